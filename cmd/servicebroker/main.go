@@ -78,9 +78,14 @@ func runWithContext(ctx context.Context) error {
 		fmt.Printf("%s/%s\n", path.Base(os.Args[0]), "0.1.0")
 		return nil
 	}
+	if options.CatalogDryRun || os.Getenv("CATALOG_DRY_RUN") == "true" {
+		// Prints the changes the catalog file would make, without starting the broker
+		_, err := broker.NewStorage(ctx, options.Options)
+		return err
+	}
 	if options.RunBackgroundTasks {
 		return broker.RunBackgroundTasks(ctx, options.Options)
-		// The above will never return expect on fatal errors
+		// The above only returns on fatal errors or once the workers have stopped
 	}
 	if (options.TLSCert != "" || options.TLSKey != "") &&
 		(options.TLSCert == "" || options.TLSKey == "") {
@@ -103,6 +108,7 @@ func runWithContext(ctx context.Context) error {
 	reg := prom.NewRegistry()
 	osbMetrics := metrics.New()
 	reg.MustRegister(osbMetrics)
+	broker.RegisterMetrics(reg, businessLogic)
 
 	api, err := rest.NewAPISurface(businessLogic, osbMetrics)
 	if err != nil {
@@ -113,6 +119,7 @@ func runWithContext(ctx context.Context) error {
 
 	businessLogic.RouteActions(s.Router)
 	broker.CrudeOSBIHacks(s.Router, businessLogic)
+	broker.RouteAdmin(s.Router, businessLogic)
 
 	if options.AuthenticateK8SToken {
 		// get k8s client
@@ -176,17 +183,14 @@ func getKubernetesClient(kubeConfigPath string) (clientset.Interface, error) {
 }
 
 func cancelOnInterrupt(ctx context.Context, f context.CancelFunc) {
-	term := make(chan os.Signal)
+	term := make(chan os.Signal, 1)
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
 
-	for {
-		select {
-		case <-term:
-			glog.Infof("Received SIGTERM, exiting gracefully...")
-			f()
-			os.Exit(0)
-		case <-ctx.Done():
-			os.Exit(0)
-		}
+	select {
+	case <-term:
+		// Cancelling stops the server and lets the task workers finish what they're doing.
+		glog.Infof("Received SIGTERM, exiting gracefully...")
+		f()
+	case <-ctx.Done():
 	}
 }
@@ -0,0 +1,277 @@
+package broker
+
+import (
+	"github.com/golang/glog"
+	"github.com/pmorie/osb-broker-lib/pkg/broker"
+	"io/ioutil"
+	"strconv"
+)
+
+// readAdminBody decodes the request body over v, fields not in the body keep their value.
+func readAdminBody(context *broker.RequestContext, v interface{}) error {
+	body, err := ioutil.ReadAll(context.Request.Body)
+	if err != nil {
+		return UnprocessableEntityWithMessage("InvalidBody", "The request body could not be read.")
+	}
+	if err = decodeStrict(body, v); err != nil {
+		return UnprocessableEntityWithMessage("InvalidBody", "The request body is not valid: "+err.Error())
+	}
+	return nil
+}
+
+// checkServiceConflicts ensures no other service (even a deleted one) has the id, and no
+// other active service has the name.
+func (b *BusinessLogic) checkServiceConflicts(service *ServiceDefinition, isNew bool) error {
+	services, err := b.storage.ListServiceDefinitions(true)
+	if err != nil {
+		glog.Errorf("Unable to list services: %s\n", err.Error())
+		return InternalServerError()
+	}
+	for _, other := range services {
+		if isNew && other.Id == service.Id {
+			return ConflictErrorWithMessage("A service with the id " + service.Id + " already exists.")
+		}
+		if other.Id != service.Id && !other.Deleted && other.Name == service.Name {
+			return ConflictErrorWithMessage("A service named " + service.Name + " already exists.")
+		}
+	}
+	return nil
+}
+
+// checkPlanConflicts ensures no other plan (even a deleted one) has the id, and no other
+// active plan of the same service has the name.
+func (b *BusinessLogic) checkPlanConflicts(plan *PlanDefinition, isNew bool) error {
+	if _, err := b.storage.GetServiceDefinition(plan.Service); err != nil && err.Error() == "Not found" {
+		return UnprocessableEntityWithMessage("InvalidPlan", "The service "+plan.Service+" does not exist.")
+	} else if err != nil {
+		glog.Errorf("Unable to get service %s: %s\n", plan.Service, err.Error())
+		return InternalServerError()
+	}
+	plans, err := b.storage.ListPlanDefinitions("", true)
+	if err != nil {
+		glog.Errorf("Unable to list plans: %s\n", err.Error())
+		return InternalServerError()
+	}
+	for _, other := range plans {
+		if isNew && other.Id == plan.Id {
+			return ConflictErrorWithMessage("A plan with the id " + plan.Id + " already exists.")
+		}
+		if other.Id != plan.Id && !other.Deleted && other.Service == plan.Service && other.Name == plan.Name {
+			return ConflictErrorWithMessage("The service already has a plan named " + plan.Name + ".")
+		}
+	}
+	return nil
+}
+
+// AdminListServices lists the services, deleted services are included with ?deleted=true.
+func (b *BusinessLogic) AdminListServices(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	services, err := b.storage.ListServiceDefinitions(context.Request.URL.Query().Get("deleted") == "true")
+	if err != nil {
+		glog.Errorf("Unable to list services: %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	return services, nil
+}
+
+func (b *BusinessLogic) AdminGetService(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	service, err := b.storage.GetServiceDefinition(vars["service"])
+	if err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to get service %s: %s\n", vars["service"], err.Error())
+		return nil, InternalServerError()
+	}
+	return service, nil
+}
+
+func (b *BusinessLogic) AdminCreateService(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	service := NewServiceDefinition()
+	if err := readAdminBody(context, &service); err != nil {
+		return nil, err
+	}
+	service.Deleted = false
+	if err := ValidateServiceDefinition(&service); err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidService", err.Error())
+	}
+	if err := b.checkServiceConflicts(&service, true); err != nil {
+		return nil, err
+	}
+	if err := b.storage.AddService(&service); err != nil {
+		glog.Errorf("Unable to add service %s: %s\n", service.Name, err.Error())
+		return nil, InternalServerError()
+	}
+	glog.Infof("Service %s (%s) was added by an operator\n", service.Name, service.Id)
+	return service, nil
+}
+
+// AdminUpdateService changes the fields of a service given in the body, set deprecated
+// to mark it as deprecated in the catalog while keeping its instances working.
+func (b *BusinessLogic) AdminUpdateService(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	existing, err := b.storage.GetServiceDefinition(vars["service"])
+	if err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to get service %s: %s\n", vars["service"], err.Error())
+		return nil, InternalServerError()
+	}
+	service := *existing
+	if err = readAdminBody(context, &service); err != nil {
+		return nil, err
+	}
+	service.Id, service.Deleted, service.Created, service.Updated = existing.Id, existing.Deleted, existing.Created, existing.Updated
+	if err = ValidateServiceDefinition(&service); err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidService", err.Error())
+	}
+	if err = b.checkServiceConflicts(&service, false); err != nil {
+		return nil, err
+	}
+	if err = b.storage.UpdateService(&service); err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to update service %s: %s\n", service.Id, err.Error())
+		return nil, InternalServerError()
+	}
+	glog.Infof("Service %s (%s) was updated by an operator\n", service.Name, service.Id)
+	return b.storage.GetServiceDefinition(service.Id)
+}
+
+// AdminDeleteService marks a service as deleted, its plans must be deleted first.
+func (b *BusinessLogic) AdminDeleteService(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	service, err := b.storage.GetServiceDefinition(vars["service"])
+	if err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to get service %s: %s\n", vars["service"], err.Error())
+		return nil, InternalServerError()
+	}
+	plans, err := b.storage.ListPlanDefinitions(service.Id, false)
+	if err != nil {
+		glog.Errorf("Unable to list plans of service %s: %s\n", service.Id, err.Error())
+		return nil, InternalServerError()
+	}
+	if len(plans) > 0 {
+		return nil, ConflictErrorWithMessage("The service still has " + strconv.Itoa(len(plans)) + " plans, they must be deleted first.")
+	}
+	if err = b.storage.DeleteService(service.Id); err != nil && err.Error() == "Not found" {
+		return nil, ConflictErrorWithMessage("The service still has plans, they must be deleted first.")
+	} else if err != nil {
+		glog.Errorf("Unable to delete service %s: %s\n", service.Id, err.Error())
+		return nil, InternalServerError()
+	}
+	glog.Infof("Service %s (%s) was deleted by an operator\n", service.Name, service.Id)
+	service.Deleted = true
+	return service, nil
+}
+
+// AdminListPlans lists the plans, optionally of one service with ?service=, deleted plans
+// are included with ?deleted=true.
+func (b *BusinessLogic) AdminListPlans(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	query := context.Request.URL.Query()
+	plans, err := b.storage.ListPlanDefinitions(query.Get("service"), query.Get("deleted") == "true")
+	if err != nil {
+		glog.Errorf("Unable to list plans: %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	return plans, nil
+}
+
+func (b *BusinessLogic) AdminGetPlan(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	plan, err := b.storage.GetPlanDefinition(vars["plan"])
+	if err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to get plan %s: %s\n", vars["plan"], err.Error())
+		return nil, InternalServerError()
+	}
+	return plan, nil
+}
+
+func (b *BusinessLogic) AdminCreatePlan(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	plan := NewPlanDefinition()
+	if err := readAdminBody(context, &plan); err != nil {
+		return nil, err
+	}
+	plan.Deleted = false
+	if err := ValidatePlanDefinition(&plan); err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidPlan", err.Error())
+	}
+	if err := b.checkPlanConflicts(&plan, true); err != nil {
+		return nil, err
+	}
+	if err := b.storage.AddPlan(&plan); err != nil {
+		glog.Errorf("Unable to add plan %s: %s\n", plan.Name, err.Error())
+		return nil, InternalServerError()
+	}
+	glog.Infof("Plan %s (%s) was added by an operator\n", plan.Name, plan.Id)
+	return plan, nil
+}
+
+// AdminUpdatePlan changes the fields of a plan given in the body, set deprecated to mark it
+// as deprecated in the catalog while keeping its instances working. The provider, type and service of a
+// plan cannot change while instances use it, as they decide how those instances are managed.
+func (b *BusinessLogic) AdminUpdatePlan(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	existing, err := b.storage.GetPlanDefinition(vars["plan"])
+	if err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to get plan %s: %s\n", vars["plan"], err.Error())
+		return nil, InternalServerError()
+	}
+	plan := *existing
+	if err = readAdminBody(context, &plan); err != nil {
+		return nil, err
+	}
+	plan.Id, plan.Deleted, plan.Created, plan.Updated = existing.Id, existing.Deleted, existing.Created, existing.Updated
+	if err = ValidatePlanDefinition(&plan); err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidPlan", err.Error())
+	}
+	if err = b.checkPlanConflicts(&plan, false); err != nil {
+		return nil, err
+	}
+	if plan.Provider != existing.Provider || plan.Type != existing.Type || plan.Service != existing.Service {
+		count, err := b.storage.CountInstancesOnPlan(plan.Id)
+		if err != nil {
+			glog.Errorf("Unable to count instances on plan %s: %s\n", plan.Id, err.Error())
+			return nil, InternalServerError()
+		}
+		if count > 0 {
+			return nil, ConflictErrorWithMessage("The provider, type and service of a plan cannot be changed while it is used by " + strconv.FormatInt(count, 10) + " instances.")
+		}
+	}
+	if err = b.storage.UpdatePlan(&plan); err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to update plan %s: %s\n", plan.Id, err.Error())
+		return nil, InternalServerError()
+	}
+	glog.Infof("Plan %s (%s) was updated by an operator\n", plan.Name, plan.Id)
+	return b.storage.GetPlanDefinition(plan.Id)
+}
+
+// AdminDeletePlan marks a plan as deleted, it must not be used by any instance.
+func (b *BusinessLogic) AdminDeletePlan(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	plan, err := b.storage.GetPlanDefinition(vars["plan"])
+	if err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to get plan %s: %s\n", vars["plan"], err.Error())
+		return nil, InternalServerError()
+	}
+	count, err := b.storage.CountInstancesOnPlan(plan.Id)
+	if err != nil {
+		glog.Errorf("Unable to count instances on plan %s: %s\n", plan.Id, err.Error())
+		return nil, InternalServerError()
+	}
+	if count > 0 {
+		return nil, ConflictErrorWithMessage("The plan is used by " + strconv.FormatInt(count, 10) + " instances, they must be deprovisioned or moved to another plan first. Deprecate the plan to stop new instances using it.")
+	}
+	if err = b.storage.DeletePlan(plan.Id); err != nil && err.Error() == "Not found" {
+		return nil, ConflictErrorWithMessage("The plan is used by an instance, it must be deprovisioned or moved to another plan first.")
+	} else if err != nil {
+		glog.Errorf("Unable to delete plan %s: %s\n", plan.Id, err.Error())
+		return nil, InternalServerError()
+	}
+	glog.Infof("Plan %s (%s) was deleted by an operator\n", plan.Name, plan.Id)
+	plan.Deleted = true
+	return plan, nil
+}
@@ -0,0 +1,196 @@
+package broker
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	"github.com/pmorie/osb-broker-lib/pkg/broker"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type adminRoute struct {
+	path    string
+	method  string
+	handler func(map[string]string, *broker.RequestContext) (interface{}, error)
+}
+
+func Unauthorized() error {
+	description := "Unauthorized"
+	return osb.HTTPStatusCodeError{
+		StatusCode:  http.StatusUnauthorized,
+		Description: &description,
+	}
+}
+
+// authorizeAdmin checks the request carries the ADMIN_TOKEN as a bearer token, the admin
+// endpoints are disabled if no token is set.
+func authorizeAdmin(r *http.Request) bool {
+	token := os.Getenv("ADMIN_TOKEN")
+	if token == "" {
+		return false
+	}
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(header, "Bearer ")), []byte(token)) == 1
+}
+
+// RouteAdmin adds the operator endpoints under /v2/admin, they are not part of the OSB api
+// and require the ADMIN_TOKEN in addition to any middleware on the rest of the broker.
+func RouteAdmin(router *mux.Router, b *BusinessLogic) {
+	routes := []adminRoute{
+		adminRoute{path: "workers", method: "GET", handler: b.AdminListWorkers},
+		adminRoute{path: "tasks", method: "GET", handler: b.AdminListTasks},
+		adminRoute{path: "tasks/{task}", method: "GET", handler: b.AdminGetTask},
+		adminRoute{path: "tasks/{task}/retry", method: "POST", handler: b.AdminRetryTask},
+		adminRoute{path: "tasks/{task}/cancel", method: "POST", handler: b.AdminCancelTask},
+		adminRoute{path: "services", method: "GET", handler: b.AdminListServices},
+		adminRoute{path: "services", method: "POST", handler: b.AdminCreateService},
+		adminRoute{path: "services/{service}", method: "GET", handler: b.AdminGetService},
+		adminRoute{path: "services/{service}", method: "PATCH", handler: b.AdminUpdateService},
+		adminRoute{path: "services/{service}", method: "DELETE", handler: b.AdminDeleteService},
+		adminRoute{path: "plans", method: "GET", handler: b.AdminListPlans},
+		adminRoute{path: "plans", method: "POST", handler: b.AdminCreatePlan},
+		adminRoute{path: "plans/{plan}", method: "GET", handler: b.AdminGetPlan},
+		adminRoute{path: "plans/{plan}", method: "PATCH", handler: b.AdminUpdatePlan},
+		adminRoute{path: "plans/{plan}", method: "DELETE", handler: b.AdminDeletePlan},
+	}
+	for _, route := range routes {
+		glog.Infof("Adding route %s /v2/admin/%s\n", route.method, route.path)
+		var r adminRoute = route
+		router.HandleFunc("/v2/admin/"+r.path, func(w http.ResponseWriter, req *http.Request) {
+			if !authorizeAdmin(req) {
+				HttpWriteError(w, Unauthorized())
+				return
+			}
+			c := broker.RequestContext{Request: req, Writer: w}
+			obj, err := r.handler(mux.Vars(req), &c)
+			if err != nil {
+				HttpWriteError(w, err)
+				return
+			}
+			HttpWrite(w, 200, obj)
+		}).Methods(r.method)
+	}
+}
+
+// AdminListWorkers lists the workers that are alive and the tasks each is running.
+func (b *BusinessLogic) AdminListWorkers(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	workers, err := b.storage.ListWorkers(workerLease)
+	if err != nil {
+		glog.Errorf("Unable to list workers: %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	return workers, nil
+}
+
+// AdminListTasks lists tasks, optionally only those for a resource, action or with a status.
+func (b *BusinessLogic) AdminListTasks(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	query := context.Request.URL.Query()
+	filter := TaskFilter{
+		ResourceId: query.Get("resource"),
+		Action:     query.Get("action"),
+		Status:     query.Get("status"),
+	}
+	if query.Get("status") != "" && query.Get("status") != "pending" && query.Get("status") != "started" && query.Get("status") != "finished" && query.Get("status") != "failed" {
+		return nil, UnprocessableEntityWithMessage("InvalidStatus", "The status must be pending, started, finished or failed.")
+	}
+	if query.Get("limit") != "" {
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit < 1 || limit > 1000 {
+			return nil, UnprocessableEntityWithMessage("InvalidLimit", "The limit must be a number between 1 and 1000.")
+		}
+		filter.Limit = limit
+	}
+	tasks, err := b.storage.ListTasks(filter)
+	if err != nil {
+		glog.Errorf("Unable to list tasks: %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	return tasks, nil
+}
+
+// redactTaskMetadata hides secrets (webhook secrets, new auth tokens) in a task's metadata.
+func redactTaskMetadata(metadata string) interface{} {
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(metadata), &values); err != nil {
+		return metadata
+	}
+	for key := range values {
+		if key == "secret" || key == "token" || key == "password" {
+			values[key] = "[redacted]"
+		}
+	}
+	return values
+}
+
+// AdminGetTask shows a task with its metadata and every status and result it has had.
+func (b *BusinessLogic) AdminGetTask(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	task, err := b.storage.GetTask(vars["task"])
+	if err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to get task %s: %s\n", vars["task"], err.Error())
+		return nil, InternalServerError()
+	}
+	history, err := b.storage.ListTaskHistory(task.Id)
+	if err != nil {
+		glog.Errorf("Unable to get history of task %s: %s\n", task.Id, err.Error())
+		return nil, InternalServerError()
+	}
+	return map[string]interface{}{
+		"task":     task,
+		"metadata": redactTaskMetadata(task.Metadata),
+		"history":  history,
+	}, nil
+}
+
+// AdminRetryTask puts a failed task back on the queue with its retries reset.
+func (b *BusinessLogic) AdminRetryTask(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	task, err := b.storage.GetTask(vars["task"])
+	if err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to get task %s: %s\n", vars["task"], err.Error())
+		return nil, InternalServerError()
+	}
+	if task.Status != "failed" {
+		return nil, ConflictErrorWithMessage("Only failed tasks can be retried, this task is " + task.Status + ".")
+	}
+	if err = b.storage.RequeueFailedTask(task.Id); err != nil && err.Error() == "Not found" {
+		return nil, ConflictErrorWithMessage("The task is no longer failed.")
+	} else if err != nil {
+		glog.Errorf("Unable to retry task %s: %s\n", task.Id, err.Error())
+		return nil, InternalServerError()
+	}
+	glog.Infof("Task %s (%s for %s) was requeued by an operator\n", task.Id, task.Action, task.ResourceId)
+	return b.storage.GetTask(task.Id)
+}
+
+// AdminCancelTask stops a task that has not yet started from ever running.
+func (b *BusinessLogic) AdminCancelTask(vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	task, err := b.storage.GetTask(vars["task"])
+	if err != nil && err.Error() == "Not found" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Unable to get task %s: %s\n", vars["task"], err.Error())
+		return nil, InternalServerError()
+	}
+	if task.Status != "pending" {
+		return nil, ConflictErrorWithMessage("Only pending tasks can be cancelled, this task is " + task.Status + ".")
+	}
+	if err = b.storage.CancelPendingTask(task.Id); err != nil && err.Error() == "Not found" {
+		return nil, ConflictErrorWithMessage("The task has already started.")
+	} else if err != nil {
+		glog.Errorf("Unable to cancel task %s: %s\n", task.Id, err.Error())
+		return nil, InternalServerError()
+	}
+	glog.Infof("Task %s (%s for %s) was cancelled by an operator\n", task.Id, task.Action, task.ResourceId)
+	return b.storage.GetTask(task.Id)
+}
@@ -0,0 +1,50 @@
+package broker
+
+import (
+	"github.com/gorilla/mux"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestAdminAuthorization(t *testing.T) {
+	Convey("Given the admin routes.", t, func() {
+		router := mux.NewRouter()
+		RouteAdmin(router, &BusinessLogic{})
+		defer os.Unsetenv("ADMIN_TOKEN")
+
+		Convey("Ensure they are disabled without an admin token", func() {
+			os.Unsetenv("ADMIN_TOKEN")
+			req := httptest.NewRequest("GET", "/v2/admin/tasks", nil)
+			req.Header.Set("Authorization", "Bearer ")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+
+		Convey("Ensure the wrong token is rejected", func() {
+			os.Setenv("ADMIN_TOKEN", "sekret")
+			req := httptest.NewRequest("POST", "/v2/admin/tasks/abc/retry", nil)
+			req.Header.Set("Authorization", "Bearer nope")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+
+		Convey("Ensure invalid filters are rejected once authorized", func() {
+			os.Setenv("ADMIN_TOKEN", "sekret")
+			req := httptest.NewRequest("GET", "/v2/admin/tasks?status=bogus", nil)
+			req.Header.Set("Authorization", "Bearer sekret")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			So(w.Code, ShouldEqual, http.StatusUnprocessableEntity)
+		})
+	})
+
+	Convey("Given task metadata with secrets.", t, func() {
+		So(redactTaskMetadata(`{"url":"https://example.com","secret":"abc","token":"def"}`), ShouldResemble, map[string]interface{}{"url": "https://example.com", "secret": "[redacted]", "token": "[redacted]"})
+		So(redactTaskMetadata("some-instance-name"), ShouldEqual, "some-instance-name")
+	})
+}
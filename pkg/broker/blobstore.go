@@ -0,0 +1,281 @@
+package broker
+
+import (
+	"errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// BlobStore holds backups for providers that cannot keep their own (e.g., redis on kubernetes).
+type BlobStore interface {
+	Put(string, io.Reader) error
+	Get(string) (io.ReadCloser, error)
+	Stat(string) (*Blob, error)
+	List(string) ([]Blob, error)
+	Delete(string) error
+	// SignedUrl returns a url the blob can be downloaded from without credentials.
+	SignedUrl(string, time.Duration) (string, error)
+}
+
+type Blob struct {
+	Key      string
+	Size     int64
+	Modified time.Time
+}
+
+// NewBlobStoreFromEnv creates the blob store in BACKUP_STORE, either file:///some/path or
+// s3://bucket/prefix. S3 compatible stores can be used by setting BACKUP_STORE_ENDPOINT.
+func NewBlobStoreFromEnv() (BlobStore, error) {
+	if os.Getenv("BACKUP_STORE") == "" {
+		return nil, errors.New("No backup store was configured, set BACKUP_STORE to enable backups.")
+	}
+	u, err := url.Parse(os.Getenv("BACKUP_STORE"))
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "file" {
+		return NewFilesystemBlobStore(u.Path, os.Getenv("BACKUP_STORE_PUBLIC_URL"))
+	} else if u.Scheme == "s3" {
+		return NewS3BlobStore(u.Host, strings.TrimPrefix(u.Path, "/"), os.Getenv("BACKUP_STORE_ENDPOINT"))
+	}
+	return nil, errors.New("The backup store " + u.Scheme + " is not supported, use file:// or s3://.")
+}
+
+type FilesystemBlobStore struct {
+	root      string
+	publicUrl string
+}
+
+// NewFilesystemBlobStore stores blobs under root, as the files are local to the broker a
+// publicUrl serving the same directory is needed for pods to download them (for restores,
+// which are only allowed in tests).
+func NewFilesystemBlobStore(root string, publicUrl string) (*FilesystemBlobStore, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+	return &FilesystemBlobStore{root: root, publicUrl: strings.TrimSuffix(publicUrl, "/")}, nil
+}
+
+func (store *FilesystemBlobStore) path(key string) (string, error) {
+	path := filepath.Join(store.root, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, filepath.Clean(store.root)+string(filepath.Separator)) {
+		return "", errors.New("Invalid key " + key)
+	}
+	return path, nil
+}
+
+func (store *FilesystemBlobStore) Put(key string, body io.Reader) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	// Write to a temporary file first so partial blobs are never visible.
+	file, err := ioutil.TempFile(filepath.Dir(path), ".upload-")
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(file, body); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+	if err = file.Close(); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func (store *FilesystemBlobStore) Get(key string) (io.ReadCloser, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil && os.IsNotExist(err) {
+		return nil, errors.New("Not found")
+	}
+	return file, err
+}
+
+func (store *FilesystemBlobStore) Stat(key string) (*Blob, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil && os.IsNotExist(err) {
+		return nil, errors.New("Not found")
+	} else if err != nil {
+		return nil, err
+	}
+	return &Blob{Key: key, Size: info.Size(), Modified: info.ModTime()}, nil
+}
+
+func (store *FilesystemBlobStore) List(prefix string) ([]Blob, error) {
+	blobs := make([]Blob, 0)
+	err := filepath.Walk(store.root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".upload-") {
+			return nil
+		}
+		rel, err := filepath.Rel(store.root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			blobs = append(blobs, Blob{Key: key, Size: info.Size(), Modified: info.ModTime()})
+		}
+		return nil
+	})
+	return blobs, err
+}
+
+func (store *FilesystemBlobStore) Delete(key string) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// SignedUrl is only available in tests, whatever serves publicUrl hands out the files as they
+// are, so the url would never expire and anyone who saw it could download the backup.
+func (store *FilesystemBlobStore) SignedUrl(key string, expires time.Duration) (string, error) {
+	if os.Getenv("TEST") != "true" {
+		return "", errors.New("Backups cannot be restored from a filesystem backup store, use an s3 backup store to restore backups.")
+	}
+	if store.publicUrl == "" {
+		return "", errors.New("Downloading from a filesystem backup store requires BACKUP_STORE_PUBLIC_URL.")
+	}
+	if _, err := store.Stat(key); err != nil {
+		return "", err
+	}
+	return store.publicUrl + "/" + key, nil
+}
+
+type S3BlobStore struct {
+	svc      *s3.S3
+	uploader *s3manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+func NewS3BlobStore(bucket string, prefix string, endpoint string) (*S3BlobStore, error) {
+	if bucket == "" {
+		return nil, errors.New("The s3 backup store must include a bucket (s3://bucket/prefix).")
+	}
+	config := aws.Config{Region: aws.String(os.Getenv("AWS_REGION"))}
+	if endpoint != "" {
+		config.Endpoint = aws.String(endpoint)
+		config.S3ForcePathStyle = aws.Bool(true)
+	}
+	sess, err := session.NewSession(&config)
+	if err != nil {
+		return nil, err
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix = prefix + "/"
+	}
+	return &S3BlobStore{
+		svc:      s3.New(sess),
+		uploader: s3manager.NewUploader(sess),
+		bucket:   bucket,
+		prefix:   prefix,
+	}, nil
+}
+
+func isS3NotFound(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == "NotFound"
+	}
+	return false
+}
+
+func (store *S3BlobStore) Put(key string, body io.Reader) error {
+	_, err := store.uploader.Upload(&s3manager.UploadInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.prefix + key),
+		Body:   body,
+	})
+	return err
+}
+
+func (store *S3BlobStore) Get(key string) (io.ReadCloser, error) {
+	resp, err := store.svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.prefix + key),
+	})
+	if err != nil && isS3NotFound(err) {
+		return nil, errors.New("Not found")
+	} else if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (store *S3BlobStore) Stat(key string) (*Blob, error) {
+	resp, err := store.svc.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.prefix + key),
+	})
+	if err != nil && isS3NotFound(err) {
+		return nil, errors.New("Not found")
+	} else if err != nil {
+		return nil, err
+	}
+	return &Blob{Key: key, Size: aws.Int64Value(resp.ContentLength), Modified: aws.TimeValue(resp.LastModified)}, nil
+}
+
+func (store *S3BlobStore) List(prefix string) ([]Blob, error) {
+	blobs := make([]Blob, 0)
+	err := store.svc.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(store.bucket),
+		Prefix: aws.String(store.prefix + prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			blobs = append(blobs, Blob{
+				Key:      strings.TrimPrefix(aws.StringValue(object.Key), store.prefix),
+				Size:     aws.Int64Value(object.Size),
+				Modified: aws.TimeValue(object.LastModified),
+			})
+		}
+		return true
+	})
+	return blobs, err
+}
+
+func (store *S3BlobStore) Delete(key string) error {
+	_, err := store.svc.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.prefix + key),
+	})
+	return err
+}
+
+func (store *S3BlobStore) SignedUrl(key string, expires time.Duration) (string, error) {
+	req, _ := store.svc.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.prefix + key),
+	})
+	return req.Presign(expires)
+}
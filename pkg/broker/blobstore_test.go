@@ -0,0 +1,44 @@
+package broker
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFilesystemBlobStore(t *testing.T) {
+	root, err := ioutil.TempDir("", "blobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	os.Setenv("TEST", "true")
+
+	Convey("Given a filesystem blob store.", t, func() {
+		store, err := NewFilesystemBlobStore(root, "http://backups.example.com/")
+		So(err, ShouldBeNil)
+		So(store.Put("instance/backup.rdb", strings.NewReader("REDIS")), ShouldBeNil)
+
+		Convey("Ensure urls are only handed out in tests", func() {
+			url, err := store.SignedUrl("instance/backup.rdb", time.Hour)
+			So(err, ShouldBeNil)
+			So(url, ShouldEqual, "http://backups.example.com/instance/backup.rdb")
+
+			os.Setenv("TEST", "false")
+			defer os.Setenv("TEST", "true")
+			_, err = store.SignedUrl("instance/backup.rdb", time.Hour)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "Backups cannot be restored from a filesystem backup store, use an s3 backup store to restore backups.")
+		})
+
+		Convey("Ensure keys cannot escape the root", func() {
+			_, err := store.Get("../outside")
+			So(err, ShouldNotBeNil)
+			_, err = store.SignedUrl("missing.rdb", time.Hour)
+			So(err.Error(), ShouldEqual, "Not found")
+		})
+	})
+}
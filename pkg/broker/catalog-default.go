@@ -0,0 +1,1571 @@
+package broker
+
+import (
+	"strings"
+)
+
+// defaultCatalogFile is the catalog used when no catalog file is given. It is written like a
+// catalog file (see docs/catalog.example.yaml), the plans of kubernetes providers are only
+// offered when USE_KUBERNETES is true.
+const defaultCatalogFile = `
+services:
+- id: 0a8d668d-2971-4533-8e38-a816a7c01bec
+  name: akkeris-redis
+  human_name: Akkeris Redis
+  description: Reliable and powerful Redis as a service.
+  categories: Data Stores,Redis,Cache
+  image: data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAgAAAAIACAYAAAD0eNT6AAAABHNCSVQICAgIfAhkiAAAAAlwSFlzAAALEwAACxMBAJqcGAAAIABJREFUeJzs3Xd8XGeVP/7P89w2M+rdVbIcS7Kd2E7iBFhI4vRqAml2nARSbNhdFli2wvKDBZayBdhlWXaXH3EaSRZCem8QklDTY8exLbk7buoa9bnlOd8/7siRbdlWmZk7c+e8Xy9eNpI8OlG599znOc85AGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMMcYYY4wxxhhjjDHGGGOMsVwigg6AMZZ9CJDvLlwYU5pm6BFHWsp2ZYeXmLNrV0IAFHR8jLGp4wSAsTxDgNh06vxp0lELoaGJlJgnBNUpJWZJqBpIVACycMx/rJSrIHskqBUC+0iI3URimwC1gLBh/vzmreJ+eBn+T2KMTQInAIyF3MbT51eIBH1YgD4IwukK4lQpUZmOz6UUhqSgtyHoVUD8nqT47YK3mvel43MxxqaGEwDGQmbDwoXlUldnC9C5guhsSHFisBGpFkC8AOBZaakXGl/d2htsPIwxgBMAxnIeAbL55MalROIyABcTcLoEZNBxjUkpV0n5slB4DBKPLljXvDPokBjLV5wAMJaDdtTVRexS8zwF+XEBtRyQ04KOaXLoTUXyAaXJ+096a+PWoKNhLJ9wAsBYjnh78eKCiExcSoSrBNRlRy3Uy1n0JpH4me659zW8u+29oKNhLOw4AWAsi+3+0KzowGDBpQJYqQjLpUQ06JjSTQEklXoJQtxjGO4DJ7yxPR50TIyFEScAjGWZ15cuNYq83gs8T66SUn08fE/646eAYQE8IojuampqeZ6PGDKWOpwAMJYFCBAtixs+QBA3KBIrpURV0DFlHaX2kZQ/hcKdC95pbg46HMZyHScAjAWoeVHDXBLiBhDdACkbgo4nZxD9QQi6Q1h0Hx8rZGxyOAFgLMO2LZ1b4rraCg/ykxI4I+h4cplSGBISDwC4ff665pcFoIKOibFcwQkAYxlA10Db3Nx0PgRuIuDjEogEHVPYKGC7AG7XlHdX4ztb9wQdD2PZjhMAxtKo+ZSGBcqTNwpSn4CUM4KOJx8ofxXgOSlwu+z3HmvcujURdEyMZSNOABhLsR1L6kqHKXItBG4SwAeDjievkeokyLsJ4raF6zdvCDocxrIJJwCMpQBdA21Ty/zzJNRNCuIKXuLPPkrhVSHFbZrl/pwLBxnjBICxKdlwysJ5uufepIS4UQKzgo6HjYOiQQj8AsBtTetbficACjokxoLACQBjE7SpqakIFq5JLvGfGXQ8bCpUM0He5njGTxdv2NAadDSMZRInAIyNAwGiZVHDmSTEzRB0DSALgo6JpZBSLkE+LjW1trFhy7PccZDlA04AGDuGlkXzZnlSu1EQ3QQh5gUdD0s/pbBXCroDwO3z17fsCDoextKFEwDGDtMyb56lCrXLFeEWABdKQAYdEwsGEX4pQGvloHqEjxOysOEEgLGk5iUNJxPELQBdD8jyoONh2UR1Ecmf8nFCFiacALC8tmHhwnLdcFcRxGoBnBJ0PCz7EfCKULSWbHHfgubmvqDjYWyyOAFgeYcAuenk+ecJolsIuEICVtAxsVykBgjy56TE2gXvbH6FjxOyXMMJAMsbm5Y0zQFwkyC6GULUBh0PCxFF70JiLXTcM/+Nlo6gw2FsPDgBYKG2o64uYpeYH1eQq0ngPMk/8yytlE2QD0uh1ja+veUFnk7IshlfDFkotSyed4oHbTUI1wuJ0qDjYXlIqZ0k5W0S6o6mdVv2Bh0OY4fjBICFxoaFC8uloa4TilYLiZODjmcyzNo62Hv2AIr70ISFApQEPQWBtftLpj95zksvuUHHxBjACQDLcWEr6Cs44yxM++o/If7oQ4g/8iCcffzgGCpK7YcUd0pX3db47tZtQYfD8hsnACwnvXvKgjrN824C0c2Qsi7oeFJGCMz+yZ2Inf5BgAgDr/wB8YfuR/+LvwLZdtDRsVRS6tcK2q3R3qGH63ftGg46HJZ/OAFgOaNl3jxLxeTHAaxWQpwf1oK+yImLUHfv/Ye8zYv3oPfxR9Dz0AOwt28NKLLMMmvrIKIxJJo3BR1KmqkuQNyjSN7KTYZYJoXyAsrCZfNJjYuhYTVAN+RLh74Z//YfKLrwkjHfN7T+bcQf/AX6nn8GanAww5Gllzl3HorOvxBF518Eq7EJO6+5HIktLUGHlTEEvCKIbnVd/b6TNm7sDzoeFm6cALCstG3p3BLbMVYJqNUQ8rSg48k0c3Yt5jzyNISmHfVj1MAAep95EvGH7sfwxg0A5WAfGiFgNTSi6PyLUHTBRTDrTzj4rqE3X8fuW24IMLggqX4i+TMp1NrGdVte4yZDLB04AWBZgwCx6eT5Zwmi1aRwtZSIBh1TkKq/9FWUXXv9uD420dKM+MP3o/epx+HF42mObIqEQGTBQhSel7zp145dwrHvS3+DvmeezHBw2Ui9QyRv9Vzt3pM2buwKOhoWHpwAsMBtOqVpBnniRgl1C4/cfZ9WXoG5TzwPGYuN+9+QbaPvV88h/tD9GHzjNUBlSR8aIRA5afHBJ31jxsxjfrjb2YntF58NcpwMBZj9FJCQpB4gIdfOX9f8Eq8KsKniBIAF4vWlS41Ct+8yAKuVEpdIiaOvdeexik9/BpWf+fyk/q3z3m7EH3kA8UcfhtvRnuLIxkFKRJec4t/0z7sA+rTp4/6nnT/5H3T8zw/TGFyOU2oLpLjN9sw7F2/Y0Bp0OCw3cQLAMmrToqYmCNwiBN0IiJqg48l2MhpF/ePPQ6+snPyLKA/9L7+E+MP3Y+C3L4O8NDYZkhpipy5F0QUXofC8C6FXVk38NZSHbZecB7f1QOrjCxulXIJ8XIBubZrf8py4H9xBio0bJwAs7d5evLjAEolrCFgtgTOCjifXlF69EjVf+UZKXsvtaEf8kYcQf/RBOO/tTslrCl1H7LQPoPCCi1F07vnQyqZ2UKPvV89j3998LiWx5RMC3gNwu6drt5/0xsbUfHNZqHECwNKCANGypOF0IrEaglYBsijomHKV0HXMefAJmHVzUvq6g6/+EfGH70ffC78EJRITi8kwEPvgh1F0/oUoPOd8aCUlKYvrvU/fhMFX/5iy18s3yq8NeBYkbh00Ch4/7Y03uJCCjYkTAJZSG0+fXyGG6QYh1RpAnhR0PGFRdN4FmPH9/0rLa3u9veh94lHEH77/mGfuhWWh4E8+gqLzL0Lh2edCFqY+p7N3bMeOKy/LzSONWUgBbZLoLgW5duH6zfnTUIGNCycAbMoIkJuWzD8filZLqT4OSDPomEJHCNTe9TNEF6d3xtHwhvXoeegB9D37JNTAAGQkgoIzzkLR+Reh4KxzJnQiYTLa/vVb6P7ZPWn9HPmKgJcF6NZYdPDB2j/uGQo6HhY8TgDYpG1YurBWd9ybQ9ePP0tFT1mK2jvuzcjnUkNDGF7/NiJLToGMRDL2ObddcBZUf19GPl++IoUeIegeIWlt09tb1gUdDwsOJwBsQjYsXGhqmne5kGqNgrwwrP34s9XMH/wPCs8+N+gw0qLn/p+j9dtfDzqMvKIUXhNSrMUw/WxBczNnXnmGL95sXFpOnrfQI201KXxSSkzhTBqbCnPuPNQ/8BggZdChpFy+9f3PLmqAIH8uSd3auH7Lq9xkKD9wAsCOasPChYW67q4EsBpC/EnQ8TDftH/8JkquvCboMFIqv/v+Zxu1Idl6+B5uPRxunACwQxAgNi2a/0EhaY2AWgnIwqBjYofSq2sw9/FnIazM7M1nwr4v/jX6nn0q6DDYKH7rYXqQhLiVWw+HEycADACweWljJVx8AgqrIcWJQcfDjq3yc3+NitWfDjqMlHA7OrD9knO47382I9oKgbWuo9910saN3KIxJDgByGOHHN+DugJSGkHHxMZHFhZh7pPPQyspDTqUKeO+/7lDKXhC4HEp1a2NDVue5dbDuY0TgDy05cQTZnuadjOIbuHjezlICESXnILqv/sHRE5cFHQ0U6M8bLv4XLhtPM8m1yhgjwBuV1LefuJbm3YFHQ+bOE4A8sSGhQtNTfc+SgKrAVzMx/dyjzmnHsWXfhTFl10OY+asoMNJib5fPod9fzu5aYcsOyiAJNRzpORaz9MeO2njRjvomNj48E0g5JpPaVhASqxWSnxSSkxiNBsLklZegeKLL0XxZZfn/tP+GLjvf7gohXYp6E4icduCd5qbg46HHRsnACG0YeHCQk33Vgio1RDyw0HHwyZGRiIoPOc8FF92OQo+fAYgtaBDSgt7xzbsuHI59/0PKQJeFkRrY7HBB7j1cHbiBCAkCBAtixs+oIRcI6Cu5eN7OUZqiJ3+QRQvvxxF512Y9p772YBsG/0v/grxxx7G4B9+B/K4niyMlEJcCNwjpbqVWw9nF04Aclzy+N4NAK3m6Xs5RghYDU0ovuxyFF+6HHpVddARBcbtaPcnEj72COztW4MOh6ULqddJaLdqlvvzxle39gYdTr7jBCAHESBbTm44V5FcI6Cu4Ol7uUWvmXawmM+a1xB0OFln+N13EH/0YfQ9+yS8eDzocFg6KBqEwH1Kk7cueGvzH7nJUDA4AcghLYvmzVJCjhzfmxN0PGz8ZGERis6/EMWXXY7YaR8ABP/qHQ85jr9F8OjDGPzDb3mLIKwUvQuJtcqUdy98bXNn0OHkE74KZbnXly41itze5YrkGhK4WALhmwITUkLXUfCRM1F82eUoXHYuhGUFHVLOcjs60Pvko+h97GEktvEWQTgpGxAPgrC2aX3LiwJQQUcUdpwAZKmNi+c3CtBqErhJAvm7OZxrhEB00WIUX3Y5ii6+LBSd+rLN8MYN/hbBM0/wFkFIKWAbSNwGHXcufHPz/qDjCStOALLI60uXxoqc3qv9Sn6cGXQ8bPzM2bUouuxyFF92OczZtUGHkxf8LYIX/FMEv/8NbxGEkFLwIPAEINa2ltU8c85LL7lBxxQmnABkgY2L5p8KSWugcL2UKA46HjY+WmkZii68BMXLP4bo4iVBh5PX3M5O9D75GHofewiJrVuCDoelgVLYKwXdAeD2+etbdgQdTxhwAhCQHUvqShPCuo5IrBHAKUHHw8ZHWBEULjvHb9JzxlkQWjib9OSy4Y3vovexh9D79JPw4j1Bh8NSTAEkiX4JgbVyQD3auHVrIuiYchUnABlEgGhZ1HCmknINAddIIDwD3cNMCERPPQ0lH/04ii64CLKAeyzlAnJd9L/0a/Q+9hAGfvcbkMurx2GjFDqExE814d3W+PbWjUHHk2s4AciA9SedVGNq9o3JZj2NQcfDJk5oGrSyMmjlldArKqBVJP8c6/+XlwOSD2tkE6/L3yKIP/4IEi3coj6USP2ehFybIOsXJ69fPxB0OLmAE4A0oWugtWxpuEh5co2A+iik1IOOiWWIlNBKSkclBpXvJwgVldDLKw7+XSuv4G2EDEts3oj4Yw+j96kn4PV0Bx0OSznVByX+j3Sxdv5bzW9wk6Gj4wQgxTYvbqwHcIsicbOUmBl0PCzLSQmtqPjQBGHUysLopEGrqIQwjKAjDgVyHDj79iL+4H3oeeA+qMHBoENiaaAI66SgW21l/d/id97hbO8wnACkQMu8eZaKyY8TxBohcH7Q8bCQEgKysGjMlYQjtyMq87bxkNfVCbe9DW5bK5y2toN/d9tG/mz1iwN5CmHeUMAwFB6AJtYueHvzy7wq4OMEYAreXbLgRElqjYD6BISsCDoexg4SAjJWcESCUHzpckRPPjXo6CZFDQ0dvIG/f1NP/r01+ffODpDjBB0qy2ZKbYEUa23PvGvxhg2tQYcTJE4AJmjDwoWFuu6uhMAaQHwo6HgYmwhj5izUP/Rkdq0OKA9ue/vBm7kz8qR+2JO7GugPOlIWJkq5BPm41NTaxoYtz4r7kXedpDgBGAcCRMuShtMV5KcE1LWA5HNgLGdV/OlfoPLPP5eRz+XF40c+tR/+Z1c3oPLu2suyiAL2COAOALcvWNe8M+h4MoUTgGPYsHBhuaZ7Nwih1gByUdDxMJYKwrJQ/+ATMGbNTsvrD77yexz45tfgtreBEtyjheWOg02GgNvkoHok7E2GOAE4DAGyeXHj2RBYoyCulEAWrZUylhoFZ56NWf/147S89p7P/ikGfvtSWl6bsYwh1UmQdxPEbQvXb94QdDjpwAlA0qZTmmbAxU0ksVoCc4OOh7F0m/mD/0bh2eel9DWdvXuw/aMXAoonuWYSAejzCN2uwtaBATTEIphtGRCCL/GpQMArQtFassV9C5qb+4KOJ1Xy+qfj18uW6dO6D1wihFqjlLxMSnBHFpY3jBkzUf/wUyktCGz/j++i667bUvZ67NhsRej2CN0u4BFBCOC1bn/+QVSTmBe10FgQQaHGnSlTQw0AuE9Jbe2Ctzb/MdePE+ZlApBs1rMaRDdDyhlBx8NYUCo+/RlUfubzKXktsm1su3AZd9dLMwLQ6xG6XcKAd+j9Z3QCMNoMy0BDLILaiAHJqwIpoQibBHCbAefueeu3twUdz2TkzU8CN+th7EjCslD/wOMwZtdO+bXijz+CA1/9UgqiYmNJKKDbU+hxCd5RnjuPlgCMiEiBE6IRNBZYKNZ5wTMllHIhxWNC0G25dpww9AnAxsXzTxKg1UKoTwKyPOh4GMs2BWechVk/+smUX2fXDSswvGF9CiJiIxQBvYrQ7RAG1fFXmwWA13rGNwJ5mqmjIRZBXczivc8UUQp7paA7BdHtTe9s2R50PMcTygSAm/UwNjEz/+NHKDxn8gtjwxs3YNd1V6cwovw2TIRuB4h76qhP+2OZSAIwwpQSJ0RNNBZYKNV5ZlnKKPVrkvK2gujAQ7V/3DMUdDhjCU0CcLBZD8k1QqhV3KwnA4SAjEYhC4sgYzEIXQd0A0LXD/4Pmg4oD+S6/jx21zn4dxoagtffDzU4wFXjAfMLAp+EsCKT+vcHvvZlxB99KMVR5RcFIO76lfxDk/x1mEwCMFqlYaCpwMKcqAmdawVSQinEIfF/Qonb5r+z+c1sKhzM+e8wN+tJMSmhV1bBmDETelUV9Kpq6JX+n1pVNfSyMv+GX1gEragQkClYPCSCGuj3k4G+PnjxHrjt7fA6koNc2tv9FrGtB+Du2wvycmaLLadUrPkzVH72CxP+d15vL7ZfeBbU8HAaogq/YUXocoG4qzDVNHiqCcAIQwrMjVhoLLBQbvCqQKooYL1QuF2YdO/8N1o6go4nJxMAAuTmJU3LBNEaJcRV3Kxn4mQ0CquhEea8Jph1dTBn18KYXQeztnbST4GZQJ4HZ+8eOLt3wX5vN5zdO5HYthWJ5s3+hDc2acI0MefBJ2BOsCCw66d3oP3f/zVNUYWTR0DcI/RM4Wl/LKlKAEYrN3Q0xSzURy0YMidvGdlHKSdZOHj7vpIZz53z0ktuEGHk1Hdz0ylNM8gTN0LQagmcEHQ8uUIWFCC6aAkiJy2C1TgfVtMCmLV1fslwiLitBzDcvBmJls1IbHoXQ2+/CbezM+iwckrBR87ErP++dUL/ZsdHL4T93u40RRQuQx6hyyPEPYDSNI749RQnACM0IVAfNdFUYKHCMHLr5pHNlNpPQt4tNXVn01tbNmXyU2f995Cb9UycVlaO2GmnI3ryUkRPWYrI/PmpWarPQfaunRh66w0MvfUGBt98HQ7fqI5rxvf/C0XnXTCujx34/W+x5zNr0hxRbvPg7+13uYTEOCr5pypdCcBopbqOxgILJ0RNmJKbDKUKAa8Iortssn6++J130t5QI2sTAG7WMwFSIrLgRBScuQyFZ5yFyImLQvd0nyr2rp0Y+O1LGPjNSxh883WQbQcdUtYxpk1H/aNPj2sraO9f/jn6X/p1BqLKPYPJp/1elzJa9ZWJBGCEBqAuaqGhIIIaU8/eG0rOUTZIPiqkujOdWwRZ9f3iZj0TICWipyxF8SXLUXTu+dDKK4KOKOeooSEM/O436Hv6cfT/9mWeXDdKxepPo/Jzf33Mj3H278P2yy7gUb6juOQf3et2CImAar0zmQCMVqxraIpZmBuLIMK1Aqmj1H5IcQcIa+evb9mRypfOiu/Su0sWnChJrRFQn4CQfCc7BmteA4qXfwzFF18Gfdr0oMMJDTXQj75fPofeJx/D4Ouv5v2xRGGamHP/YzDr5hz1Y9p/+O/oun3qDYRyHQEY8IAeT2X8aX8sQSUAI6QQqI34rYenW1wrkCoKIKHwlAD9d9M7Lc8KTPnQSHDfm7cXLy6wkFgpQGsgxJ8EFUcuELqOwnPOR9mqGxA99bSgwwk9e9dO9Pz8HsQfewRqoD/ocAJT8Ccfwaz/HXuwDzkOtl10Nryu/C2ydMm/6Xc7BDvou/4oQScAoxVpEg0FEcyLWojyQKKUUYRNEvR9Oajuady6ddJLlxlNAAgQm09pWio8+hQErQJkUSY/f66RsRhKr70BZddeD726Juhw8o4aHET80YfQdedauK0Hgg4nEDO++58ouuCiI97e+9Tj2P/lvwsgomARAf2K0OMS+hQhTYX8U5JNCcAIKYBZlomGWAQzLAO8Q5AaCtgDiH/VB9xbJ5MIZOTbsH7RojJdOteDaI0UWJKJz5nLhBVB6TXXomL1p6GV8fiCoJFto+f+n6Hztp/k3ROvPm066h95GjJyaEHg7k9ei6H1bwcUVeY5BHS7/rl9Jwtv+qNlYwIwWoEmMS8WQUPMQgGvCqSGUrtIk1+e/3bzzybSaTBtCQABomVRwxlKyk8RcI0Esre7TLYQAsWXLEfVF/6Wn/izkBoaQteda9F1x615dXqg/JZPo+rz7xcEJpo3Yee1VyIrH39TrM8ldHsK/V4W9W89jmxPAEYIADOTtQKzLB5TnAoK+K0m1afH208g5V/xlg/MK1YJ+QlS4jNCYmGqXz+sjBkzUfOVb6Dgw2cEHQo7DnvHNhz4+lcwtO6toEPJCGEYfkHgnHoAwIF/+iriD90fcFTpYxPQ7RB6PAU3V+76o+RKAjBaVCZXBQosFPGqwBQpGxBfbFrX8p/HWw1IWQKwcfH8k6RQfwHQJwBZkKrXzQclV1yN6i9+5YhlVpbFiNB9711o/8H3/CFHIVfwoQ9j1o9vh+rvw7YLzoIaysrhZpNG8J/2uzzCwETG72WhXEwARpth+asCtRFeFZgKpfCLwoKBm441iXDKX91NJzd9GB6+LCQum+pr5RthGKj+u39A6Yrrgg6FTdLg669i3999AV53V9ChpN2M7/4Ablsb2r77naBDSRmbKLm3Tzn5tD+WXE8ARkSkwNxYBE0xC8V6fnYynSoFvFAYHVh+tCRg0glA88kN5yuSXxXAWZMPL3/JgkLM+uH/Irr09KBDYVPkHNiPPX92C+ydKe3RkXX0mmmQlgV7966gQ5kShfef9gdz/Gl/LGFJAEabZupoKIigNmJB50WBiSF17/z1W24Y610T/lJuXDz/JAj6rgQunnpk+UlGIpj137fyzT9E3NYD2H3z9XD27Q06FHYUCfX+036Yexe+0dOTMwWLE2VKgblRC40FEZTxqsC4kcLHFrzT/Njhbx93ArB+0aIyUyT+RQmxRgJcpTFZUsOsH/4vCs7ghZOwcd7bjV03rsq7o4LZjMifvNcd0qf9sYQ5ARit0jDQVGBhTtSEzrUCx0bq9/PXb/nI4W8e11dt0+KmiwSp23koz9SV33gLqv7q74MOg6VJ36+ex76//XxeHJHLZsMK6PYU4i4hT+77B+VLAjDCEAL1MQtNMQvlhh50OFlJASSGUbKgublv9NuP+dX69bJl+vSe/d8H8HkIfuifKnNOPSr/4gtBh8HSqOi8C1B0wcXoe+7poEPJOwr+2N1ulzCUgbG7LDs4RGgZGEbLwDDKDX9M8dyIBYPbDR4kARGXbi2Ad0e//agJwNuLFxdEevb/HBDL0x5dnqj4089CmGbQYbA0q/rLv0HfL5/N+4FCmTKkCN0OIe7R1KejsJzW5bj4Y4+L18Qg6qMmGmMWKk0eSEQA9iSMI74MYz7Wb1i40DRF4hm++aeOVlKKovMvDDoMlgHGzFmInf6hoMMINY+ALpewbdjD9mGFbr75H8RrH4BHhK2DCfyyqw9bBnjMd79HGHa9wcPfPuYKgG64/wkIbkmXQoXnXQBhGEGHwTKk+NLlGHzl90GHETqDitDtAr2eAq/yj02Ak4Ca5LHBOj42CABoSyiMVfJ3RAKwaUnTOQD+LAMx5RWzrj7oEFgGjbTNZVPnEdDj+q15h/kxnx3FSOOgxpiFEj4ieFCvS+h2FQSO/JockQAQ8PecMKWeMWNm0CGwDDJmzg46hFDwCDjg+NX8+f5Uy8Y23TTQWBDB7Kg5xi0uvzmKsGP46F0vDkkAfr1smY6uAxfwKf/UI9cJOgSWQWTzvmMqaAKYaUrUGP4qQLdHsHnt/5jyYQuAhwcdn0fAliEXzjFWzQ5JAGZ07aslKTmJSgNnz3tBh8AyyNm7J+gQQkUXQKUhUGkIDHp+G99eXhXIKzw+ePxc5d/8B4/T8vKQBKB5UJU2FnI2lQ6Jls1Bh8AyiL/f6RPTBGKagGcmVwVcQoJXBUKrQJNoiFmYF7VQwHv7x5VQhC2DHobH8TtxSALQ57qDQ56OKH+NU67/5RfhxePQSkqCDoVlQPzRh4IOIfQ0ABW6QIUuMJRcFYh7fvtfltukAGZZJhpiEcywDHBPn/GJuwrbhxS8cf4OHFEE2O54qNU4A0g1SiTQ+/jDKLvhpqBDYWk2/M46JFqagw4jr0Q1gZmawDQC4h6hx1UY4hMDOadIk2gosDAvGkGU9/bHjQDsGVZos72xbtUjAAAgAElEQVQJbYsdmQDYCjWmhMUpV8p13vq/KLp4OfTKyqBDYemiFFr/5VtBR5G3NAGU6wLluoZhRehyCXE3z5oECZFTsyikEKiN+JX807hr34QNeoQdQ96k2l8fkWIRgG2DXn79wmSIF4+j9VtfCzoMlkZdd67F8LvvBB0Gg38ufIYp0RTTMMOUiOXJA2Wu1MYV6xpOL47hmpoyLCsrwnS++U8IAdib8LBpwJ307IsxOwEOKsLOIQ9zuRgg5fpf/BW6bv8Jym/5dNChsBQb+MPv0PHjHwUdBjuMBFCmC5TpGhJE6HKAuKfybkpgNtAAzIlaaCiIoNrU+YY/Sb2uwq5hNeXi16MOA+pKHh7kJCD12v/rPyALC1G64rqgQ2EpMvT2m9j3158F2XbQobBjsITAdBOoIQ29yVqBAV7uTLsyXUNjQQRzoyZMmSdLMWmQUIQ9CQ/dTmqy12OOA+5y/GrCE6Ian7lMJSK0/su3QI6DsutvDDoaNkWDr/wee//mL6GGhoIOhY2TFECpLlCqa0gQ0O34rYZ5VSB1dCFQHzXRUBBBpcFP+1PhEbDf9tCWUCndnj9mAgD487U3Dng4IaYhyoWBqaMU2r77zxjevAnTvvpPPCY4R3XddTs6fvh9kHecjhssa1kCmGYK1EBDr0vocQn9OdxXgALuBVhh6GiMWaiPWjD4njElBL8wf1/Cg5uGb+lxEwAAGFaETf0uZkU0VJu8fJNKvY8/AnvbFkz/9r/BrD8h6HDYOHnxHrR++xvoe+7poENhKSIAlOgCJbqAfXBVgODmUEV9UAwpMDfi7+1XGLxtPFUEoNMh7Et4aW19Pa4EAAAUgN3DHnpchbqoDosTu5QZ3vgudq68AuW3fBoVa/4MQh/3t4UFoPfJx9D2vX+B190VdCgsTUwB1JgC1RDo8wjdTm6vCqRLlamjKRZBXdSEztvEU0YAuh2FfQk1rk5+UzXhO02vS3i338F0S2K6yZleqpBto/PHP0Lfc8+g+q/+FgVnnh10SOwwiZZmtP/Hv2HgD78LOhSWIQJAsSZQrAk4yl8R6HYJKarBykmmlDghaqKxwEIpP6ykBJFfc7ffzsyNf8SkvnuKgL3DCp02YVZEolTnbYFUsbdvxZ7P/RmiS05B5We/gNjpHww6pLxn79qJjv/5IfqefwZQXDKerwwpUCUFKnWgX/mTCfs9yr6eO2mKZ5ploDFqoTZm8djdFCEAHbbCAXvqR/omY0rp27AibB30UKQrzI5oiHHBR8oMrXsL733qRsRO+wDKbrgRhcvOzZ0OHyExvGE9uv/vbvQ9+xQX+bGDhACKNIEiTcAloMdT6HYIdpYkAqm8SkSkwLxYBI0xC0U8iCdlPADtCYVWR8EJcGspJes3fa5fJFimS8yISEQ4EUiZwddfxeDrr8KYOQulK65DyRVXQysuDjqs0CLXRd9zT6P7Z/dg+J11QYfDspwugEpdokIHBjw/Gcj1McUCwHTLX+KfzWN3U8ohoC2h0O6kp6p/og75zt5bVzefpLZpSi8o4CcCFicC6SCsCArPPAtFl3wUhWedDWEYQYcUCkNvvIbep59A3/PPwov3BB0Oy2Eu+Z0GuxzADmB/4K14Lzya+FZVTJOYF7PQEIugkAfxpFRCEfbbCl12as/xT4TwtBOu371l++i3pbyCY6SYodtVKNMlppsSUY0TgVShxDD6fvkc+n75HGRRMYrOuwCF516Agg9+CMKKBB1e7lAKQ+veQv+LL6Dv2afgHNgfdEQsJHQBVIysCiigx1WIZ+mqgAAwM2KiKWZhpmVA8NN+Sg14hP0Jhbinsq9WBGlIAEYcTAQchRJDYLopUcBZZUqpvl7EH3kQ8UcehLAsxJaejoIzlqHwzGUwZtcGHV7W8bq7MPC736D/ty9j8A+/hRePBx0SC7kCCRSYEtNMv6lal0tpL/YS40g1CjQNDTEL82IWX5fToNshtNoe+rOotaSHxBHBpHwL4FiKNIEaU0OpwVlmuuk10xA9ZSlip5yK6ClLYTU05V0RobPnPQy99QYG33oTQ2+9Dnvnjpwak8rCaWhkTLEHUBp+Ht+Ox8dsXiSEQK31/thd3qFNLQWg01ZozfBRvvHSlFe/ateunaPfltFDnH0eoW/IRSQhUGNKVBiSfwjTxG09gL5nnkTfM08CAGRhESILFsJqWoBI03xYTQtgnjAPQgtHZa/93m4kNm9ConkThps3I7HpXbgd7UGHxdgRolJgpikwjYBez08G0nnDKNI1NCaf9iM8iCflHALabQ9ttsqKwr6JCKSLw7Ai7Br2sNf2UKVrqDYF94xOM9Xfh8HXXsHga68cfJswTZhz5sKsrYUxuw5mbR2M2jqYs2uhV9cEGO3YvHgPnN27YO/eBfu93XB274KzZzcS27ZBDfQHHR5jE6KJkTHFAsMjqwIupaRITAqBuoiJxpiFGsvgQTxpMOQBrbaHLie4wr6pCrSNk6v8CUcHbKDckKixJPcSyCCybSRaNiPRsvmI9wnDgFZRCb2qGnpllf9nVRW0snJohYWQhUWQRUXQior8v8digG5AGMaxVxWUB3JckOtCDQ1B9fdB9ffB6/P/VH198HrjcNvb4La1we1oh9vRDq+9DWp4OI1fDcaCE5ECM0xxsFag2yUMTWJVICKBpcVR1EctWPy0nxa9rr/MH8+1x/0xZEUfR3/wgUKno1CoCdRYfndBTgWCQ44D98B+uJOpjhcCQtP8mQa6AXj+DZ88jzvpsakRItR1HBLvrwokiNDlEHo8QB3jv1kIgRINKNdE8sRVNGPx5ouRe1RrQk0qMctWWZEAjNbvEfoHPZhSodqQqDIFtDwrXst5RP4N33UB8FM7mzituBjmnHp/i2pOvf+/+rno+O//RN8vnws6vIywhMB0U6AmWSvQ4yoMjMqfo9JPFIo1AT5pnR4uAW22h3ZbhXL+Q9YlACNsRdiT8LDPFqgwBKpNiShvDzAWGkLTYMycBbM+eZOvqz/4d62s/IiP73v+WfT96vkAIg2WFECpLlCqa0iQ33m1UBOI8Ap/2gwpQmtC5fT+/nhkbQIwQhGh3Sa02wpFukCNwccIGcslWknJoU/yySd7Y3btuEdfe12daP3210O9/D8elgAsvv6lTdz1z+/3hmB/fzyyPgEYrc8l9LkurIRAtSlQYWjQ+XeBscAJXX//ab7u/SV7c049tNKyKb/+gX/6Krye7hREytihFAGdrr+/n43n99MppxKAEQlFeG+YsDdBKDf8ngK8PcBY+mklpe8v2Y/aozdm16atp0T8sYfR/+ILaXltlr/sg/v7BC9PV5ZyMgEYoYjQYRM6RrYHTIkSPj3A2JQIXYcxu/b9J/nRT/MlpRmNxT2wH23/9p2Mfk4Wbv0eodX229Tnu5xOAEbztweSpwdMgUreHmDsmLTSsrGf5mfNzpoOkfu/9mWo/r6gw2A5jgB0O/75/YEs6s8ftNAkACNsRdgzTNg3rFBmStSY3FyI5S9hGDBm1R7xJG/W1UMrKQk6vGPq/vm9GHzlD0GHwXKYf4xPod1RcPJsf388QpcAjBgZzNBpKxRoEjWmQJnB2wMshISAVlb+/k1+1HE6c9YsQGbH0/xE2Lt3oeMH3w06DJajBj1Cmx3+Y3xTFdoEYLQBT2H7EKAPK1SZAlWGBpPP0LIcIwzDn9kw+mk+uU+vFRcHHV7qKIUDX/kit35mE9bjEFodD315coxvqvIiARjhEmF/gnAgoVCq+6sChTpnAiyLCAG9vBzGHP/mbtX7N3hjzlyYM2fm5NP8RHXduRZD698OOgyWIzwC2h2Fdlshwcv8E5JXCcAIAtDtKnS7QFQqVJsSFYaA5JbDLEOEacKcXXtEAZ45px6yKERP8xOUaGlGx49/FHQYLAcMK3+Zv8PmZf7JyssEYLSh5GjiPQmgwpCoNiUiXDTIUkEI6BUVRzTGMevqYcycBfC0tkOQ62L/V74Isu2gQ2FZbGQaX69L4Of9qcn7BGCEN1ItaisU6RLVhkSJIbhokB2XsKxRT/OHtryVhUVBh5czOn/8ozFHUzOmiNDh+E/8+datL504ATgMwc8we10FMyFQZUpUGZJ7CuQ7IaBXVI5ZgGfMmMlP81M0/M46dN1xa9BhsCxjK0KrTeh0PHBdX+pxAnAMtiLsHfawf9hDmSFRZUoU8tzNUBOWBbO2buyn+YLCoMMLJUoksP+rXwJ5XtChsCzR5xLaHA89LuX7/Ke04gRgHBSATkeh01GISYFqS6LckOBnvhwlBPTKqiOe5A8+zXMxaEa1//D7sHfuCDoMFjAFoMtRaLMVBrlbX0ZwAjBBg4qwc8jDnoSHCl1DtSlhcSaQtcz6ubDmNR7a8rauHrKgIOjQGIDB115B98/uCToMFiCbgPaEh3ZH8TJ/hnECMEmuAlptD222h2JdoMrUUMqFAllH9fXCnFOP0qtWQK+ZFnQ4bBQ1MIADX/syoPgQVz7q8whtCYVul7//QeEEYIoIQNwlxF0XlgSqDA2VhgT3F8oObkcHOm/9X3Td/hMULDsXZStXIfbBDwcdFgPQ9r1/hrNvb9BhsAwiAF22QqvDy/zZgBOAFEooYE/Cwz7bLxqsNiQKuGgwK5Dnof+F59H/wvP+isA116LkY1fyMb2ADPzmRcQfeTDoMFiGOIrQ5hA6bA8O3/cD4Wja4OFvO+TudG9d3XyS2qbMhRR+BZpAtSFRbvIgomwjo1EUXbIcZSuvg9W0IOhw8oYXj2PnVcvhdrQHHQpLswHX783f7XDTnkAp1Xb9rp3TBA79NvAKQJoNeIQdnof37JGiQQGLOw1mBTU0hPhD9yP+8AOILlqM0hXXoeiiSyEMI+jQQq31O9/gm3+IEYBux+/WN8DL/FmBBB48/OYP8ApAxgmAiwazmFZWjpKPXYnSFav8I4EspfqefQr7vvjXQYfB0sAlSnZTJTh8eD97KOVohr5g1dat2w5/FycAAbKkQJUhuWgwG0kNBR85E2UrV6HgI2dxb4AUcDs6sPOq5fDiPUGHwlJowCO02grdjuJl/mxE+PoNO7d/Y6x38RZAgBKK/KLBBHcazDrKw8BvXsTAb16EMWs2Sq9eiZIrroZWUhp0ZDnrwDe+wjf/EOlyFVoThAGPj/FlLVK/mllX923s3D7mu3kFIMsc7DSoS3CpQHYRloWiCy5G2crrEFm0JOhwckr84Qdw4BtfCToMNkXuyNA0R8HhoTzZbp2jvLNv3rXrqFk3JwBZShMClYZIdhrkTCDbRBaeiNJrVqH40uUQViTocLKas38fdl59OdRAf9ChsEka9Ahttocuh8DP+7mA3hSGcdH1LS0dx/qoQ+4sd9TVTTOktj+9gbGJEACPJ85iWnExij96BUpXXgezti7ocLLSe2s+icHXXw06DDZBREC36/fm7+dq/txB6slhy1q1prm573gfesj9hABxT/3c7QKYk7bg2KSZUqDaFKg0NB5PnG2kROz0D6Hs2utQuOxcHg+c1H3vT9H23e8EHQabAFcBHa6HtoSCzff93EL4jrlz+z+uAMY1WvOI28jd9fX/n4D4VuojY6kiAL/TIBcNZiV92nSUXrUCJVeugF5REXQ4gbF37sDOlVeAEsNBh8LGwV/mV+hyFXh7P8co1QbIm27Ytf3pifyzI+4eP62pKZCR6EZIWZu66Fi6xDT/KGGFISD5qFpWEYaBwnMvQNmKVYguPT3ocDJLKez65LUY3rA+6EjYMRABPcll/j5e5s9R9IgS4k8/uX1720T/5Zh3jHvnzj2DPPUCpOSWaDlCE0CF4XcajHDRYNaxGhr9osHlH4OMxYIOJ+061/4YHT/6QdBhsKPgZf4QUKqNNPmFG7Zv//lYXf7G46h3invr66/3IO6Wx/gYlp2KdP/0QKkmuX9NlpEFhSi+7HKUrrwO1gnzgg4nLRLNm7DrhhUgxwk6FHaYIUVoTfAyfy5TAEnQTwzH+fLKPXu6pvJax7w93F1ffwMpukNKyQ2DcpApgUpDQ5UhYPCqQHYRAtFTT0PZilUoPP8iCE0LOqKUINfFrlVXIrGlJehQWJI/slyhNcHL/CHwR0/gczdu3/56Kl7suHeFe+vrzyeI+wFwC7QcJQCU6n7RYBEfH8g6emUVSq68BqVXr4ReXRN0OFPS/sN/R9ftPwk6DAbAI0K7TWhzFGx+3M9pCtglBP5h6/bt930DqWvFMK67wU9ra+sh5f1SyKWp+sQsGFENftGgqSEcz5zhITQNBcvORdnKVYh98MNBhzNhQ+vfxu6brgfUuE4gsTQZUn5v/i6HoHgoT05TQLck9R2H6Ec379qV8uM0434c/MXChWZicPjrQuCLAPiQc46TACpMf1UgytsDWcecU4/Sa1ah5GNXQBYWBR3OcanhYexa8THYu3cFHUre6nEIrY6HPpdv+rlOKdUvhfyhQ953j9XKd6omfOW/u77+dCixVkgsTkdALPMKNb9osMyQXPGZZWQ0iqJLlqNs5XWwmhYEHc5Rtf7zN9Fz371Bh5F3PAI6HA9tNiHBy/xhMECgH0nD+N7x2vimwqSu9///0qVGrKvrc6ToG1LKwlQHxYKhC7+nQJUpYPKqQHYRAtFFi1G64joUXXQphJE9J3QHX/kD3vvz1YDiLvGZMpys5u90FPfmDwOlEqYm1zq6/vVM3PhHTOkqf3dt7XQh9W8pgZv5uGB4CAGUaBLVpkCxzrs92UYrK0fJx69C6TXXwpgxM9BY1EA/dlz1UbgHeIRIJvS4fre+Xpdv+2GgC6DG1FBlSr+9O9FLJMRdGMYDC8bRy3+qUnLT/umcOUsgxD9LiEtS8Xose1gH5w9IaNxUILtIDQUfORNlK1eh4CNnIYimDwe++iXEH38k4583nygAHbZCq614mT8kTCkwzZSoPFoHV0WDJMWDgujOpvUtL4oUVv6PltIrxr1z5iwjkt+ExJmpfF0WPCmS8wcMiQKeP5B1jFmzUXr1SpRccTW0ksyc2O1/8QXs/au/8PvJspRLENCW8NDhKPDx/XCISIFploaKiUx2VWoXSblWQt3RtG7L3lTGk/IrOQHi7vr684WifxRSnpHq12fBK9AEqg2JMlPycZAsIywLRRdegrIVqxBZtCRtn8fr6cbOq5bD7exM2+fIV72uf4yv11WT6+/Ksk6hLjHdlCiZQh8WpeBJSY8QxI/mr2t+abLtf0dL26McAeKeuhOWCeF9BUKel67Pw4KjS6BC11BtSVi8KJB1IgtP9OcPXLocwoqk9LX3/d1fou/5Z1P6mvlMEdDpKLQ5Hoa4jUJolOoS06zUT20l4C0IfO9AybRfnPPSS+5kXycjl+17TzhhqVL0RQKu5mLB8BEAipPzB0q4aDDraMXFKP7oFShdeR3M2ropv17v009g/z/8bQoiY7byO/W12wSPt1JCQQq/ZqomAw9GCtguCN8+UDbtp5NJBDJyM96wcGGhrrsrhwmfbbPVyR3coSq0TClQZUpUGcmqVpY9pETBBz6E0pXXoXDZuYCceLLmtrdh51XL4fX2piHA/NHv+cf4urmaPzR0IVBjClQZGjL/HKSaAfGlpnUtj05kayCtWwDNJzeeRkp8Sgi1Cni/X4CrgPZk8wqHE4FQEvCLBmsMiQLOBLKOPm06Sq9agZIrV0CvqBj3v9vzF5/CwO9+k8bIwosAdDqENtvDIFf1hUZUCtRYEhXZ0EhN0XMC9OdN72zZPp4PT3m8ry9dGit0+lZB4DOAOPVYH8u/EPkhpgFVhl/5OuaRFxYYYRgoPO9ClK1Yheippx3zY3seuA+t3/pahiILD5f8s/ttNsHlB57QKNYlpmVjrxRFg0LSFxrXbVl7vNWAlF2N3z15QYNU6jMg3CTkxCcH9rl+H+seh39BwkoX/vyBKkMiwp0Gs47V0OgXDS7/GGQsdsj7nL17sHPFx6AGBgKKLvcMekCr46HL5mr+sBAAKpL7+1k/Q4XoTtfV//SkjRvto33IlP8LNi6af6qU3j8oyKtSUeCXIKDN9tCRUOBi2PAq1v29stKJnIdlGSELClF82eUou/Y6mHPnAUTYvfoTGHozJSPIQ40AxF2F1oRCH69qhoYugOrk8DQ9h1YxifCUNuhd2bh1a2Ks90/6v2Tz4sYzQPgqpLhw8uEdnQegk7tfhZ4pgCpTQ5UpcuoXKy8Igeipp8GsrUP84QeCjiarjQzlabUJNl+vQiOq+a16y43c7XlCwNoF65o/Ndb7JnzF3bh4fqMA/asQ+PjUQzs+gt8Yo832EOcxl6HlFw0KVJtays/MMpYuCeU37em0ecUyTEp0gRpTZt/+/mQRXTJ/fcszh7953Ffalg/MK1YJ+U0o+gyk1FMb3fgMK7+YpsPmCVhhFpV+TwEuGmTZqjd5jI+79YWHFP7+frUhEQ3ZQwgBLy9Y17zs8LeP679y05Kms4VSd0LKqXcRSQEv2TWLtwfCTRv5hTS5aJAFzz+1pNBmKz61FCJGcuBZlR7E+f3MUAApRys+aePG/tFvP+aTPAFy8+KmfxbA30+maUi6aMmCjCpT8vZAiHmE5PEphWJNoNqSKNFkEEPvWB5zCGi3PT7GFzIFmr/MX5YN5/fTTAJiSDizAWwa/fajJgC7PzQr2jwUu1sAV6U9ukkS8PdqSnT94PYA78WFU69H6B30YAqPiwZZRgwpf5m/y+Etx7AQAijTBWoMLe8alB0YPHLrfswE4PWlS43+of4nJHBu+sNKjYgUqI1omBnR0GkrtDsKQ7xMFzo2AXsTHvYlgDJdojoNgzZYfhs5xtfL14/Q0IRAlekXGZt5eLkYcAk9Hh3RxGPMBKDQ7fseIHLm5j+ahvfPa/Z6ye0Bh7hQJ2QIQJer0OUqxDSBKoOLBtnkKQI6kzf+Ya4rCo2oBtQYGsrz/NrQaiuMVfJ3RALQvKjhTIL4fCaCSrdiTaA4qsO2/IlbHY4Cz94In0GPsMvzsDfhdxqsNiQsLhpk4+AoQptNaHc9vjaESGlyOmlojvFNQZ9HyaFT2hHvOyIBICn+PhNBZZIpBWZZGmaYGrodhVaHq3jDyCWgNaHQllAo0iVqTMHjidmYBj3//H6Xw8f4wsIfwyv41NAoLgE7hjwQxj7yd0gCQNdAa95MFyOkXzyZ7EVfYUoMeAqtNqGbLwCh4zePUuh1AVMqVBsSVaaAlsdLgMwXdwmtCY/390PESvYNqTQkuBzofYoIWwbVMTtTHpIANDc31kKKQJr8ZFqBJjE3CrgRifZk0aDNS4ChYyvCnoSHfXZyPLEpEQtpgsvGRgA6HIU228MQHxEKjaJkNX+JLvho8GE8ArYOehg4TqJ7yM3+XVvVnGgduU8QZroQmG5pmG5p6Hb9M+d93FMgdBT5syU6bYVCTaDKlCjPg/O/+cwjQqvtHw/m8/vhIAGU58o0voDYirB1yBvXNvchCcCArfqH83hUa5kuUaZLbjkccv0eoX/Iw55hD5WGhipL5uXRoLDy+/MTOhwFxTf+UDAlksv8GvLs+P6E9LmEbUPeuBPeI5b7222F2ZH8WgU43MGeApaGTsfvAMZHg8LHIWC/7WG/7fk9BUyJIr665KwBj3DAVuhxFfi+Hw6Fmn92v4zHhh8TEbDfVthvexP62R8zAaix8rNZwuH8lsMaqk2/E11bQiHu8cUljLpdhW5X8SCiHBR3CQdsj7fuQkLAX+avNiUKuKrvuIYVYeeQh/5JFLYekQAoANsGPcwv0DjjGqVYEyiOabBJQ7vtoZ37gofSkCLsGvawJzFypEiDxScJsw6R3wjqQEJhiFfnQkEXIjnjRcDg5Pu4iPwGP/tsD5P9FRiz4n/AU9g1BMyJ5vdWwFhMAcy0NMyw/KfGtoSaVObFstvoArISXaDK9KuNWbAUgA5b4YB97ONNLHcUaP6Nn4tyx6/fI+wa8qac/B71yF+H45e/1UV5JWAsAkC5LlGuSwx6fqfBLoe46ChkCECPS+hxXURGzhubErwokFkeAe2OhwMJXnkLi3LDn8ZXoPFv03g5irAnodDppKY8/Zhn/jscBQ+E+qjOF7xjiGkCczQNsyx/Xni7zf3Ew2hYEXYPe9ib8FCR7CnALYfTyyO/sK/dVuAt/tynC/jTPA0Jk28q46YIOGArtCa8lE67PW7Tn26HMOy5OCGm5e3xwPHSBVBj+jeGXpfQ7njo4UFEoeMR0Ja8KRXr/lMM9xxPLZeAA7aH9gSP9w6DWHIoTxmvnk0IwX+o3Jfw0tKoblxd/4YUYWO/i7qIhgpO28alWBco1nXYEf9kRYftweFMIFQIfgV63PUQ1ZR/ekDn0wNT4SrgQPLoLW+n5TYhgFLdfyDikd0T1+Mq7E2kd6z9uNv+KgA7hj10u4TaKB8THC+/aFBihiW5aDDEhpJFOXvyfO74ZPlL/YS2FC9xsszTRn4HDAmTV40nrMch7LPH18lvqibc97/HVejrU5gR0VBtctXmeHHRYH7wiHAgQWhNKJQljxHy08/RecmjTK22B86Lc1tUCtRY/jWO7/sT1+0q7E94GMxgBjypwT8egPeGPXQ6HmZFdBTzBW5CRooGZyeLBtu4aDB0CECXQ+hyXBRqAjXJbmbM5x+zVMk+/UFHwyZLAChJLvNzF82JIwDdjt/BL4hBVVOa/DfoAS0DLkp0gVmWDm4bMDF+p0G/4xV3Ggwvf/aACyshMC15jDBfL5WO8pf6O2xe6s9lugAqTcnL/JM00s+i1faQCHDgTEpG//qFUA6qTInpJv9ATMboToMdyU6DDmcCoZJIdhncl1CotvyLZ74sng15QKvtodNRfComh0Wkn8SWc6vsScm2fhYpSQBGtCcn6FWYEjM4EZgUUwAzLA3TLX9pqN1W6OPN0VBxiLB32MOBhD+NsCbE0wjjLqHN9hDndf6cVpqsZ+Ht3smxCWhLeGi3s+tYa0oTAMDf0+hIzl3nRGDyRgZilBsSQw384iEAACAASURBVIoOJlc8njg8/AI4D222F6qCQVcBHa6fvCa4tiVnaQKoMiSqeB7GpA0rwoGEh84s7QeT8gRgxEgi0GErlBsS0y2JKCcCkxI9ZDyxQrsTTMEIS4/RBYMxDagyNJSbErlUUkMAel1Cp+OhO0svdmx8uOX11PV6hNZEdq18eUgcEUzaEoDRuhyFLscfqlJjaijmatFJGV002Of5qwJdKeoJzbLDoAfs8jy8l1Ao1f2JhEV69hYNDnjk/367BIef9nOaX80vuKvlFHQ6Cq22ysgZ/okyhAgmARjhFwu6iGl++9QKg3/QJqtIEyiKaphtSbQ7hA4nPa0iWTAUUXJVADCEh1JDokwPPhkgAH0eocdR6HGJJ/LlOE0AlYZEtaXBytYsM8t55Ne/tdke7Bz7dchoAjBi0CPsGPKwJ6FQnewYpXFF6aQYUmCGJfxOgw6h3fbQm4XZJ5s8J3mBabcBTXgo0gWKNYliXaR9PgfB73LY5xF6XUKfy3UoYcDL/FOXUH4vi1yuzQokARjhKMLeYcL+BKHC8BOBaAiKoIJSZgiUGTqGR4oGHcXd1ULGI79VaI/jF4HoEohJiQIpENUEohpgCUzqiJZLQEIpDCl//segSxhQ4G6VIVKq+zf+oFeSclm/53f67AlBz5ZAE4ARigjtNiWnq/ldpUq4TmDSIlJgdkTDTEui0yG0O9m5J8WmzlVAr1LoPeztugAMIWBIAQ3+Ui8EIMj/U8H/vXNIwFX+/j3XlYaTBqDC5PHVU0EEdCePtIZplktWJACj9boKva56f4mKG05MmkwO5agyJQbckfkD3IglH7gEuEQYOu4ePf80hJUl36+14oXVyTm4v++oUNa7ZF0CMGJYEXYPe9ibGGk5yWdRp6JAF6jXNcyOaGhPNhgK4w80Y/muRE827dEFL/NPUoKA1oSHzixr3JNqWZsAjPAIaE34Q0OKNX8Zi48RTp4ugOmmxDRDotfzv67ZdFaVMTZxUvhHRqtNmfbC0DDrdxUOOP41Mdf398cj6xOAEURA3FWIuwpRTaDK4O2BqRDCP/dbokskCGhPzh/w8uGnnrGQsCRQbWioNHmZf7IIQKdDaLPdjI7izQY5kwCMNuQRdnujtgdMPsM6FZYAZlkaZpjJ+QOOClWhC2NhU6z7+/vFmgQ/A02OS0Cb7V/v8rWJVU4mACMObg8kFIqT+158emDypPCrhStMiUHljyfucnL3jCtjYSKFPx9kGi/zT8mgR2h1FLpz+Px+quR0AjCC8H6XwYj0q94rufJ1SmJSYE5Uw+yIRIdDaOPBLowFwpR+j5QqU3DDtCnodvwbf7+b77f994UiARhtWBHeG/awb9hDueH3zefmQpOnCYEa0y8u6nP94zBxHvbCWNoVaQLVlkQZ9+afNI+ADsdv05v4f+3de3Bd93Uf+u/67ccBQBAk3hRFEQRJWbISJ27VW89N3CpO0qRP124c6VrPKJYtS7Ryk2nS3Ny5N4rbOtM4cW1Kih35EVumKEdy06R5OGO3ia/aTsbtrXpjx5FCmQIBkSKJBwESIB5nP9a6f+wDGRQB8AA4B/ucfb6fGY9nQBBYks7ee+3fb/3W4nP/KoVLAJalQHbcLVZ0eYL+0MPugMdiNksAdPkOXb5DVLJs/kCUImYmQFQzAqAndBgMPHQ00zjIBpO16TVciNJCH+PbqsImACvNpobZxQRhWTAQCvoCDywV2LzQCa5fMX+gaN2xiLZbUFnm7wsdAt6bNm220q3vUsJVymq0RAKwLFLDmSXD2SVFT5CtCuzg9sCmCYCeQNAT+FjUrE7gQmzsHU9UpR2VyajdAXvzb5ZaNoZ3Ik6xyNf9DWmpBGCZApiKDVNxgh2V7YFebg9sSbsTDLV52FcCLsQpJmPDIlcFiFbV4zsMlBw6+QKyaZEBE+xfsiUtmQCsNJ8a5hcTnFkC+gOH/tAh5BGbTfMEGAg9DITAXJKtChRhahbRVvkO6Pe9yj0m72ia11yaHVGeYTX/lrV8ArAsMeBclLWB3OULBgKPLYe3aKcv2Ol7iNVhsjKVsFUbblDraneCwZJDj+/Ad4vNMQDTkWKck01rignAG9jr89aT1ycScprW1gROsLckuK7kcDHOjhLOcf4AFdwu32Gw5NDFm8emxZaNiZ+IDAmXEWuOCcA6licSnllK0RtWegowhd80AdAdZAVPi2l2TJPHdKhIHLL25IOhQ4n3ik2bTw3jkWKG48vriglAFRTZTOjJSLGzUjTYw7M6W9LuAfs9h+vbHKajbCrhtWfXEzWm0opjfHzh37zp2DAep5jnCuG2YAKwQXOpYW4xwemyoD/IphIGzPQ3zQPQH2bFl3OpYTJKMc3uQtQkOn2HwcBhty8cyrNJiVVesOIUEev6thUTgE2K1XC2bDhXVuz2s+2BnSwa3JKdnmBnu48b2gyTkWGKNwRqQAKgN8iGj3XwdX/TFlJgPE45lCdHTAC2yADMJNmRlHYP6A889IYO7OK5eYGsKBpMsu0BFg1S3nwBBkLHVb8tMAMuporxMkeONwImADW0mAKvpileqwwiGixxbOdWCIBuPxuGsphm8wdYNEjbrc0J9vAY35YkCkwlKSbKiojP/VyYc4tv/BoTgDpYOYgoKxp06AnY+WMr2j3Bfk+yosE4e4NYYtEg1dEuP2vT28VpfJu2qIbxsmI6UfByzY+qTt01Njpx5xu+zgSgzrKiwRSny8qiwRrwUOnYGDhcThTjsWEm5g4i1YYToDfIjvFx9W5zDFkvlfFYcZnd+hqDc78nuPpEJROAbcKiwdrr9B06fSAuucqKi7HTIG1K4LJJof2+B77wb05qWfHuRKyIeB02DAVSMf3Yan/GBGCbXV006NAbeiwa3IKs06CH60rATJz1a5hjgRFVoaMyja+H0/g2bUkN5yPFNCeBNiQxfPSe0dETq/0ZE4AcZUWDijNLyk6DNZCNJ85u5hxPTOvZ7QsGQ4+rcFtwMTZMRClmmWw3LFP9rwt9vY9idPU/ZwLQAK7qNFjKKt95a9q8leOJpyqrAiwabG2CrE3vQODQzvP7m5IaMBWnmIgUZW7vN7qXNG5714MvvBCv9Q1MABrMXGqYW0gRiKKv0iEv5L1q0zwBBiurK3NJNlTkIguTWopfGVHdHzqwg/fmLKbZ3v4FNu1pDqrfjmF/7/6zf31hvW+7IgFwqnMQVsA0gtgM58opzpVTdFdOD/A40uYJgC7focsHInWYiBVTsYK5QHG1OcGeyv4+d9Y251KiGI8Ms7xQmofpn4npe+5/9dWZa33rFZfFo4C7cfjgawD21C042rQ2l/UU6ON44ppQADOcMV44nZ5gT+hhVyDcRtuEbJlfK8v8vC6aix2d7+n5hfWW/Ve66vo4duDgr4rgl2ofGNWKE0GvnyUD7EVeG/Np9qbD8aPNqzsQ7Ak87GBh36YsLRfORspum01GgRkneODukZF/v5G/d9WVcnz//u5U/Jecw2DtwqN62eE5DITCY0w1kphhIjJMxsqeAk1gubCPjXs271JiGI8UcwmT3yb1NfPc++45efLMRv/iqlfM8QMHftzEfQUAN52bhC/frXAOeSPcMkPWU2Ai4tCSRuQJMBBkxZ3srLlxKYCpiMv8Te6iwX7h7lOnPrdal79qrHnlHB8efsggn9x8bJQHEWCXJ+gPPeziUmhNLKTARJRiOmYFdN4CAQZKHgZYB7Mp2TI/h2o1OzMcTyz9+fvHxs5v5eesewkdGx5+2CBPuGt8HzWmkgD9oYf+UOAJ/xNuVaLLxVEpJ5pts7BS0d/Hiv5NuZRUmvYkxmX+JmaKb4noI3ePjv7nWvy8a15KXxw69C7n7GkAO2rxC2n7OQF6fEFf6KGTr001MZMoJspsOVxvJSfYGzr0hKxx2SgFMBWlmIiMTbCanCrGnej/HY6O/vbtqN3iTVXX1LH9h28RL/0yILfU6hdTPjqcYKBUORuddzAFsJAutxxmAVUttXuC60oO3Z4DF682pmzZltVUpGB+2txU9bI4+Vipvf03bn/xxcu1/vlVX1pPXnddx46w/dfg8KFaB0HbzxNBXyAYCB1KXFPdssSAySjFBCcSbkmbE+xdboXNj+WGzK5o2sNPYHMz1TKcPGkiH7l3ZGSiXr9nw5fY00OH3gGkn4Zzh+sREG0vAbDTd+gPHHazccqWLZ8eOF9WLDARqFrJAXtDD70h16U2Qs1wobK/v8iqvqYnAPpDhz0+4tDzfl+cfuHsrr1fe8fzzyf1+n0b9uy+fe1xEPwfpvaL4lyp1kFRPkLJPnz9oYPP168tu5wazpeVswfWEbpsqb+PfSw2JFLDeGSYihUpp102PSfZaPjBknf17BfVcxD5bQCfu/lbL5+q5e/d0jX3xf37h8Xzf02An6xVQJQ/QdZVrT/0sJNFg1tWNmC8nGKKo4lfVxLgujYPvXzwb8hcYpiIU1xMDPwoNT8PQH/JYTD0rjmoSgETxVdE7ImbvvXy1wRbP5Vck2vv+NCh/zWV9NeduB+sxc+jxtFeKRrsZdHglqWWFQyOR4qkRW/eHZU+/T0cy1c1BTAdK8bLikVuKxVCIMBgyaEv8LCZdi2meBGCj6WJ9/T3vvhitNk4anYVGiBPDw//QwD/SiB/o1Y/lxqDJ0Bv4NAfeGj38o6muakZpmLD+UgRtcgNvct32BMKJ1puQKTARKSYitOWTRiLpuQEgzXsZ6HAGQf7N0nsf2YziUDN03AD5Omhg+8yh192wFtr/fMpf52+w0CQzR+grZmODeNRivkCntdyAvT6DgMlh3aeNKna5crRUg6mKo4OJ9hTOX5dF6qjIvilN33rO89upC1w3a5KA9xX33TwF+YS+/mySV+9fg/lJ3CC/kDQF3hg8fbWZDf9FNNx89/y25ygL3ToD9iBslqGbJl/ItJCJoOtqst32FNy6NqmWioD/jMUH3jzX544Uc331zyqb99yS48fJPcp5AMOuBkA5lLD+XKKS1zHKqzdlTe97fqgF1WsWWX3VGxNNaTFA9AdOPSGjoWjG5BU6kImI0PMqr5CEAA9ocOeMJ+VLwXKYvgXN3/rxOPXWg2oWXQvveWmt8DhZ0xxl3NoX+17FjXb95yOuLRVVG0uay7Uy2EtW2IA5pMsEZhJGrOjmwDYHQi6fQ+7fcDxbb9qC2k2gneay/yF4VeO8jXKhEoDnt3RPn///m+cWVzre7YUpQFy4vve9A4YfhFOfqzavxdXzrBO8gxrYTkR9AaC/sChg5nAlhiyLm8zseFSoshzl8AXYJefNY3q8h1YD1o9A3Axyar5OWK6OEoOGAw99IWNd1LKDP9pR8f8O9dKAjZ1ZzZAXnrrzT/qNP0ViPuBzQa33MVqvKwcVlFgnV62KtDNM99bZshW0mYTxWySrRLUswGcJ4JOT9DlZx0j2x27RW5UaobJyDARt86pj1bQ6WWFfbsafF6FAcfe/M0T9672ZxsO+6W33HyrIP11OPeOrYeWMVw5rpKKKRCgL8jGE4cNsERWBGZA2QzziWFBgSVVLGnWKW4jV5IHoOQJSiJo97KtnB2+Q4n/mTZtSbOXmwsJG0AVhQjQ7WfL/M00WdUM73zzt0784Ru/XvU/wYv/y829EtmvGfDTro6nBxa1Ml0t0q23OaKGJALs8hwGeC68bgxAqkBs2UpbCoNCABgE2Vu8J9mSfuCk4ZYum9mlJNvfn+NQnsLwKi8vg8368mL65zd/6ztXNeqr6p/kxPff+O5U3ZPOob/2ka0uUWAyUUyWU0S8igqr5AT9lcYYm+mIRdQIuJ1ZTKVKUXNf2Nz1LgqYLGHXm0+cmFv59XVvud++5ZbQ89OPi+Dh+oa3vplEMVFWzLFwprCcCHr87GJj0SA1i8iAiSjFZGQsaC6QnZ5gsAn29zfiUpR879teeuWvVn7NX+ubX7n14K44Tv4DRG6rf2jr6/az+eALKTAepzxGWEDL7XGnYkWnl60K9LBokBrUfJrNdJiJN1ZrQY2tN3AYLDl0NOMy/zoMwJny1QM4Vk0A/uL7vm9HlJS/KoK31T2yDejwgGHPww0lD1NxiomycnuggC6nhsuLKU4vpegLHQYC15z7blQoZsBMZX9/PmWFUlF4IhgIspqkRji/Xw/zqWEp0YU3fn3VBKANS58GpKEe/iv5AuwJPewJPW4PFFhiwPly9qbV5WWVt7tYKEDbLDFgKlIe4yuYdg8YCDz0BlL4JlbjZcVqO/5XJQAnvv/Gf2SQO7cjqFr47vaAYTJSXEgUvEaLxQy4lCguJcqiQdo2Zc3e9qd4IqlQdvuCgdBDV4vcQGaTrJuorFLGeFUCoHC/2Iz/Wjo8wVC7h33mMBUrxiNjtl5AZTWcWUpxtqyVokEPHc1cnksNZy7JWpbP8hhfYTgR9FWW+dsKusy/mlgNp5bWbhV2RQLw0k037TTg7c38r8cTwWDoYSAAZtMsEZhNmL8XzcqiwR2+YDBgp0HaPANwIc6akS1wO7EwQicYrKwYttrhotSA7ywmiNd5/F2RAJRLMtQGK8S/Jqn0LN/lA0vqXm8uVM+2qZSP+cQwkqQIltKs02DJISzEp5jqLa1M45uIDTFXDAuj03cYDBx2+1KYY3wbkWj28F+4xgPvigRgfHGpZ6ijVM+4ctHmBPvbPFzf5uFCpJiMFYvM8gsnNuBclOJclKI7EPSHHscT06qWKvv7F2K26S2K5TG8gy0+gKyshu8spFU1pLoyAUjd9B41lAq6R+IBGAizavLZ1DAZpbjIc7yFNBMbZuKkMp5Y0Bt6Td3Ji2pjLs269V3i/n5hBC47xtcXOlx90r21XEoUI4vVT9m9qghwMlbsKxX/VtnlCbrafUSlbFLXZJyCc4iKZ0kNry4ZXltS9IYO/YGH9uJ/vGkFM2AmzcbwznPlrzB2eNn+Pmt/Ko1+lhQTUbqhxPaqBGAiMgwE1jKNV0InuL5NsLfNYTpWTETGJh8FlALZXm+k2FlpOby7QG0+6WqK7/4354mg4ugJsgf/Do8jrABgITWcWkyxuInP+NXHAM0wspji5h1rdgkuJEHWBrI3ABbUYaKsmI55/reI5hLDXJIilBT9oUN/6LGnQIHEahiPs/P7XNUrBl+A/tBDf+AQ8rkPIHvrP1tOcb68+e2sVZ/ylysZxXCLrpV2OMGBdg/7Sh6m4qxosMw3iMKJDHitrFlPgcChv8lmfNOVFlPgPGeFFEq7y4by9PgOLbIoXZXZRDG2tPXn0pqv+RfibHr4ULvXsvsrvgP2lBwGQ4dLlaLBS3ylKJzsDLjiQqzY4WWnB3oDadnPfbPJCvuya5NXZ/MTALuDrLdHp8/X/ZXKajhTTjET1+aTvu46/1SsiA041O4K3yt5PSJZ+8jdvo+yARNlxVRcfaUlNY/51DC/mOC1JbCnQIObibOOfazZKQZfgN7Aw0AohT2Jtllp5YjzRLm229LX3Oi/lCheWjAcbPPQzuVRlAS4oc3h+pJgOsmaiLBzWPFc0VPAdxgoOezk578hTMWK82Wt6pwzNb5WGsqzUQZgMlKcLdfnlFpVlX6LqeGlhRQ3lLJ9UlruLZ31l75cGUQ0HXPvsYhmEsVMomh3goGSQ2/gwKtge6lVHvys6C+M3ZXEms26rrbcmvpsOa3r573qUn81w9hSipnEcKCN89lX6vQEne0ebmjzMBkrJnmTKqRFNYwtpnitnFYGi3jcHqgzBTAeKSbKKWq07Uk58gToC7Nrp8Rr5yoGYCbOCpO3Y4Vrw2f9ZhPFt+cNe0OHPSW+B63kC3Bd6LAncBxEVGCJAufL2TI0twfqI7XswT8esdamCLKOnFm3Pj41rmYGTMeKc9H2bm1t6rC/WlaJeCHJugbu4iHqK6wcRFRWh8koKxrkAYLiWd4e6PCAwcBDT8iuZFuRAhgvZx3NeL00v11+1rRnp8/rYjUGYCrKtrbyOGq+pW4/i6nhOwsJunyHfSXOZV9NyQn2tXnYW3IsGiywhRQ4laY4U/5uc6FW70u+EWqG8dgwHqXgollz8wD0htnxaVbzry4FMFlWjMea6xTKmrT7m00ULyXZcuj1bfyPvhoWDbaG2ICzZcW5sqIndNgTOrTzeliTGjAZZ93MuMff3Eoue9vvDRy4I7a6uHKMvFFmz9Ss368BmE4U05ezoSt7mf2taWXR4FSkmIhZNFg0BuBCpLgQKbp8wWDIrbKV1AwTUfbGzwd/c9vlCwZCD10+m2etpayGc5FiOmqs9vJ1afi/fOPrDSpvQEwHV+ULOw22gtnEMJtko4mvCx16W/gobWqWVfXHyqX+Jra8ojkQOrTxRW9N86nhXFlxKVU0Yi1rXSf+XIizZe5dQXbj4/Sm1b2x0+BklGIyMlY/F8ySGk4tZccIB0MP/WHrND4pa/bgn4oNys910yq5rGlPX8hl/vXMVOpZLjdQvVeK8lXB1H3knwG4GBsuxil2eorB0MNuVketqSTAvpKHvWF2HnQi5gzzookMOF1OcTYSDITZvqlf0ERgLjVMlLOTEtS8uirV/F0cob0mRbb6Pb7NR/mqFYhsfwKw0lxqmFtM0Fb+brEIV49W5ySrpO0NHeZXFA3yNlocqRnOlQ3nKwWDg4FDRwFeqxTAdGWZnydempcTQW8gGAg8tOhg2KrElVXbiSYcP72tCcCyJc26Cr4Wpej3s+EPATOBNe3wBDsq44kvJNlAiKsXc6hZrSwY3OE5DASC7iZMji+nlv1zMFFtaqETDIbZiSWPr/trWkyB8Sht6hezXBKAZYlmA1fOR0BP4DBYcuhotrveNvIdMBh6GAg9zCVZp8FLXFotlPlUcSoFXi1nQ4h6w8buMriYGmYSxYXYcmlkQrXT5WXV/Lt84TL/OmaTbJm/CAXbuSYAy1bOY+/0BIMlh93sHLUmAdDlO3T5QKQOE5FhqkHOlVJtpJXhN1OxIhSgO8yuiU4v/6NW86nhYpw9+Btxr5Oq5wToDRwG2K9iXcvPqPGyYrFAn/mGSABWupwaLi+kCJ1iIHDoD4XLUOsInWBfm+D6NpcVDUbaUJWntHWRZe1xx8sK32Vval2+w07PYTvGcSypYS7J/jebNt8+J12Ny/zVSQyYiFJMRsVsVNVwCcCySLN5A2ejSiEKM9R1CbJtlJ7AYSE1TMSKaR65KpxEgWk1TMcpgBSBE3R6QIcTtHuCds8hBDa1hKtmKFu2t7moWQHffGp84BfITj8r6tvNZf51LaphvFz8wuuGTQCWqRkmo6wKfqcvGAx4jPBaOjzBAc/DDaVs2WqiQY+l0NbFaphRYOb1ptIpgOwNLxBB4Aw+sopuEQAmAAxq2XemZojNEKkgYbJYSA7ZiSK+RF3bpSQ7vz/bIllvwycAK2XLkAlK5ez8dG/ggd1V1+YJMFC58Gcr57EbtSMV1Vakhgi2nA8A15w6wQ9F0YQCDIQe+gIHnz3Y1qQGXEiyLbZWe1FqqgRgWVkNp5cMr5UNPUHWU4CZ7fq6PEFXh4dIPUzFWafBmJkAUeHs9AQDJYduPvXXFb2+v9+6XVebMgFYpmaYigxTy9sDocMunh5YV+iAvSUP15WAi5VOg3MtstxFVFSC5Wp+jmW/lstp1pZ6Ji7y7n51mjoBWCnbHqicHggFfdweWJcA6A4cugOHxRSYjBUXovS7K8ZE1PBCB/SHDv28363LkLVWH4/YWn2lwiQAyyI1nFkynF1SdIfZpD02F1pfuwfs9xz2tTlciLKiwSKddSUqms5K057uIP++EI0sO8anmIwVMe9pVylcArBseTDDcnvVwTBrr8qLZW0OlbeJ0OHyiiEuvGyI8rd81HcgdNjRwN0hG8FCapjg/JRrKmwCsNJ8qhhZBPwlRX8o6A88tPBI9qp0eoLODg+JOUzGismyImImQLTtfMlOPfWHDgEP76/rYmwYj1PWNVWpJRKAZcmK6Wu7/WxVoJOVsuvyRXBd6OG60MPFRDERGWY5f4Co7jpc1ha9hyuX60otq2GajJTzKDaopRKAZQZgJlHMJEC7UwyEDr2BwDG7Xtdu32G3D5TVYTJSTMate3yGqB5EgG4/W+bv5DL/upY0W+afirjMv1ktmQCstFgZTXym/N2hGG0sGlxXyQn2tXnYWzJMJ1mtwAIzb6JN8x3Q53sYKDmEvP2sa3ka32xirE/aopZPAJaly9WikWKn7zAQOOxihe26nAj6gmygyPzy/IGIRYNE1Wp3WdOeXt+B7x1rUzNMxca25jXGBOANDFmGOZsowrJUztg6nrG9hh2eYNjzcEPo4UKSYiJSlLkuR7Sq3UE212QnbyzritQwHhkucNx5XTABWEekhteWUpxbStEdZMfjuC+3Pt8Bg6GHgdDDbGKYjFJc5JVLBA9AXynr1lfibWRdc4lhIs7uHSwzqh8mAFVQZFP1LsSKjsqSXU/gwPMDaxMAu3zBLt9HWQ2TsWIqVvAAAbWaksvalPeGDuzSuzYFMF2ZXrrAbn3bggnABi2oYXQxxZlyil7fw0DoUGImsK6SE+wredhb8jAdG6aiFJd5gVPBdXnZMb4uzidZV2TAZDnFZKxc5t9mTAA2KVFgPEoxEaXo8gX9oYfd3M9blwMqRYM+FjQ7PTCdKFjTQ0XhUDlNVOKE0muZW9FtlPLBBGCLDMClxHApSVByQH/A+dvV6HCCA+0ebjD3enUvm3hQswpcpVsfh/KsywBMR4rxmMv8jYAJQA2VFThTTnE2yooGBwL27L4WTwSDoWAgdJhLsvHEl2Ke76XmsMPL9vc5Z2R9sRomKtt/MS/uXMSet/DGrzEBqAO1lYOIBAOBQ0/IG8R6BECXn+2XRiXDRGSY4tEfakCCrCvmYImngq5lPsl6888wqc+X6sQ9Y6OT977hy0wA6mw+NZxKU5yOlosGBSXuDa4rdIJ9bYLr21w2wztWzDMToJx5AvQFHgZDQchreE0GZNdtpJjnMn9DMMHvCq7OFSwC7QAAGGVJREFUwZgAbJPvFg2CRYNVWh5/2hM4LKTAZJTiAosGaZuVKr0teIxvfYlZpZuqIebh/cahGvuB/7HV/ogJwDa7smhQ0B84Fg1WocMDhto97GPRIG2TTt9hT+CwyxdwTtja5lPDeKSYidkGvCGJ+8h7T558ZbU/YgKQo7JaVjRYZqfBar2xaHA8MlziMSKqkeVVp8HQoYPX4rqmE8V42TCf8vprWKZ/ev3Q0EcwOrLqHzMBaACrdhrkcJB1fbdoEIjUYYKdBmkLPMmKdQdCQcALb03J8tC0WBFzBa7RfTM2e887nn8+WesbmAA0mOVOg6dF0RdIpdMgb0jrCd/QaXAySll8RFVpW27TGwgc1/nXtJAaJqIU07GBOXYzsP8pQfDj9788cnG977oiAQhELqUs3mgIqWVTsCY4nrhqKzsNcjwxrWenL9gTsk3vesyAmSTrzc/W3U3E9I+XSqX3PnDixNy1vvWKz74Bcnx4+DQg19cvOtqssNJtrI/dxqqWGDAVZQ2GIi5ZtryewGEP9/fXlSgwlaSYKCsiXjLNxfCr4ejIL98OpNV8+1VXwbHh4V8RyKO1j4xqRYCs0yCLBqtmBsymWdHgLAsFWoonQH+YjeENebmsKVvm53yOpqQ6Abifunts5E828teuTgAOH+6SNH2RqwDNocPLjhJyD7N6S2qYjLKiQa5sFlcowGDJQx/P76/JDLhYWeaf48XQpOz3VeTBe0dGJjb6N1d9YhwbOvRD5uw/OfC6aRaeAL1B1mmwjUWDVVFkLZvHI8USX3kKo8PL9vfZn39tXOYvANUJ89zP3j0y8jurdfmrxprXx7EDB+8TwRc2HRzlZqefnR7Y7Tk2MKnSXJItf15MFayDbU5dvqsU9vFDv5ZFNYxzDHdTU8Ac7NNBHP+fd5w5M72Vn7XulXLswMH7TPA5rgQ0p9Blvcv7A55trlZkwGRZMclBRE1BBOj2HPaUWNi3lqz7qGK8zGX+AvhGKnjkvpGR/1GLH3bNK+bYgQM/JuKeBbC7Fr+Qtt/y9LKB0GEn346qogBmKtsDC3xVajhOgL5Kxz72yVhdaobJyHgCpgAUGBPBL50cGXn2w6hdK4aqrpwvHT58KE3T5wD5m7X6xZSPdg9Z0WDocVmnSvOV0wPsdZ4/v1LRPxjyKOxaFjXrzT8dG5T7WU1NgRln+qux2RP3j40t1frnV30JPXfLLWG0sPBhiPsXyHquUBNzAHrDbFWgnW9QVYmX36giRcIb67YKJOvY1x86cKV/dRdjw3icYo57V01PVS87cY/Flv76/WNj63bz24oNX0pfPHDgbTB8xjn3lnoERNuv08uKBlk1XR3OO98+oQB7Kkf5+NZxtdSAqTjFRGScjlkM8wZ7wgXBb9z18stT9f5lm7rfP3nrrUHHhZmfNdNfds511jooyocvWU+B/lAQclWgKpdTw0SlqppqJ3SC60rZqGx+Eq+2VKnmvxAre/MXgeosxD0uof+J7XjwL9vStXVs//7rxLmPqLifclv8WdQ4RIBdXjYZrcvne1c1IgUm4xSTkfL0wBaEAlxXeePnDeVqFyvHVdnNshhUdcqJOxpb+kQ9l/rXUpNr7IsHDny/iPs1AX68Fj+PGkfp9fkDDh6bClyTApiOsyNXi1ySrVrgBNdV9vj5KbuSIptnMR4pl/mLQvVVE+83FsoLn3vw3LmFvMKo6bV2/MCB29Twr8W5t9fy51L+nFTmDwQOO1iFVZXZxDAepbjEJYE1BZJ17esPHbjrdKWyARPllC2rC8RM/8rgfXSxd/eXHnzhhTjveGp+yRkgx4aHfxRmjzpxP1jrn0/52+EJBgKHbhZmVWUxBSai7EbO+3gmdMCe0ENfwAf/G2WJY7bMz89LMRjwvBN89M6RkT/ZbNveeqjbpWeAPD106DaR9P+CuB+p1++h/PgO6PU9DJQcSryJX1NsWSLQynUCbU6wp+TQy+K+K6gBF2LFRJxisapBrtToFDAx/T0DPnrv6Oh/yzue1WzLNXj80KFbVe0XDXgPiwWLRwB0VeYP7GLR4DWlAKaiFOORImqRWq5OL3vw7+J8iitEmnXqm4wMKXtLFMUCFL/tBe4T7z158pW8g1nPtl6KXzp8+FCS6M+J4H4AHdv5u2l7hE7QHzr0B46d2q7BAExHivNRcQsGe/ysXe8OfhiucDnNjvHNsJq/MFQxLs4e13Lpt+47+9cX8o6nGrlclU/tvbnXlcofFMiHAOzJIwaqL0FWNDgY8OZfjdnEMBGnuBRb42wQblLoslMjHEJ1JQNwITZMRCkWWNVXHKrfVs/92z7nnvmHJ0+W8w5nI3K9Or9y+HBpKrE7IPZzDnhrnrFQ/XR4QH/goTcQOK7/ritSw2SsmIoNcROtCjgAuwNBb+ChyxMu86+QWHZ2fyIytpAuEAO+CtN/e/fo6H9spMK+jWiIy3S5YBBOf9Yg72SdQDH5ks0f6A8c2vhmeE2ziWIqMlxMG3Ooiwiw03PoCQS7fW75vNFCCozHKaYjVvMXhapGTtzT6vDxe0dGvp13PFvVcJdsZfLgh6D2Pji3M+94qD66fEF/4GF3II33IWwwaoZLqWEmNswm+Z4g8CoP/d2Bw25PwJrPKxmAS0nWCGqOy/yFYaqTcPJJD/jUnadOjecdT6007L33szfdtLNUTn7KLH3EOXdj3vFQfYQC9Ice+kOBz3XjazIA82mWCMwlhstpfWsGnAg6HdDpO+z0BZ0eE7bVLA/lGY8MURNt3dD6zPSvROTjserxeozjzVvDX8uPAu7GoYM/Dmc/A8jfzzseqo+saFAwEHroZKfBqhmAhdSwkBoW1bCgQJQqog0+gwRZ2+eSA9qcQ4cDOnzhqOhrKGvWtOdCpODx/eJQ2J+I2SeaeX+/Gk11dR87cOAmwD1ipvdxCmFxtbuspwCLBjfPkDUeSsyQGKBZVxKYCUQMDtnbvS9ZO15PmuxmkLPZyjE+dusrDgUWBfaUqj5239jYS3nHsx2a8pp/7uDBXZHiPpg+AucO5x0P1YcnQG/gMBCyaJDylx3jU0xEymN8BaLAWQd7Ii2XPt0s5/drpanvqtweaB1dnmCAneQoB7EBk1HKY3wFo4r/Lh4+sdDd/e8aYTBPHgpzK31qePhNDjgiavfz9EBxsWiQtsuiZsv807GC/fqKQYFUYF+G6tF7xsa+kXc8eSvcHfSzN920sxRF91S6DL4573ioPgRAt+8wUHIsGqSaWj7GN8tl/sJQYNqZftp8/zfvOXnyTN7xNIrC3jkNkKcPHvxhmD0ikH8CcHJtUXV4gv6ARYO0eWrAhcqDf4nH+ArEXhRzRy+XF55+8Ny5hbyjaTQtcbf80tDQgcS5DwrwACC9ecdD9bHcaXAgcCixaJCqEKthIjJMJik4l6c4VPFHnrOjd5469adFPsa3VS11l3x237722AvvgLNHAPmbecdD9SEAdvoOg6FwPDGtaiHNzu9PxzzGVxSqetnBfUE8PHbXyMh38o6nGbRUArAsmz0w9DYTecQZfhLOBXnHRPUROsFA4NAfCjxuD7S8S4lhvJxyf79AFDYiKo+XPHz+9pGRS3nH00xa/o74zPDwYAo8IGofhHP78o6H6sNJZTxx6NDB7YGWYgCmYsVElGKR7fqKw/TPDDhaGh3949vBRoybwTthxddvu81/7fTpd0L1CMT9cN7xUP10eoL+0KEncLwACiw1w3iUjeLl+f3CWILiaTh77O5Tp/4y72CaHe9/qzi2//AtcPowWw4XWyBAX+Chv+QQ8koojKw/v2Eq1oYco0yboHrGPPebuhR+ptW69dUTb3vrOHb4cJek6T2AHAF7ChRat5+1HN7JofZNaz41nI8UFxMFn/sFYfrnBhxd6O39vVbt1ldPvNtVwQA5PnTohyB2RAXvcoCXd0xUHxxE1HwuJYbzUYq5hE/9QlCNzbnfUcFj942M/I+8wyky3uE26Njhw/skTT+gKh9wDoN5x0P14YmgrzKeuMSThA3HDJhOFOfLikU27ikEVYw7wadiS5+8f2zsfN7xtAImAJv03C23hPH80j9T6BFx7u15x0P1IQB2+YL+0MMubg/kTgFMRYrzkSLig78YFP+vODsatLd/+fYXX4zyDqeV8I5WA88MD3+fAg8DcjeAHXnHQ/XRVtke6Asd+0pvs9SAyTjF+TIn8hWBqiYQPAfgsXtHR/9b3vG0KiYANfTcwYO7IsV9EDwM4Ka846H68ATorfQUYMvh+kotK+ybjBTc4m9+pjoJJ096SfKpO0+fPpt3PK2Od686WB5EJGZHAPmn4CCiQhIAXb5gMHToYsvhmkoMOB+lmCwrO7wUgv1PNXc0teS5+8fGlvKOhjJMAOrsS4cO3ZCofsBU3s+iweJq9yqnB3yeHtiKRIHzcYqJyHiGv8kpkDrgdyF47K6RkT/nUJ7GwzvVNmHRYGvwRNAfZqcH2FyoetlSv2GinPKNv+nZBTP5NHz3yXtOnjyTdzS0Nt6icsCiweITAN2VY4SdHi+ztaQGjEeK8SgF5/M0vW+q4Ggpin7njjNnFvMOhq6Nd6YcPXfw4K6yyr0m9rADbs47HqqPTk8wGHroDni5Lcv69GulT3/e0dAWKAy/B+hjd42O/hcu8zcX3pEaADsNtoaSE+ypHCNs1Qsv1mypfyriUn8zU2BaYJ9RkU/eNzLyat7x0Oa06n2oYX1h343X+0HyAUA+AGBP3vFQ7QUiGCg5DAQOrbI7sJgC41GKC7HyFbGJqepfipPHFpaWnnnw3LmFvOOhrWmR20/zefLWW4PO6el3KeSIALflHQ/VnleZRjhY4GmElxLDRJTiEtf5m5kC9gem7ujdY688z2X+4ijobadYnjl06HvS1B7ieOJiKlrBYKLAVJI17ymzXW8zu2jAZy1NPnnvq6+eyjsYqr3mv9u0kM/edNPOUhTdA7OHRdz35B0P1V6HB/QHHnpC11SFIAZgNjFciFPMxMZXxKZmL0LlcV2aP3bv+Ph83tFQ/TABaEIGyNPDw2834AjUfsI55+cdE9WWE8FuP5tIuNNv3KLB+dQwHSumE0PMt/2mpYA54A9N8NjdIyN/xmX+1tCo9xWq0ueHhvYE4j0A0wfh3L6846HaCwTYHTh0+/knAwZgLjVcjBUXE+NEvianiksQfNZT75N3vfqdkbzjoe3FBKAgvn7bbf7p0TP/WGBHxOFH846H6sMTYKcv6PIcunxBW52HERmAxdQwlxpmE8NcotC6/kbaJi9B8Vi4o+3p21988XLewVA+mAAU0LEDB24SkQ+qyv3OYVfe8VD9+A7ocA47nKDdE7R7QEmwqXkEiQFlVSwqsKiGhcQwr2BP/oJQwETxR87ZY3eeOvWnXOYnJgAF9sXBwR3S0fFegxxxwFvzjoe2jy9Zv4HACTxkKwcQQCz7f0X2YI9NkGi2f8/GPAWlOmviPuf77jffe/LkK3mHQ42DCUALMECeHhp6m8A7otDbnXNh3jERUd2dAOzxpTD84gMnTszlHQw1HiYALebZw4f740R/2gQfFOBA3vEQUW2Z6VfMvMdfGXvlax8GSzZobUwAWtRzgFceHv77MHvYxP0Dx88CUdNS1cvOyedT4In7Tp16Oe94qDnwpk84vv/Gg+olDwrwPkB6846HiKqkehLiHjfffeGekydn8w6HmgsTAHrd54eG2jzxb3diDwN4W97xENGavmawx06eOvUnXOanzWICQKs6fujQrZraQya40wHtecdDRFgw06fU7PH7xsZeyjsYan5MAGhdx/fv71bx7zPow865G/OOh6jVGDAqsCckTX/7rldfnck7HioOJgBUlUcB96bh4R824Agg7wTg8o6JqMgMeB6Ko6WxkT+4HWzTQLXHBIA27EuHDt2QqH7AVN7vHAbzjoeoKEy1LOKOK/Sxe0dHv5l3PFRsTABo05675ZYwWii/W8UedsDfzTseomalwFmB/WboeZ+54+TJybzjodbABIBq4unh4beY2UNmuMc515l3PERN4huiOHq5r/t3H3zhhTjvYKi1MAGgmjp2+HAX0vRuyWoFbsk7HqJGo6oJBM95IkfvOnXqv+cdD7UuJgBUFwbIMwcO/F0Vd8RU3+2c8/OOiShPqjolTn7LS5JP3Xn69Nm84yFiAkB198wNN+xNveABEzzogL15x0O0zb6pgqNpmn7p/rGxpbyDIVrGBIC2zZO33hp0TE//UwMedpB35B0PUb0oYAL7A1F39K6xV/4fASzvmIjeiAkA5eKpoaE3e849BLX74FxX3vEQ1YTqHJx8TlL/8bte/c5I3uEQrYcJAOXquVtu6SwvLN1lpkecc2/JOx6izTDTVwTuMQ7loWbCBIAaggHy9PDw28XsIRjeA+eCvGMiuhaFfV3MPlEaHf1jduujZsMEgBrO54eG9gTiPQDTB+HcvrzjIVpJVSNx8oyoHr17bOwv8o6HaLOYAFDD+vptt/lnRk//ExE9AnE/knc81NpMdRJOPpmo/tb9Y2Pn846HaKuYAFBTOD40dLM695Co/RSLBmk7qepfipNPJKrP8BgfFQkTAGoqLBqkbWP6x+bcx+8eGfkzHuOjImICQE1puWjQgCNQ+wl2GqRaUGBRTL8A4Og9o6Mn8o6HqJ6YAFDTO7Z//3Vw/vvZaZA2S4GzDvZEEMdP3nHmzHTe8RBtByYAVBjLnQYrg4h+KO94qPEp8BcC+1ipvf252198Mco7HqLtxASACumLBw9+r6g+LOLuBbAj73iosajijwTysbvHXnme+/vUqpgAUKEdO3y4C4ndJ2JHANyUdzyUqyXAnhLVT9w1NvbXeQdDlDcmANQSDJBnhod/xLLtgXcCcHnHRNvDVCch7onQd5+64+TJybzjIWoUTACo5Tx94MAQgA9C5P2A9OYdD9XNCZh+LDY7xvP7RFdjAkAt6/NDQ22+eHcY9BEn7ta846HaMNX/CsGvnxwd/aMPA5p3PESNigkAtTwD5NiBA3/bmfsQoHdwEFHzUcAc7PdN9aP3jI19I+94iJoBEwCiFZ4ZHh5Uk/er4CH2FGh8qhrBuacM9hv3nTr1ct7xEDUTJgBEq3jy1luDzunpd5nKI3D4O3nHQ1dSxSURfDKx9DEO5iHaHCYARNfw9NDQWwHvCBzuBtCWdzytTBXjTvBx892n7jl5cjbveIiaGRMAoio9tffmXi+M3gfTI3Buf97xtBIDRh3so34cf+GOM2cW846HqAiYABBt0Ndvu81/7fTpd5rhZwS4Le94Ck3xsgl+daG3+5kHX3ghzjscoiJhAkC0BV88cOD7HdzPmOld4lwp73gK5CVR/MtgbOTLtwNp3sEQFRETAKIaePbw4f4oTd9vkCM8PbAFipfNw6+URkae44OfqL6YABDV0JO33hrsmJ7+Saj9HJz7W3nH0ywUNiIm/3Lf0A3H3/H880ne8RC1AiYARHVggBw/ePAHoPg5CN4Nzh5YlQGjgH1koafnKe7xE20vJgBEdXZ8/40HzUt+FpCfBkcTAwDM9BVz7iOL3d1P88FPlA8mAETb5Nl9+3qiIHgIav+7ONefdzw5+aYo/s3eAzf8Oy71E+WLCQDRNnvyuus6drS13W+QnxfgQN7xbAvFfxTRj905Ovo1ASzvcIiICQBRbr5+223+2dHT7zHoPy9iwaACi05x3Dl7/M5Tp76VdzxEdCUmAEQ5M0CeOXjwB1OzD0HtJ5xzft4xbYUCfy2wz4RZ177pvOMhotUxASBqIM/ccMPe1PfvF8j7AAznHU/VVOcg7suAPnXX6Oh/4TI/UeNjAkDUgB4F3JsOHPg7Btyn5v6Zc9iVd0xvpKqRc/IVmPud+fLCHz547txC3jERUfWYABA1uK8cPlyaTtO/B5N3w/Qfw7mBvGIx1UmB+6o5+w/lMPzqAydOzOUVCxFtDRMAoibyKODedOjQ3zDVHzPIbaL6A3BuZ71+nwLTUPy5E31ePO/rL7/yyv/3YUDr9fuIaPswASBqYs8BXnLo0M1pan/LgLc4se9RtUMAhpxzYdU/SHUOzo3AcBJiL5rZt33ff+F/O3lyhPv5RMXEBICogB4F3MG9N3c7Pxl0YrtU0p0QCWDmOecSmEUKXDLnZtpKpfO3v/ji5bxjJiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIiIioqr9/xBtI/S1fWgpAAAAAElFTkSuQmCC
+  plans:
+  - id: c7b8e0b0-429a-4fa8-92a0-e60d9e781cac
+    name: hobby-dev
+    human_name: Hobby (5.0.4)
+    description: Redis 5.0.4 - 1xCPU 512MB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 1500
+    attributes:
+      ram: 512MB
+      cpu: 1xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 20
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.t2.micro
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: null
+      SnapshotWindow: null
+      Tags: null
+  - id: e9f28df0-552e-4fa6-be84-92fc88998f05
+    name: standard-0
+    human_name: Standard-0 (5.0.4)
+    description: Redis 5.0.4 - 1xCPU 1.5GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 3000
+    attributes:
+      ram: 1524MB
+      cpu: 1xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 100
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.t2.small
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: null
+      SnapshotWindow: null
+      Tags: null
+  - id: 7cc7f327-7e0f-4197-b105-b4e606c967d8
+    name: standard-1
+    human_name: Standard-1 (5.0.4)
+    description: Redis 5.0.4 - 2xCPU 3GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 5000
+    attributes:
+      ram: 3GB
+      cpu: 2xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 250
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.t2.medium
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: null
+      SnapshotWindow: null
+      Tags: null
+  - id: 13cabcd5-10c5-4afa-8e40-1594cb211b5f
+    name: premium-0
+    human_name: Premium-0 (5.0.4)
+    description: Redis 5.0.4 - 2xCPU 12GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 13500
+    attributes:
+      ram: 12GB
+      cpu: 2xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 500
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.r5.large
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: null
+      SnapshotWindow: null
+      Tags: null
+  - id: 973be197-0aed-4e4d-be83-791d11353f47
+    name: premium-1
+    human_name: Premium-1 (5.0.4)
+    description: Redis 5.0.4 - 4xCPU 13GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 27000
+    attributes:
+      ram: 13GB
+      cpu: 4xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 1000
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.m5.xlarge
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: null
+      SnapshotWindow: null
+      Tags: null
+  - id: fef28df0-a52e-4faa-ba84-a2fc88998f05
+    name: standard-2
+    human_name: Standard-2 (Persistent, 5.0.4)
+    description: Redis 5.0.4 - Persistent - 1xCPU 1.5GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 3500
+    attributes:
+      ram: 1524MB
+      cpu: 1xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 100
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.t2.small
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+  - id: fec7f327-7a0f-41f7-ba05-bbe606c967d8
+    name: standard-3
+    human_name: Standard-3 (Persistent, 5.0.4)
+    description: Redis 5.0.4 - Persistent - 2xCPU 3GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 5500
+    attributes:
+      ram: 3GB
+      cpu: 2xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 250
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.t2.medium
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+  - id: fecabcd5-10a5-4efa-8940-15c4cb211b5f
+    name: premium-2
+    human_name: Premium-2 (Persistent, 5.0.4)
+    description: Redis 5.0.4 - Persistent - 2xCPU 12GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 14000
+    attributes:
+      ram: 12GB
+      cpu: 2xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 500
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.r5.large
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+  - id: fe3be197-0aea-de4d-bc83-791de1353f47
+    name: premium-3
+    human_name: Premium-3 (Persistent, 5.0.4)
+    description: Redis 5.0.4 - Persistent - 4xCPU 13GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 27500
+    attributes:
+      ram: 13GB
+      cpu: 4xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 1000
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.m5.xlarge
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+  - id: b1e6a2c4-6d0b-4c3e-9a57-5f0e2d1c8a01
+    name: standard-ha-0
+    human_name: Standard-HA-0 (HA, 5.0.4)
+    description: Redis 5.0.4 - Highly Available - 1xCPU 1.5GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 6000
+    attributes:
+      ram: 1524MB
+      cpu: 1xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 100
+      shards: 0
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-replication-group
+    provider_private_details:
+      AtRestEncryptionEnabled: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.t2.small
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumCacheClusters: 2
+      Port: 6379
+      PreferredCacheClusterAZs: null
+      PreferredMaintenanceWindow: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: null
+  - id: b1e6a2c4-6d0b-4c3e-9a57-5f0e2d1c8a02
+    name: standard-ha-1
+    human_name: Standard-HA-1 (HA, 5.0.4)
+    description: Redis 5.0.4 - Highly Available - 2xCPU 3GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 10000
+    attributes:
+      ram: 3GB
+      cpu: 2xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 250
+      shards: 0
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-replication-group
+    provider_private_details:
+      AtRestEncryptionEnabled: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.t2.medium
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumCacheClusters: 2
+      Port: 6379
+      PreferredCacheClusterAZs: null
+      PreferredMaintenanceWindow: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: null
+  - id: b1e6a2c4-6d0b-4c3e-9a57-5f0e2d1c8a03
+    name: premium-ha-0
+    human_name: Premium-HA-0 (HA, 5.0.4)
+    description: Redis 5.0.4 - Highly Available - 2xCPU 12GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 27000
+    attributes:
+      ram: 12GB
+      cpu: 2xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 500
+      shards: 0
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-replication-group
+    provider_private_details:
+      AtRestEncryptionEnabled: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.r5.large
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumCacheClusters: 2
+      Port: 6379
+      PreferredCacheClusterAZs: null
+      PreferredMaintenanceWindow: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: null
+  - id: b1e6a2c4-6d0b-4c3e-9a57-5f0e2d1c8a04
+    name: premium-ha-1
+    human_name: Premium-HA-1 (HA, 5.0.4)
+    description: Redis 5.0.4 - Highly Available - 4xCPU 13GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 54000
+    attributes:
+      ram: 13GB
+      cpu: 4xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 1000
+      shards: 0
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-replication-group
+    provider_private_details:
+      AtRestEncryptionEnabled: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.m5.xlarge
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumCacheClusters: 2
+      Port: 6379
+      PreferredCacheClusterAZs: null
+      PreferredMaintenanceWindow: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: null
+  - id: c2f7b3d5-7e1c-4d4f-8b68-6a1f3e2d9b01
+    name: cluster-0
+    human_name: Cluster-0 (3 Shards, 5.0.4)
+    description: Redis 5.0.4 - Cluster Mode - 3 Shards - 1xCPU 1.5GB Ram per shard
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 18000
+    attributes:
+      ram: 4.5GB
+      cpu: 3xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 300
+      shards: 3
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-cluster
+    provider_private_details:
+      AtRestEncryptionEnabled: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.t2.small
+      CacheParameterGroupName: default.redis5.0.cluster.on
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumNodeGroups: 3
+      Port: 6379
+      PreferredMaintenanceWindow: null
+      ReplicasPerNodeGroup: 1
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: null
+  - id: c2f7b3d5-7e1c-4d4f-8b68-6a1f3e2d9b02
+    name: cluster-1
+    human_name: Cluster-1 (6 Shards, 5.0.4)
+    description: Redis 5.0.4 - Cluster Mode - 6 Shards - 1xCPU 1.5GB Ram per shard
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 36000
+    attributes:
+      ram: 9GB
+      cpu: 6xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 600
+      shards: 6
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-cluster
+    provider_private_details:
+      AtRestEncryptionEnabled: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.t2.small
+      CacheParameterGroupName: default.redis5.0.cluster.on
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumNodeGroups: 6
+      Port: 6379
+      PreferredMaintenanceWindow: null
+      ReplicasPerNodeGroup: 1
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: null
+  - id: c2f7b3d5-7e1c-4d4f-8b68-6a1f3e2d9b03
+    name: cluster-2
+    human_name: Cluster-2 (3 Shards, 5.0.4)
+    description: Redis 5.0.4 - Cluster Mode - 3 Shards - 2xCPU 12GB Ram per shard
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 81000
+    attributes:
+      ram: 36GB
+      cpu: 6xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 1500
+      shards: 3
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-cluster
+    provider_private_details:
+      AtRestEncryptionEnabled: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.r5.large
+      CacheParameterGroupName: default.redis5.0.cluster.on
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumNodeGroups: 3
+      Port: 6379
+      PreferredMaintenanceWindow: null
+      ReplicasPerNodeGroup: 1
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: null
+  - id: c2f7b3d5-7e1c-4d4f-8b68-6a1f3e2d9b04
+    name: cluster-3
+    human_name: Cluster-3 (6 Shards, 5.0.4)
+    description: Redis 5.0.4 - Cluster Mode - 6 Shards - 2xCPU 12GB Ram per shard
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 162000
+    attributes:
+      ram: 72GB
+      cpu: 12xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 3000
+      shards: 6
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-redis-cluster
+    provider_private_details:
+      AtRestEncryptionEnabled: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.r5.large
+      CacheParameterGroupName: default.redis5.0.cluster.on
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumNodeGroups: 6
+      Port: 6379
+      PreferredMaintenanceWindow: null
+      ReplicasPerNodeGroup: 1
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: null
+  - id: d3a8c4e6-8f2d-4e5a-9c79-7b2a4f3e0c01
+    name: secure-0
+    human_name: Secure-0 (HA, TLS, 5.0.4)
+    description: Redis 5.0.4 - Highly Available - TLS and AUTH - 1xCPU 1.5GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 6500
+    attributes:
+      ram: 1524MB
+      cpu: 1xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 100
+      shards: 0
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: true
+      encryption_in_transit: true
+      dedicated: true
+    provider: aws-redis-replication-group
+    provider_private_details:
+      AtRestEncryptionEnabled: true
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.t2.small
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumCacheClusters: 2
+      Port: 6379
+      PreferredCacheClusterAZs: null
+      PreferredMaintenanceWindow: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: true
+  - id: d3a8c4e6-8f2d-4e5a-9c79-7b2a4f3e0c02
+    name: secure-1
+    human_name: Secure-1 (HA, TLS, 5.0.4)
+    description: Redis 5.0.4 - Highly Available - TLS and AUTH - 2xCPU 3GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 10500
+    attributes:
+      ram: 3GB
+      cpu: 2xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 250
+      shards: 0
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: true
+      encryption_in_transit: true
+      dedicated: true
+    provider: aws-redis-replication-group
+    provider_private_details:
+      AtRestEncryptionEnabled: true
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.t2.medium
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumCacheClusters: 2
+      Port: 6379
+      PreferredCacheClusterAZs: null
+      PreferredMaintenanceWindow: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: true
+  - id: d3a8c4e6-8f2d-4e5a-9c79-7b2a4f3e0c03
+    name: secure-2
+    human_name: Secure-2 (HA, TLS, 5.0.4)
+    description: Redis 5.0.4 - Highly Available - TLS and AUTH - 2xCPU 12GB Ram
+    version: 5.0.4
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 27500
+    attributes:
+      ram: 12GB
+      cpu: 2xCPU
+      persistence: true
+      restartable: true
+      data_clips: true
+      connection_limit: 500
+      shards: 0
+      replicas: 1
+      high_availability: true
+      rollback: 7 Days
+      encryption_at_rest: true
+      encryption_in_transit: true
+      dedicated: true
+    provider: aws-redis-replication-group
+    provider_private_details:
+      AtRestEncryptionEnabled: true
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      AutomaticFailoverEnabled: true
+      CacheNodeType: cache.r5.large
+      CacheParameterGroupName: default.redis5.0
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${REDIS_SUBNET_GROUP}
+      Engine: redis
+      EngineVersion: 5.0.4
+      MultiAZEnabled: true
+      NotificationTopicArn: null
+      NumCacheClusters: 2
+      Port: 6379
+      PreferredCacheClusterAZs: null
+      PreferredMaintenanceWindow: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotRetentionLimit: 7
+      SnapshotWindow: null
+      Tags: null
+      TransitEncryptionEnabled: true
+  - id: e9f28df0-552e-4fa6-be84-92fc88998ff5
+    name: ephemeral-0
+    human_name: Ephemeral-0 (6.0.7)
+    description: Redis 6.0.7 - 1xCPU 512MB Ram
+    version: 6.0.7
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 500
+    attributes:
+      ram: 512MB
+      cpu: 1xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 100
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-redis-instance
+    provider_private_details:
+      size_in_megabytes: '512'
+      version: 6.0.7
+  - id: 16ed90a0-7e0f-4197-b105-b4e606c967d8
+    name: ephemeral-1
+    human_name: Ephemeral-1 (6.0.7)
+    description: Redis 6.0.7 - 1xCPU 1GB Ram
+    version: 6.0.7
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 1000
+    attributes:
+      ram: 1GB
+      cpu: 1xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 250
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-redis-instance
+    provider_private_details:
+      size_in_megabytes: '1024'
+      version: 6.0.7
+  - id: 5dc7f347-6369-1b56-b105-b4e606c967d8
+    name: ephemeral-2
+    human_name: Ephemeral-2 (6.0.7)
+    description: Redis 6.0.7 - 2xCPU 2GB Ram
+    version: 6.0.7
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 1500
+    attributes:
+      ram: 2GB
+      cpu: 2xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 250
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-redis-instance
+    provider_private_details:
+      size_in_megabytes: '2024'
+      version: 6.0.7
+  - id: 4541f317-7e0f-5b98-6369-2597a3bbe926
+    name: ephemeral-3
+    human_name: Ephemeral-3 (6.0.7)
+    description: Redis 6.0.7 - 2xCPU 4GB Ram
+    version: 6.0.7
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 2000
+    attributes:
+      ram: 4GB
+      cpu: 2xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 250
+      shards: 0
+      repliacs: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-redis-instance
+    provider_private_details:
+      size_in_megabytes: '4048'
+      version: 6.0.7
+  - id: f4b9d5e7-9a3e-4f6b-8d8a-8c3b5a4f1d01
+    name: persistent-0
+    human_name: Persistent-0 (6.0.7)
+    description: Redis 6.0.7 - 1xCPU 512MB Ram 1GB Disk
+    version: 6.0.7
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 750
+    attributes:
+      ram: 512MB
+      cpu: 1xCPU
+      presistance: true
+      restartable: true
+      data_clips: false
+      connection_limit: 100
+      shards: 0
+      replicas: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-redis-persistent
+    provider_private_details:
+      size_in_megabytes: '512'
+      version: 6.0.7
+      storage_size: 1Gi
+      appendonly: true
+      appendfsync: everysec
+      save: 900 1 300 10 60 10000
+  - id: f4b9d5e7-9a3e-4f6b-8d8a-8c3b5a4f1d02
+    name: persistent-1
+    human_name: Persistent-1 (6.0.7)
+    description: Redis 6.0.7 - 1xCPU 1GB Ram 2GB Disk
+    version: 6.0.7
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 1250
+    attributes:
+      ram: 1GB
+      cpu: 1xCPU
+      presistance: true
+      restartable: true
+      data_clips: false
+      connection_limit: 250
+      shards: 0
+      replicas: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-redis-persistent
+    provider_private_details:
+      size_in_megabytes: '1024'
+      version: 6.0.7
+      storage_size: 2Gi
+      appendonly: true
+      appendfsync: everysec
+      save: 900 1 300 10 60 10000
+  - id: f4b9d5e7-9a3e-4f6b-8d8a-8c3b5a4f1d03
+    name: persistent-2
+    human_name: Persistent-2 (6.0.7)
+    description: Redis 6.0.7 - 2xCPU 2GB Ram 4GB Disk
+    version: 6.0.7
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 2000
+    attributes:
+      ram: 2GB
+      cpu: 2xCPU
+      presistance: true
+      restartable: true
+      data_clips: false
+      connection_limit: 250
+      shards: 0
+      replicas: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-redis-persistent
+    provider_private_details:
+      size_in_megabytes: '2048'
+      version: 6.0.7
+      storage_size: 4Gi
+      appendonly: true
+      appendfsync: everysec
+      save: 900 1 300 10 60 10000
+  - id: f4b9d5e7-9a3e-4f6b-8d8a-8c3b5a4f1d04
+    name: persistent-3
+    human_name: Persistent-3 (6.0.7)
+    description: Redis 6.0.7 - 2xCPU 4GB Ram 8GB Disk
+    version: 6.0.7
+    type: redis
+    scheme: redis
+    categories: Data Stores
+    cost_cents: 3000
+    attributes:
+      ram: 4GB
+      cpu: 2xCPU
+      presistance: true
+      restartable: true
+      data_clips: false
+      connection_limit: 250
+      shards: 0
+      replicas: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-redis-persistent
+    provider_private_details:
+      size_in_megabytes: '4096'
+      version: 6.0.7
+      storage_size: 8Gi
+      appendonly: true
+      appendfsync: everysec
+      save: 900 1 300 10 60 10000
+- id: 8cccbf79-3e08-4607-ad9f-193bd5f7ff83
+  name: akkeris-memcached
+  human_name: Akkeris Memcached
+  description: Enterprise-Class Memcached for Developers.
+  categories: Data Stores,Memcached,Cache
+  image: data:image/ong;base64,iVBORw0KGgoAAAANSUhEUgAAAgAAAAIACAYAAAD0eNT6AAAAAXNSR0IArs4c6QAAAbBpVFh0WE1MOmNvbS5hZG9iZS54bXAAAAAAADx4OnhtcG1ldGEgeG1sbnM6eD0iYWRvYmU6bnM6bWV0YS8iIHg6eG1wdGs9IlhNUCBDb3JlIDUuNC4wIj4KICAgPHJkZjpSREYgeG1sbnM6cmRmPSJodHRwOi8vd3d3LnczLm9yZy8xOTk5LzAyLzIyLXJkZi1zeW50YXgtbnMjIj4KICAgICAgPHJkZjpEZXNjcmlwdGlvbiByZGY6YWJvdXQ9IiIKICAgICAgICAgICAgeG1sbnM6ZGM9Imh0dHA6Ly9wdXJsLm9yZy9kYy9lbGVtZW50cy8xLjEvIj4KICAgICAgICAgPGRjOmNyZWF0b3I+CiAgICAgICAgICAgIDxyZGY6U2VxPgogICAgICAgICAgICAgICA8cmRmOmxpPm1hZ2UgMS4wLjE2PC9yZGY6bGk+CiAgICAgICAgICAgIDwvcmRmOlNlcT4KICAgICAgICAgPC9kYzpjcmVhdG9yPgogICAgICA8L3JkZjpEZXNjcmlwdGlvbj4KICAgPC9yZGY6UkRGPgo8L3g6eG1wbWV0YT4KC+D5cQAAQABJREFUeAHsvQd4XNd55n+IMoPeG1EIgKjsXYVqtCVb7nbcN9lN+ydOsdcp//RN1nLyJHGS3ewmTttNWXuzibNyL4psS4ooymoUSbH3BhIACYIkegfI/b2XMxJEAiTK3Dv3zpxDzjODOzP3nvueM+f9zleNsc0iYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBGwCFgELAIWAYuARcAiYBF4HYHr168vmfZI4XXqtEcar29+pHNstsfNn9Xf08+X8thjj+kazjVf74R9YRGwCAQOgSWB67HtsEUgCRAQwXKbMz7a29tTqquro++l6HO9vb0pBQUFSwYGBlJyc3OXDA4OpiyhRc5hRkZGUrKysvS8JPp8M4yZmZnXh4eHzbTna5HP0J3r13Nycq5x/uuc/xrXu8719P51Hs4z/bpOv6LHdPxND7qjv22zCFgEfIKAFgjbLAIWAY8RiBL89u3bU7Zt27bk5MmTKY2NjSJz5wHBpqpBmimjo6NpKSkpqXqMj4/rWOrk5GRqOBxOmZiYcF7zXoqOTU1NOd/R93gtISD67AgDHOMQJ0hNNXodvW3+vs7fRkSv1xzXS72+xtO1ac9T+jstLW3qGk3P6enpU2NjY85r3psKhUJ6z3lkZGRM6vOcewqBYYrzSkC4xv1e436vcf/XuX9HaKBbVkCIDoh9tgh4gMDrC4AH17KXsAgkHQKQn7ND58b1W0vlkXL58mV4My0VQkzt7u5Oh1xF8GmQeToknsbf6ZB+OiSaHj2u93Sc5zTO4XwOTpUaP41n58FrCQOOyp5jqXxfqnup650Hx9QXw3H1RTKD/nQax/R8jWMOCYvwIWTnwTFJDRIC9DxF3yf1zN+TevBazxM6zjkmEAgmuZaenQfnnuQcEwgDEzquz+k9HefvydLS0gkEnin6PlVSUqJzqDMSFhxBRNfmtW0WAYtAjBGwAkCMAbWnS04EIFmHVHfv3p2Sn5+v3bzIXo801PHp7JDTIb6QiBw1fEiv2c2H+TvEZ8KQX5hnva/XGXxe7+m1SN95H8J03tczxOkICLoGf8On6RIWQpFHOqSZqkM8cygl+qw/OLTkzezPSdQ4j3b7er5+46WenGPX6IPe065exD2uB8cmON8EX53Ss4QAnse57pieuccxveZZgsAYGgu9HtVrvuO8z7M+M47WYIx7Hcf8oO9P6DWfn8DsoPNLKJhCazDV19d3bdOmTRIIJJxYjQFA2GYRWCgCVgBYKHL2e0mLQJTsAcBR1/Ocxg5WBO/s3CGvEAQZ1oPPOoTOsQxIO4PnTD0g/0xIPpOdfiY86hzjOUuf43si8kzOlw0ZZkKOIcgwnUeIvzN4VtPhNHbVaTzLPOA0SHg62euYiQgBIv05jxl9kCAgOwBPzms986ejztdrp3EfU9zDJM+T3M84jzHuYzTyeoLjEhRGuK8hvjBCH0T8EgKGeR7heYR7GOF+RriPER3Tg/sY1ef0oP8SKJwHf49Lk8D5JtCgRIUDRyDg5qxQMOcRth+0CNxQS1ocLAIWgVkQgPAkJDu7e3agqdrZ9/T0OGQPt4UhpBDknyFijhC5CDwLAszi/WyesyHILB7ZvJ/D3zm8r89kQ3i5kFk2TnkZ7HyzeJ3FjjcjOztbBM8p09N4pHINPQInrEPS13lMcd96SECYGBoaGkMjAhyjw2hC8Dkc1ushsBqA5Id4jHLvg9z3IHgM8ZCgMAQ+Qxwf5n39LQFihHOOIgSMco2oBmGisLBwQpoCxinqb3Ddagpmmdz2cNIjELhFJelHzALgKgLTCZ8LOTZ6iNpR37MrDUNeUs9n8l4WZJYlsoe8HGKHzHIhsxweuZCTHjl8RySfD6ln8cjBgz43Ly9PrzltKJ2Hs4N39aZ8fHKwm+IhDYKEg/H+/v5hIg0GeD3IQ9JBH5qTAQkEPAbAUo9BhKYBCQrgp8cwOEswGOaYNAgyKziCgc4rHwMgcAQChAHrT+Dj+WC75i0CVgDwFm97NR8iAOlHVfkO4UMY6TjqZUAkYXapUtFnQRxZvM5ht5kD0echBOQiDOg5H5LKQxDIhXQKxe/s4nPxA8hndwrP39jNQ1qyy/vw7v3ZJcbEgOtEVGuAlmUI+38fmEs46Od4D9oACQP9kH4fmOvZERAQCCQwDCEUDPEsrcEor0dxMBzF6dIKBP4ccturOCBgV6Q4gG4vGV8EIrt8kb7jpMdzCFIJs/HM5D3Z47PZdUp1n8sOUrv6fIgnH+Iv4HUh7+ciHBSxky+IEH1BUVFRNmrqMIQkBz/7u3JpiMH+OmMwjjA2dvXq1SEEg14JBmgOehHUeiD8fsagh4eEgj4EMsVTOoKBBALGZghBTL4II2VlZY4zIl11nAx5tj4ELo2bPa0/EbALlT/HxfYqxghM2+U7YXSQvez3GRC/VPnZ7OClus+DRPJE9BBMAZ8pFOHTlQIIpZQdvXb1hcXFxfmQfwZq6DCkYn9DMR6r+Z4OYU0JjMYYr1E0N33IBD2SCxjXy4yPhIEeNDF69DJmvREhoR8hYJBjQzyGESxGERYkELzuWGjNBfMdCfv5oCFgF6+gjZjt71wRiKaqdXb5EIK86mTDz0IYkLd9jsiev7WrL4BACtlIFvO6iPeLIIVSafExBxShOpYNX456Eh5sCwACCHCTCACOQIDa/ypj28vf3ZD6VQSCq4ztFYQBCQe9ejA3HC0Br2U2kIPhGOcYX7p0qeM/wPecnAQBuHXbRYvAnBGwAsCcobIf9DsCELfm8+uqfRZ9kb6887Mg+hx2irmR3X0hi3shO8ZiSKEE0i+G4MvY3ReVl5fD9yUF7AYxKYct4ft90OfYP3wGJpkD8gHo6+rquoxAeJWxvwThX2HsLzPeVxDwevSQlkBmA3wJpB0YYv6MIjBIO2BNBXPE234sGAhYASAY42R7eRsEIur9NBb2dAg8BMlnsrg7YXe8zmcB1y6/CDtxMUJBKcdKWeBLIPxydvgl7PLkvKdwvPTbXMa+lUAIMAcmEACHLly40INQIIGgCwHxMnOgG7LvxsRzBeHgKgKkzAZ9PDvmAl6PMM/GmWeOqcCaCRJoUiThrVgBIAkHPRFuOUL6Uu+na6cPycuBL5u/cyB62fC1yy9m11fC+2XsAEvZ0VfgrFdaUVGB/1dZPqSfiSCQCHDYe1gEAhC/QRgYuXTpkrQDl65cudLNfLnIfJFm4BLCQDeaAUdDwOteLjUI8TumgohmIGomsCGGixgH+1XvEbACgPeY2ysuEIGIit8hfU4RYufmkD7knwvZF7DrL2KnX8JiXooQUC7SxxN8KWRfXlVVVQb557CDU+pd2ywCsyKAdmCcCIPBjo4OZIJLXQiRFxAGpBXoQiDolslA2gGEAsd3QMIAmqQRTjjOY+Kzn/3sNUomW2FgVoTtG35BwAoAfhkJ248ZEYiQvuz6aXh4h1h4M1iQs1l0HXu+7Pcs2CWocMsipF/OIl0p0q+srCzDYz+Hr1hb/ozo2oN3QoD5NYlGYLCzszMqDHTiG9AlYQAT0iUESgkDcijsRUgYUJgh31HOAQkDTmEj5qqtWXAnoO37cUHACgBxgd1e9E4IRFX8qGSdAjgssPLeV6hegWz57O5LSMlbDvFXcKyCnX4lqv2l1KPXTl8efFa3fyeQ7fvzQoA5N8V8Gzh37tylixcvXkAQ7YT8LzLfLmIK6GLOXZapgOc+CQMIpsP4DozhLyBhwKmoOK8L2g9bBFxGwAoALgNsTz93BKbv9lHBqtpNBmtoLiF7eZB8EQtuKY8y3lvK8aW8X8VOf+myZcuWsuPKszv9uWNtP7k4BJiPk2ik+tva2i5iiuokYVQHMuoFtAIXpR1AIO1mPl7FXNCHn8kgf0dNBFYrsDjo7bdjiIAVAGIIpj3VwhCIEH8qHtkhFtEwTlkqoqMsfErEUxIlfXb8VVyhErKvgvSr5L3PAmtt+guD3X4rRgjgezKuaAI0Ax0IBR2ctpOiRB1oBi5A/Ao1vIyw2iOtAMLAEMLrGHM3qhWw5oEYjYM9zfwRsALA/DGz34gRAhE1v+zzIVSqKrCTTXa2/Eh8fhnPFez2qxAAqrDr19CqeJSzuCo3f4x6YU9jEYgNAsxng5A6fP78+Us82pm/5xEAJAh0MH8vIqxe4vkKWSf7uOIQJqvXtQLMZ+s0GJthsGeZBwJ2FZ0HWPajsUGAhVL2eRF/GPVpFs8qrFOIN792++UifUL3qlgoq7GfLquvr5eqv4AdlLXrx2YI7FlcRkD+AngN9p4+fVqhBOcQbNvxE3CEAYSCLjRdl5nPPXRjgAiCYZ6dREMIAqpaaJtFwBMErADgCcz2IlE1P0ik4UiVITU/r/Mgennxl6JGXYq3dTW7pmocqWpp1aj5K7Tbt+hZBIKMgLQCmAcu4i/QTrhqG1qAdqJT2tEIXEAQUOKhK9xfv8wD+BCM8trJOGijB4I86sHouxUAgjFOge3lNOKXrT584cKF3KiaXyp+iL8Su2kNC2MNO6Ha5cuXLyN8r8g69AV2yG3HZ0FAjoOEE15FK3AOYbcNDcB5/FnOQ/odRAtc4vmyzAP4BwxwimilQkUPWD+BWTC1hxeHgBUAFoef/fYsCEwnfog+kx1/DmRfqN0+D9n2q3nUcLyWJD11DQ0NUvPnIRzYOTkLpvZwYiAAyV/HLNB/6tQpcg11nGXn34afgASBdswDF6UVQDvQo+gBtAVRPwErCCTG8PvqLuxi66vhCH5nosRP/H6YhcwhftT8Rdj3lZ1Pu/1lqERr8IquQ82Peb9+KWp+OQDaZhFIOgQwh42gEbiAeeAMUS9n+S1IK3COUMJOtAIyD1yVIIDQPII/jLQCVhBIulni3g1bAcA9bJPqzDcTP7scZepTxT0l66mC9JfxXIt9X7v9Ooi/AgHBhvAl1SyxNzsbAvxWxs+cOXMRrcBZzGFn0Qa0SRBAA6AIAjkN9qAdU3IhKwjMBqI9Pm8ErAAwb8jsF6YjECV+joWw72exSOVC/kWQfbnC99jx1/K6jh3N8qamploc+8qsfX86gva1ReANBOQnoEyDJ06caMNkdgrylyDQpnBChIIufl9X+X0N4CegyAGbS+AN6OyrBSBgBYAFgGa/YkyU+CH9EIuTyu/mcqxIO35U/tVR4kelKeJfTvx+CWp/G8a3yMkzRaz54NiYGRofMwM8hsfHzejkhPPcPzZqBnj08/7IxIQZ47ie9f7o5KSZoOrd+NSkucY59Prmlk5lxBTyK4RS04xeZ6Sl80gzmenpJsxrPeeFwyY3nOE8skMh5zNZPGen8+BZ76WlqHSDbYtBAHPAFLkELiMInEZ7dhryPytBAJNAuzQCOAYqy+AAQvaITSq0GKST+7tWAEju8Z/33UeJny+mY9PPjKj6HeJnMarGuakOAaCehaqhtbW1jtz8JdgyLfHPEemekWFzZXjY9I0Om6u8vjgwwKPPeb48NOiQ/gikPzxx4+EQPaQ+dS2aR2aJueVHzYFbjs1wBLHuTb10/nrzocgnbhxMhejDCAsSDDIRADIRErJC6Y4QUJqdY8py8kx5Tq5ZmptnirOyTUFmFs9ZppBn2+aGAL4zEgSuHDt27Ay/q1P8rs7gLCsTQTvmNJkGrso0gIZNzoLRssQ3jdrcrmU/lXwI3LouJB8G9o7ngECE+LW1S0el7+To144fm2QZC1MNCX1eJ/6WlpYG7fghfrsVnAHbAXbolwYHTPfQgGnv6zXn+npMW0+PudDfa3pHRyD/UXb5o2aEnbuo2/mRRkhcf7H7c45Nf57hMp4cYg44QsGbn28c0xs3mOi6oymQdiA/I8MUZGSapXkFpraw0CzLLzTVPMoQFCQsSItg260IIAhck0YAQeAUv79TCABnCJs9i0BwHiHgkjQCPA9wXHkENHGuccwKArdCaY9MQ8AKANPAsC9nRoDFPeXkyZPphCqF2fHnyLmPBakcDUA1xF9POF896sgGET/OfWV2x38DR+3KLwz08+gzp69eMccvXzKnrlw2XRzTTl+PiWtTDsXfyGy8xFHBSw2vH6YIPlGamEhCgswPekg00JNEBZkbijKzeWQZajmbhqJS01RSZpYXFZvKvHxTgQZBmNgGs6MROHv27KWjR4+eko8Av0kJAmfQALTzu4s6Cw7ymxxrbGycYA5FVUMWPovALQjYX9UtkNgDUQRYsDU/nJS9qPazqcpXQIrTMnYg1XLs41gDyUwampubG/Hsr+C1PpuUTZTW2d9nzrObP9bdZQ5evGBOXe023YOD5vLwIPb4yQjRi+QNj5TXd/JJCdi0m44KB1PXrzlCQlQwkA9CiUwJPBqKS83K8grTUlpuatAYSDBI5sWL3+EkEQMXjx8/fhJ/gZMIAY6fgEwD/A4vpaWl9UL+QxyPphgWzLZZBN6EQDL/ht4EhP3jDQQixO9U58PBKBOiL2CRKYH0q8hgVseuv4FPN+Lc18yjCtVj+hvfTo5Xcqw7w67+5JVus/dChznUdcERAKTal6Od9vDatToPbOX2hzb/eSHtADlzXtca6G85KMpcIAFgVflSs25plWlCOKhHWyCBIdkaIYMTmAU60NAd595P4ih4mscZBIEOnG4v4y/Qi6OudRRMtokxx/u169IcgUqWj0H+qagY05WcB1W/PPuLsfFXou6vJblPA2FKjSTwacHBbxn2x6RJ4CPCl/r+8KWL5tX2NnPkUheE34saf8Qhd6nrU0X4luxd/aloG4sZyigagrmJSGBwKsxEICgwK8rKzZbqWrOyrMLRGCiCIVkav9ERzALnSCh0jDDbkyQNOoVZQFEDnczNK/IPIJpgpK6uTmaBW0NAkgUoe59vQsAKAG+CI3n/iOz60wjfywAF2fmLUPlXsOtfph0/i0cj6sSWNWvWLFfK3mRASjv8I90XzUvnzph9nR2Oer8PJ73o7l5e8NY2Hf+ZIJ8C+VvoWf/ycTKUmUDagXtr682K0gpHQxD/nrrfA7R1/QcOHDjNb/YYQrxMA3IYPIdJQCmGr9KDQTQETsEhBAFrFnB/SHx9BSsA+Hp43O9chPgVphcihj+LHX6BPPt5XYO6f/nFixebWDiaV69e3UQSn1LSkibsnBkkrl47+5fazpiXIf0zPVfMJTz1tc0U0VvCd38+xuIK0wUCqWfKsnNNfWGxuWdZfUQgKDc55DNI1EZ9jeskE+o+ePDgCX7LxysqKk4gBMhHQPUGlEyoj1wCNpFQok6AedxXwi7m88AgaT8K+UfD+px4fsi/BM/iyijxY/dvRtWPc39LdaI6+F3EI3/fxQ6z4/RJs6ez3ZzrvUrynHEIP8UhfKn1bQs2AjIX3NAQXCNXQYjwwyKzobLaPLi80ayrqHKiDIJ9hzP3Xo6C+Ae0Yxo4hj+AIwhQhvgMETsdmAkuK38AAoGTPwBtgI0WmBnGhD5qV7eEHt6Zb27arj+MyjCHHUMRi0U5oUNy8GtE3d9MSd5W1P0NqBETLmuLYu/3dJw3z5w6ZvZC+h1474MJhC87/g3v/JmRs0eDjoDGWdEGUzgXQnqmCmfC9QgDDze2mI2VNeQkKAj6Ld7Sf37Pw5gFTlGK+Cg+AcfxDzhJ+OBZhPouNHpXMekN8iVbaOgW5BL/gBUAEn+M33SHLIBS98s7KhP1fgFxxaXs+pXIp4EFooXsYq3r1q1rUSIfObQlSlOI3ivn28xTJ46Y/XjtKz5fTWlrpdq3LTkRkGZgkoeaMhauwW/g7U2t5u6aOifSIFFQkeOkzAL79+8/TuTAUQT8Y/IPQBtwnvwB3ZgJerlXqw1IlAGf431YAWCOQAX9Y9N2/RmQvbPrx85fifew7PzNCAEthPStXLFixTKEgIRwn75KSt1dHefM944fNq9C/trpa8KL8C3pB31Gx77/Egb0kGecNANbamrNo80rzeaqZaaIFMaJ0CD/ySNHjpyjxsBhangcRRtwAm3Aafx8OqUNQCiQNkBOgrbscCIM+B3uwQoAdwAoEd6G/LXFDUH2mXLyY9dfRljfMuKDm/D6b2EBWLl27dpmFoPAe/eryI3i8p88dsjsOHPStPX2OGFjdqefCDPZu3uIagakBastKDQP1jead7asMuvREChzYdCbogX27dt3HLPfYaICjpHv4wRhg+fQBlzCP6AXU4G0AePWNyDoI337/lsB4Pb4BPrd6K6fHb8T2oetvzi6629vb2/Fya91FY1MflX88AOtBz+Lx/6/nTpunjx+xBwhKY+K5aSlpNrKdIGewf7ovEwEk6RszqLg0UqSD72jeYV5a0OzqSOyIMiNjcA1Mgl2HKLhJHiUwl3yEThNhIC0AVe4t0E0AlYbEORBvkPfrQBwB4CC+nZk16/UaJnE8hdQqa8c7/5aHk0k9Gnlh71yw4YNzXgF5wT1HpVedydJeb5xaL95oe2UU2BHTnza7cvByzaLQCwR4Dfl+AvIiVDZCO+vbTDvX7XW3EXyoXCAkw6xJgy+9tprx9koHEYLeJQ14QSPNkwEXUQJWN+AWE4in53LrpI+G5DFdke7/u3bt6diyw9Dgjk4/2jXXyVbv3b9SP0r8O5fRaGQSpKDBHLX30EGvqdOHDXfOXrQScE7NjmFWha7PuRvm0XACwQkBExMXYP4U52UxO9pXW3ehvNgFRkJg9hI+nWNdMKdRAscQht4ZJo2oAMzyBXWlUF8B8a2bdtmfQOCOMCz9NkKALMAE8TDIn/6nU7Yj2z9+bwuw8ZXi72vRbt+bH2r2PW3IN1nB/H+DqLa/+rBvQ75y6tfMfppEP+N2nlBvCPb56AjoMyDkwgCyjWg+gQSAj60er1ZjakgiA1twBDagGP4Bh2SNoAQwWP4CLVxL/IN6CMsOBopYLMIBnGAb+qzFQBuAiSof0L+TjY/HPuykeCLUPtX8lBo3wqEgRWY+lcroU/Qdv1S87/Qdtp85cBrzvPA2KhJx7ZvvfiDOlMTt99yHFR559xwhrmvdrn5yJoNZivPQTMPSBugBEK4BhyE9I8QMngEU4BSCneSO+AqPgJDjKIcBG1NgYBPZysABHwAI7t+kX8GUnseCX1Klb9fyXwQBlYQ57t68+bNrUjy0ggEpvVD9E+j5v+X/XvMvgvtzi5L3tc2935ghjBpO6pUxIpGkXZq3dJq8/G1m8wjTS0mD8EgSA3NYd+uXbuOEiJ8kCiBI+z+jyMEnEMI6EabqEQa1kEwSAM6Q1+tADADKEE5FFX5Q/gKUi7gh6riPfXa9aPKW9XQ0LCG8L76IKXx7R4aNE8cPeTs+I92dzlx+2kQv52oQZmVtp9RBKQjn0QQ0HNrabn5MBqBd7euMqXZwfG7VTphkgedOXXq1AEEgEP4BkgboHTCF7mtXl6rpoAqDFqTAEAErdl1NWgjFulvVOXPn9ns/IuJ66/G3t+Io98qHP9k619TX19fGpTb6xrsx76/z3z1wF6jkD7t9BMh3joo+Nt+uouANALSDCh08ENr1uMnsM6U5wQn7caZM2e68Q04gEOghIBD+AWcJG9AO5oAhQtak4C708e1s1sBwDVo3TnxzSp/wvvKkNKXydEPlf8qHPzWbtq0qRXJPNOdHsT2rCrGI8c+2fjbKMRjY/dji689m78QiOYUqC0ocjQCchisIAVxEBraxZHdu3cfRbu4H5PAITkIol08R7jgJWsSCMII3tpHKwDciolvj0RV/nRQefwLSexTwa5/OSr/ldj8V1O5bx1le5cFwdHv0uCA+TL2/S9D/qrAZ4nft9POdswFBKKCwDIJAggBH1270ckt4MKlYnpKOQhSZvgcFQb3YRI4iIPgYVKHn0EIuEA9gR4uZqMEYoq4uyezAoC7+Mbs7JB/Cur9MJm6stjxFxPXX40k3iSVP/a3NTj6raWAT1HMLujSiXpHRszXDu01//TaLnOm57IlfpdwtqcNBgJRQaC+sMT8yIbN5oOr1puCTP8r786fP38VB8H9rEsHZBJA83ic5GLtrEXTowRsiWGfT0MrAPh8gNS9Z599No0EHCGc/HLZ6Zeh9ldsf6tU/qje1kL+KxEMfO1iPDQ+br595KD533teMcdw7lMYnzL22WYRsAjgLBgpRNSCs+CPbbzbvGflapNN6mE/NzYhowgBh8kguB9NwEEEABUXUpnhyzgJDtD3MRsq6OcRNNa52s/DE7X34+SXyQ8qDxtcBbt+xfav5Nga4vrXycvfz3n85fj0zMlj5n/ufMG81nneSdGrOH7bLAIWgVsRUB4BfvdmQ2WN+cRd95mHG1t8HfqqegIUFTpDTYG9kL98Aw5jGjiND1IXG5Z+NigyCdjsgbcOtS+OWA2AL4bh1k5EyN/J5S97Pz+mKlL6NlLTew2JfVbj6LeeML/yW7/pnyN7O9vN37zyA7P99AlnhxNKgCpq/kHX9iSRERhXHgE0ZNuWN5mfvft+s76y2te3S5hg1549e17DH+AApsgDCACn0AQocVAPAoH1C/Dp6FkBwIcDA/lLN55OaJ9S9hahYqth59+C3W0tu/1199xzz2o8cH3rOny+r8f87c4XzTcP7zeDY2MmRKEUO9F8ONFsl3yNgALrx8mEmRMOm/evXGt++q6tpia/0Ld9xizZ/9JLLx3AOXkffgEH8As4hlngPB2+SsigQgWVL8D6BfhoBO267KPBUFcg/1Qc++DMtBzIvgRVfy2PFRxbC+lvuOuuu5Tdz5fGQdn5Hz+wx/zDqy8ZFewJpab5Wn3ps6G33bEIzIiAzGjjU5NOoaGf3HKv+eiajSY75MslwOCfNPbKK68cYdOyF03AfkwARyD/Noj/MhEEgwgGNoXwjKMcn4NWAIgP7jNeVeRP0Z4Mkm3kjo+Pl6MBqEf9v5LHuqampo3r169f7tcQv+dQ8//lS8+bPR3nHAc/m6t/xiG2By0CC0ZAtQb02Fi1zHzy3gfMQ5gH/NgifgGnT5w4sYfQwH08DiMEnAmFQl0kKRvAV2DUOgf6Y+SsAOCPcdDOPw2pOUPOfqj7K4nvb8DLfzVe/+sg/o3E+Ffxo/FJb9/oxvm+XvOXLz5nvnXkALuUKXb91sHvDXTsK4tA7BGI/s7et2KN+eTWhzAL+K8EMeuZoaBQB9kD9+ADsA/nwINkDzyVlZV1ASGgD9OAhIDJ2KNjzzgfBPzHKPPpfQJ8lh+KxiANws/kB1HArr+K102y9xPvv+7uu+/esGzZsmK/3apUkl8hba+c/Nqx+YdR9/tRQPEbbrY/FoFYICCCHeM3WI1PgJwEP0x6YZnc/NZwWr6CSeA1nAH3ySSAGfM4QkAn/e9FIJBz4CTrhq0jEKeBswJAnIDXZSPk73j6E1NbhBNNDQJAc1tb2zrs/xvuvffeddjQfFc5ZN+FDvPff/Csef7sKcfGb+P54ziJ7KWTGgHlD5CPwAN1DeYX738L1QerfIcHms3Bl19+eR+mgddqa2v3QfzHeZwnd8lVOmsjBOI4YlYAiBP408g/mx+Iivkon/8Kdv7rCKHZuHXr1tWk1wzHqXszXlZOfv9r98vmf+16ySijX9DqnM94U/agRSABEBgjWiCfDII/ufle8xOb7vGdk6CcA1988cWDrHN7qqqq9qEJkHPgOUwB0WJCtqJgHOahFQDiAHqE/EP8GLJx9ivFy78Ou/9K1GXr+XFsxNO/NSMjw1f6vF049/2XHc+YV8+3OVX6VK3PNouARcA/CEgToKqDW2pqza88+LDZjLOgnxr5SyZ37tx5tKOjYw9mTSUOOsRmpw3nwO5ImKAiBKw5wMNBs6u4h2DrUpC/YvxDJPXJViU/svvVs/NfzY9iQ2Nj40ZaE57+vhmXwfEx83fE9H9h9ytmYHzUsfV7DJm9nEXAIjAPBOQbkBvKMD++6W7zU+QOyAn5R5FIKOB1HANPnDx5cjfpg/eiCTiIEKBiQpdyc3OjZYVtroB5jPdiPuqrXeZibiQI34X85SIfItQvl+cyPPyX83o1YX4bV61atXnNmjW1hAD65lb2Y+v/w+3fNzvPn2XXn2bJ3zcjYztiEZgdATnkjk5OmM+/uN28wm/3N7e93az1iW+ANjdkMW1m1x8+fPhwiIiANNZF3IhSUoeHhy+hFRjgb5srYPbhjek7vtlpxvSufHiyCPmHsffnRWL8l7PrX4vqf/26devuIsyv0i/dlof/P7620/z1yz8wPSPDlvj9MjC2HxaBeSIg34DCrCzzc/fcb/7Dhrt8FSlASeFO6gjsJGPgXkyf+zEDnFauAPwC+rlNW0honmO9kI9bAWAhqM3zO1Hyx9afT07/pcrpL/JHA7CeSn5bUP2XzfOUrn38TM8V84fPft/826ljNxL6LPGPRsK1m7YntggkMAJT128kEHprQ7P5zbc8auoL/RNVjCngEhUFXyVXgCMEYAY4SS6UC0Q/9TEkVghweV5aE4DLAEfIPwP1Vt7IyEgV4X5NkP86njfg6b/JTzH+Txw9ZP5o+1OmnTS+GeTvt80iYBEIPgKpCPGpqSnm6ZPHzdFLl8yvb3ubeXfrKl/cmDY/7PrvIUIgXeYANAEkEU1JYb005AvoZ/20WQNdHCmrAXAR3Cj5a+cP+Vei/m+llO96EvysJ8Z/E04wvkjh1T86av78xe3mn/buclKN2rh+FyeFPbVFII4IKG+A0nT/yPrN5tNbt5m8jIw49uaNS7Mu9lJIaDcJg/bKORAzwFEqC3ZGNAFWCHgDqpi+sgJATOF842RR8sfGX4A0q9S+LWfPnt1IMowNDz744Ea8X31Rze9Q1wXz2WeeNLva22w2vzeGz76yCCQsAqxNThbBzdW15jMPv9OsKl/qi3tVNcEdO3bsIQnaa3V1dXtIFnSMsMBOCgj10kErBLgwSlYAcAHUGci/lex+G3H+2/DQQw9tRLpVFEDc29cP7XNU/t1DgzapT9xHw3bAIuAtAnIQLM3OcUwCP7RqnbcXn+VqaEkHnnvuuT2YBZQ1UELAUaUOJnyw1xYRmgW0RRy2AsAiwJvpqyJ/VfQj3CW6828lwc8GyH8z5L8elVbcyV+x/X/+wnbzv/fsdNKIWpX/TCNpj1kEEh8BmQSU1Os/bLzL/MJ923yRMwCt6cD27dv3ognYbYUAd+egFQBiiO908ifLXxWPFu38p6amNqH230C4S9zz+p++esU89vQT5oWzp53KfbaATwwngD2VRSCACMgkoAqD99UtN4898m6zvCj+UQIIAYOYA15LTU11hABCBI/x6LCagNhOMCsAxAjPiNo/jMNfQcTbv+X06dObRP7s/Degyoo7+W8/fcJ89ul/Ned6qd5nvfxjNPL2NBaBxEBgFJNAbUGh+cwj7zLbljfF/abwmxrEHBAVAnbLJwDHwA60qPIJsCGCMRghKwDEAMRp5C9v/yrS+8rhbxMOfyL/jfHe+StH+BdJ5asKfsOT4yY9RQkJbbMIWAQsAm9GYOLalMlKCzmVBZVKON4aQmkC5BMgcwCOgbtJGxwVAmyegDcP3YL+ssHeC4LtjS9B/int7e0hPFVzleSH5D7N/C2bv+PtH2/yl73/j5972nyJED+F/1jyf2Ps7CuLgEXgzQhofVAtgT949ntGScF+7aFH4uoXoPVTUVMIAdcwp15Do3qdpEHX0ARcZ63tY/2VJsDWDnjzMM75L6sBmDNUt36QySf8VGkjl+Q+lUzIFhz+NhH2J4e/zXj7xzXU7+JAv/mt733LbD99khC/1LhL87ciaI9YBCwCfkSAtQ1BYApTQKP5g0ffZypy47qUGaID+hECdhERsIvkabsRAo6RNKgT7AZ4SAiwVQQXMJGsALAA0PSVCPmrql8uhF/BBG1GCNiI+n/LAw88cFe8k/wcvHjB/MZ3v2kOX7pos/otcIzt1ywCyY6A/AJWllWYz73jfWZ1RXzLlShZ0PPPP78TM8CrkP8eNljHEQgukj5YQoAtJbyAyWoTvS8AtCj54+Wvkr6l7Pzrqei3huf199xzT9wz/D1z8pj5xNe/ZI52W/JfwPDar1gELAIRBJQSXOvIz3z9X4zWlXg2barIoLpR62xkva3X+qt1mH6FIutyPLsYuGtbH4B5DllkkqWTyz8LJ79SNAD1Fy5cWEMWqw2Q/2biVuMaQ/PP2Po/99xTZnR83FeVv+YJs/24RcAi4BMEQpQX7h4cML/0xNfMbzz0NvPDpBGOV0P9X0Io4OaXX355khDBCcoGTOEgeI31+Hp+fj7L8/UJaw6Y++hYAWDuWOmTMpkIs0yc/IrxUK0j6c9qBICNtC3Lly8v14fi0aZI6PFXLz9v/vLFHeY65rA0bP62WQQsAhaBWCCg9WRscsL8LmnDrwwPmZ+/5wHHqTgW557vObTOsv5u2bNnzyQCALmMrk3hLDiF+fU65oEhzjfJw/oEzAFYyxJzACn6kWeffTaNZBRZ7PpLeNSy61+Lx/+mVatW3b169eqa6Oe8fh6ZmDB/sP375m93vmhSUpYYVf+yzSJgEbAIxBIBZQwUq7507ozpGR0x99TUmfQ4bTSUUZXdfg65VibRAEyQOniEMsKj+GNNvPOd75z64he/aCMD5jD4VgMwB5D0ESabhKUM7E8FhPtVQ/4rz58/v66pqWnT2rVra+d4mph/rI8f4m9/79vmiWOHSO6T7qgoYn4Re0KLgEXAIgACEgJE+v9IGvEr1BD5/Uffa/IzMuOCzZo1a5ZRWXXs5MmTcgAclzYADcDUtm3bSH1yfYRjU3HpWIAuagWAOQyWyD+S3z8fp5Mqsv214PG/Fk/UjRs2bGhkos3hLLH/iIr4/Oq/ft3sOHMST//02F/AntEiYBGwCNyEgFY7OQf+K5sO5Rn5k3f9kFNU6KaPuf6n1l2tvyRfG8MMO5aRkTGKEDAhkwB+Aizb120FwTuMgtUV3wEgJpGT6CcnJycXu1MF6Smb2PmvxRSw8a677mql6E9c2L+9r9f8/Dceh/xPWfK/wxjaty0CFoHYI6BNh9YfrUNaj+LRtP5qHdZ6TKKgdVqftU5rvVaCNq3f8ehXUK5pwbnNSDF5RO7pTLIcHEzKUfs3MqnWYHPasHXr1tVInHHRoJy83G1+jrCcPR3nbIz/bcbPvmURsAi4i4A0AVqHtB5pXYpH0zqs9VjrstZnrdNar7Vu05/0yDoej675/ppWAJhliKLkH4kxVaxpvTz+R0dH1zPZ1iFhKgOg5+0IiX1+7pv/10nwYwv6eA6/vaBFwCJwEwJah5Rw7OdZl7Q+xaNpPda6rPVZ67TWa/oRzRFghYBZBsUKALMAs3v3bu3uM/ExKcLpbxmJJ1aiXlpLrH/cyvoeuNhpPvXNL5szVy7ban6zjJs9bBGwCHiPgISA06xLWp8Osk7Fo6luwN13371B67TWa63bWr/pS2ZkPY9Ht3x9TSsAzDA87P5TSeiTgRqpkLCSGibTCh7r1q9fv6mmpiYuiX72Xmg3//FbXzZtFOgI8WOzzSJgEbAI+AkBrUtanz7FOrW3sz0uXSNRULHWaa3XWre1fmsd13qudT0unfLxRa0AcNPgMEmESZiY0jyyS1Uqx7+c/pqbmze2trZW3fRxT/7c03nefBrJur2vx5K/J4jbi1gELAILQUBCgNapT3/7y/gGnF/IKRb9Ha3TWq+1bmv91jqu9ZwThyPr+6KvkSgnsALAtJFkcsjpL4TqKJeJowI/jVT3W0vRifXE+i+f9lHPXu690GF+8dtfNZ1U9lNKTtssAhYBi4CfEdA61dnfz7r1FaP1Kx5N67XW7bNnz0oIaNR6rnWdvtiaAdMGxAoAETAi5J9Ohr9sbEilxPvX4026Ck9S2f1X4GHqOVay+etH1NnfB/lb7dW0eWtfWgQsAj5GQOtV50Cfs35pHfO6ab3GH2AFGQLXah3Xeq51Xes7fbFOgZEB8ZzUvJ4I87heGln+MvEiLWKy1JLoZyXPIv+1qI889/iXN+0vQP6O2t+S/zyG0X7UImAR8AMC0gRo/dI6Fo/oAEUGaP3WOh5Zz2u1vmudt06BN2aI3VaCw+OPP55KPv8MeYziMFKL88gaQkk2b9my5e54OP2dvNLtONI4Dn9W7e+Htcz2wSJgEVgAAqkpKebqyJB5+fxZs7W23hRlaQPuXdPmjTwBuSdOnBijeuBoOBweysrKGqGg0Dhr/tSXv/zlpC4aFJcsdt4N/52vFFH9Z5DetxABYBkpJdeSW/penEjup8Jf453PENtPKKPWz5JU4wg1uMMBIn9VIyQHp2omxBaQGJ9NuczVlEZUr7VAJf2PIMYYJ/rpppjj165f48Fcd/5fZ9775641r1W9LzUy1/3Qs7GpSbOirML8zQc+bqrzCzzvEjv+kwgBP2hsbHxp6dKl+xEEzlFQqIeOKF2wj0bPW2iS2qssavcH8mzyR5diI1qO09/qoqKitVT3q/d2KIy5RG7/X/rOVx11WVCS/Ij4tRDW5Rea5tIykxX23Foy52FSP4fGxpz+DlBEqWd42LQP9puJqSlDTdHXC53M+YT2g0mBgOaG5nkqlTbTUlLNUnaxJdk5ThEcEW1GeojoHP8oUzXHD3ddMO3Y4FPprx8EAW1mjnRdNL/M+vaXH/iY57UDKBxUT/n2ftb3Ach/sLy8fITJO84Dee76RLIKAUktADD4aaj8sygcUYwGoLazs3Mlx1ZjN1qJ84inv2hV9fvVJ75udhM6o/SaQWjjEGdNXr75xJat5tGWlaaIRTEobYwSysMT4+Yytc3PXb1sdp4/ZxRueai7i7rnk07Fs6i2ICj3ZPsZOwTY05vxySkIP8UsLygyGyqrzZbKGtNcUWHKs3NNTihsMkIhR5MUu6vG7kzdgwPmySMHzF9TIlxzPF5le6ffkTY1u1jffoV17s/f92FPqwhqPde6/vTTT/exzvdjDhikcNAoWoAJ+igNgJ6TrnlKcn5CN2r3p0+FkH+dqvvxvIlJsqWsrEwxo561EcjoN7/3LfP0yaOBKewj8t+ytNr8GT/kBxuaTSaLYZCadm6Z7NyK2c0tLy41DyxvNO9budbcX7vcyDP2XG+PGUJAsCaCII3q4vsqJhhHXZ2Zmm7esrzJ/OoDbzGfvu8t5j0r15gVFZWmLCfPZKPlokCIb8lfKGQjoKyrqjFbl9WZV8+3me7hQWcuLx6hxZ1BAtVpBO4OTJ3bljd7Kpiw80/DMTDv+PHjo9zFSGZm5hCPYSK9ktYfICnNn9Pt/niILoP418nuv2LFiofWrVtXt7gpPr9vS734e8981/zjazsDtfNfU1pu/uaD/86UowFIxHaE0KW/f/VF851jh801blALl22JjYB+i1oQ34ZA+5Ob7zUba2oT4oZPoNX61DcfN6d7rnpKuLOB5whZaNl+ZMMW858ffqfnv619+/adPXLkyHM4Ar7MZm8vQkHS+gMknQYgavcnHjSXNJEVPDeTLGJjYWHhls2bN7egGvJUKPr8i8+Zv935gpPb39MLz/brvMNx2dGLMjJR4X3E1LNzTtRWmpNr3o5Zo7Gw2Bzs6jSXR4Y9X6gSFVu/3ZcISWafBlT9n3n4HebTD7zVVMbBUc0tXIoxza0sKTffPX7EcdSVA2w8m64uzZrSBcvMdg9aCi8bNQPy2PQZfAJGcnNzB8kZMAwmihCYfOyxx6599rOf9bI7cb1W0gkA27ZtS8vPz8+amJgoZQI0UD5yHd7/m++///71hId4qsf+p72vmv+y4xnnxxAUe7Mc5n79gYfNw80r4jpxvbp4I46N2+qbTDu7p1PkOcduaKMGvALfg+tMRbz5P7Z6nfncOz9gNlTX+lq1v1BIJNDg62ReOHfGF4KshACteTJPFGVlmbUV3mVZ1yYPIaCAqIBx1n6ZAgYh/2Fej73yyiuTX/ziF6X0S4qWVHpNdv8pqPnlpp6P818lAkAzSSFWbdq0aTVCQaaXI/7MqWPmj7Y/TTzajR+Cl9de6LVk97+HBfKDazcu9BSB/F5tUbH5/Ac+an4CleUkGDCPAnkfttNvRkBhq3npYfO77Pp/H/JPVHNW9K5/fMu9ZjWheBLi/dCcTQ/rn9ZBrYdeNq335HlZrfWfTIEt4gOSBOWLH8QTXvYlntdKmhuNqv5R9eRg9y8j5K+BZD8rGhoa1tTV1Xmqyz5IiM7vfP8JMyonsyXBGYJ0dr8/c/d9gXP4i8UPTKFev/nWd5hfuf8txA1FYsBjcWJ7jrggMAYJ1uTmmc/jxPrxDXfFpQ9eXzQnnGF++q6tvtJgaf3TOqj1UOuil03rvtZ/8YD4AA1JmfiBPiRNquDgsM8iZ4ZSPyLlZRLvX9zf31/LoLei8l9N0Yj6RZ56Xl+/QGzurz/5DdNF/Lk80YPS5Bm9Faeoe+sagtLlmPdT6v+fufdB86v3v/WNRDAxv4o9odsIjKIKX1NWbv7uQz9i7kmy+fxIU6tZv7SKSAd/aAE01loHtR5qXdT66GXT+k+2wNXiA/GC+EE8kSypgpNCAJBKhzrRYVQ8Bah8qlD5NFMZagVOfysUGuLVhBscHzO/9b1vm6Pk+Q9Slj/hk4Kk/pE1GwMltLg1rj99zwNWCHALXJfPOzY5YR4kJe3/+OAPm+Ulnir+XL6zuZ1emqyP8TuWDd5PTeuh1kWtj0Osk141rf+YgFeID6gY2CR+EE+IL5LBFJDwAkBE9S/nvhxUPOV4/S+XymflypVryAblWby/vOf/ZMfT5rnTJ/D4T/dqfsfkOrKVtuLx/yBx0bbdQGC6EMAcs7AEAAGR/wPkefjT937YlKP+T9b21qYWswynQGU39FPTuqj18Y9xjNZ66VUTD4gPqAGzUvwgnkA7LFNAwpcOTngBgEFM6+vry5yampLqfxlZoFrxAF2Js0e1VxNM1/k/r71qvrR3V+DIX31HLWbeSUicn9P8qp9eNwkBP73pHkKrcAz0+uL2evNCYDr5e12QZl4d9eDDhSS/ephcB8p74LcmIUDrpNZLL5v4QLwgfhBPIAgUizfog2caYi/vN3qthBYAIiocR/WP418VEl7z+Pi4VP+tqhcdBcHt5+fPnDL/9flnCC8KXgiZiC0f56G3J0nY33znwi8/9Dbz8VXrSRs7Od+v2s97hMANtX+D+dP3fNjzanQe3eK8L/Pu1tUm04cpx2WaUJ4CrZfPnzk57/ta6BfEB5gCWsUP4gnxhUwBnC+hTQGekeBCB2ah34uo/tOx7eQQ8y+vf6n+WykKsZqkP57VpDxD7Ph/fuo7ZnhcHv9+s7zdGV05/8lpqL6o5M4fTsJPKKHJb5LN7EFUy0omY5u/ENCYSO3/X9/7IWpVePaz9xcIM/RmRflSs4aHn5wBo93UOqn18j8/9YTR+ulVowhcNk6Bq8QT4gvxhviD6ydsVEDCCgAMWioqnCxyOxRFVP8tpH1c2dTUtNSrCSWnP5G/8sr7oRjHQu5bIstD9Q1OApyFfD8ZvqPc8H/4zvebFSQN8uOCmgxjMNM9jlJj4y7y4cvmn+xq/5vxCbH7f1AREB7a2m/uw+3+1nqpdVPrp9ZRrxrlgivFE5gCWsQb4g/xCNcPTsjWPMBKSAEgqvofGRnJJ9lPJRJdE6qd1vXr17dQ+MGTe5bq/L/94FnzwtnTTprfeYyJbz6qe8ghUcoD9Y2+6ZNfO1JBTYQ/evT9piQzy3fOVX7FzM1+qf786vIK88fv+iFL/rMAvRUBwM+VR1U9UOun1lGvfGzEDxs2bJCpuFW8If4QjwBhQpoCPCHDWeafa4cp7JNOqJ9U/6VS5SjT06pVq1bi5OGZDvBrB/ea/7NnZ2DJX4OjjGErKfpTTY502+6MwKrKKvOftr3dsWHayIA74+XWJzRv6/IKzZ+++4OmptDO3dlwbiEXQjPRPXJi9WuTEKB19OuH9nrWRUwBOeIL8QY5AerFI+IT8YpnnfDoQgknALDwpkL0GXj9F6K6qWHgmhjQlc3NzZ55/R8io9UfP/e0M4RLfBdxO/eZJRLbXL3MSF1o29wQeC855X/urvucoitz+4b9VCwRmCRLY1FmpvmTd73fNCK82jY7AsoJsJoSx34LB5ze4+j6qXTBWle9auILfMVWyiFQPCI+Ea+IX7zqgxfXSSgBgMFJuXDhglQ1edhuKlTsh4p/LZT4bfbK639gbNR89pknzeUhf9TfXswkCmGHuw8HKtvmh8DPkS3w0YYm6xQ4P9gW/WnFjoeXpJrffeTdlPKtW/T5kuEE9y2rpziQvzlNjrZaTz/79JNG66sXTXyBybhZ/CEeEZ+IVyL8kjC8mTA3oklx+PDhNGo7ZxG+UYLqRul+m3HqWIlTh2dZP/7she1mV3tboFX/wnKKxbSCMqJNOLbZNj8EpDH57UfeZZqInPCzenV+d+XvT7M4O/kqfuOhR8yjrav83Vkf9W51ZTVhvmHfF7iSKWBXR5un/gDiDZzGV4hHcAisFa+IX8QzPhrCRXUlYQQA7f4ZsDBJa/IZrCo5cFDmsZUMT8sWhdA8vvyvxw4ZlfgNWprfmW5xErtgMyrUwkzP3CZm6kZgjy3NKzCfeeSdJovEJl5mNQssYIvsuAStn9my1fzIprsXeabk+np5Tq5pLSkLhMlK6+o/kyToiSMHPRskEgTVZmRktLLzbyIksFL8Ip4R33jWCRcvlBA3wWAoWk0OGjmXL18ul+MGOZ2bielsRQjwxHFD8ap/uP372NOuJ0Q9cQG6DvvgkpTg5S5w8fcyr1OrcNKn7nmAjGv+dbKa1w359MOjpPh9T/NK82kqNdo2PwRUiKeFEsHSoPi9KUGQhOk/eu4pc9qj/ADiD0zIreITHvVwixxLEiY3QEIIANu3b5cRKxN7TREZnGpw3GiqrKxsramp8SR7jUKO/uDZ75vOvl7saQkBqWMX3EgMtW2LQ+An2JW+q7HVqAKdbbFHQL+9zaixP/O2d5t066y6IIDvra4NzLql9bWzv9d8jvVWY+9FE48sXbp0BbkBmhEAasQzPCtNsL+dJ+YATuDZSrv/lpaWEOF+udhoKhgYZXBqWr16dYPKt3rR/om81c+eOhbIPP8z4SMpuzAjw9QVeyI/zdSFhDmmHdZ/whTQVFRs/QFiPKoqUlVPUZvPveMDRvntbVsYAoqWyCYiwP86gBv3p3oB/8Z6q3XXiyYeQZvs8Ir4RTyDKSCXawe+WJA3DOnuKKUhnWXKQYPd/zJs/404brQS+qfsTa63/Rc7zF+99LyRp2qiNC2sig8usYtqTIa0PDff/M5bHzWZ2DCtP0BMIHWcVLMhgt9723uTsqxvbFC8cZby3FzTGDABVeut1l2tv140QgKzCA1sEb+IZ8Q3XDfwxYICzVoRR4wwsf4FxGpWUs+5AZtNM44bVV5MiiHyVf8hqqie0WHy/AcayjfBdR0BoLaw2KRBWLbFBoH7lzebn1LlQJLU2LY4BLRT1Rz9dTz+7yVNtW2LQ0D5AKoKCp0oisWdybtva73Vuqv1V+uwF621tbWaMsHN4hnxjXiH6wbaITDQrBXJ+JdN2sYShWngpNGI6r8lHA574vj3t6++YF45T8hfghGl7GxrcAyyLbYIfOLeBygaVG/zAywSVlVe/NH1m82/27BlkWeyX48isJbCQEHbxGjd1fqrddiLJl4hQ2CLeEZ8I94hMiA7yBkCAysAPP7446nE+IcnJycLsMtUEabRQHhG87JlyzwxXO/pOG++sOsVo2Q5idYcz2AWBNtii4B2Wr9N5UDlV/Bz9rXY3nVsz6bqfo9Qm+L/pwyzbbFDoLW0IpBmTK2/X9j9stF67EUTv78MtSkAAEAASURBVIhnxDfiHeoEFGBulhYgkEQQSAFAjn9btmxJJ+QvhwxNZQxEHdJYI6V+G1JTU12PWxuaGDd/vONpMzA+alICWOL3dj8U2ahLKWhTSRy7bbFHoIGY61+6bxs6bOd/7C+QwGeUb0oDuf0fe/t7TGYolMB36v2tLcMHIDfk/4RANyOj9XdgbMxZj7Uuu93EL+IZ8Y14Z2BgoAyHwMCGBQZSAGCQUwoKCjKwwygco5qwv4ba2trm0tJSTzL+SeLcmYCqf/14HM9q7P8F5FO3zR0EPrhuk/nAitWYAibcuUACnlWZKR2nP8h/KZ7/tsUWATn8Lqfol2opBK3JFKD1+Au7Xvak6+IZ8Y14R/wT4aEMLh44Pg1ch7X7B+hQJAzD2f3zdyOOf3U8u97kdfr3r75k0lMDB92csLnGAlCKV7DMALa5g4B2Lb/84MPsZotJEhS8BdcdVGY/a9Tp79fA7G5bm2J2oBbxTjg93ZTn5TmJzBZxmrh9VevxP7AuexUVEOEbRwvATUsLEMiwwCCyWCoOGJnYXop4rsYjczlhf815eXmSwFxtsj/+t+efNX0jI4FzmJkrMGl4167CHmibuwiU5+Ub5a1PRRi4HpgIbHcxme3scvr792hNftg6/c0GUUyOSyB13X4ak57eehI5MPaOjjjrs9Zpt5v4Rrwj/hEPRfgocMmBAiUAaPeP84Wz+ycbUxlVmupDoZDi/j0J+/saNamfP3sq8IV+bvfjUHxtU0np7T5i34sRAg+TvvaH12w045M2NHA2SJXtbQuZ/n7xgYcNObZn+5g9HgMEWvBPCXImUxUM0vr8tYN7Y4DGnU8h3hH/iIfER9ICiJ8iWuo7n8AHnwiUAABeqVRjclL+YnepwQmwntjMRmIzXQ/7a+/rMX/98g8Szunv5jkor9pKYoJt8waBT92/zayg4qJ8L2x7MwKKlFDExO9i98+zPilvBseFv6pxsPQqe6oL3XdOKfPaX738vDnPeu12E++QHKhRPCQ+Uopg8RPXDYz9NDACgKQqsjCFibvMxfOyHNVLXXZ2dmN9fb3r+mrZID//4g4jISDIEvKdfhCyR1fn5pliogBs8wYBpbD9rW1vN+loXqgjZVsEAZlFVPzlNwj3a7Y5KTyZF+X89ktIAR7kbJVanzuoFfAXrNdwhuu4NTQ0VIiHxEfiJfGTeCooWoDACACMZCoSVwbhF8WkYqzG+3I5jhgNJGdwPV3djjMnzbePHEi4hD83/zrkaV2ek2eyqQ9um3cIbCWu/UfXbTbjUzYqIIr6GGaRHyPZz3tXrYsess8uI1CQkWmWof0LumOqogK0Xssc4HYT/1CLRtEAy8VL4ifxFNcNhBYgEAJAdPePiiWPQj9lpGCsIwxQoX+uG6uHiS39ixefY3GeTIgyv7f7QSgCoJj64KkpgZi7t7uVwL33s/c+aFZSlEV17ZO9ye5/T/Uy8x9teV9Pp4KqKRZm5QRaAyDApDnSev151m2t3243tNCl+fn5DeIlagTIF0Dh6IHQAgRCAABM7f4zSfpTRNU/xV3WS+pKT093nake3/+ak2UqlGDpfmf6UaTiA9yEHdA27xEoyMp63RTgvuLS+/ub6xUduz8k9FnK++aEXQ/smWu3kuZzlRSuSoQUVVqv93SeN/93/x7Xx048hC+a6gPIF8DJC4A5IBC+AL4XAKK7f3lYIl2VS8qStEVKRteZSo4kf//qi4FMkbmQWS/72fIiTzIpL6R7Cf8dmQI+tnpD0iYIigo+v0Z4ZBPaENu8R2CFIgESpLCZQgOVG8ALh8CampqiaVqA8kheAN9rAXwvAPATSAXMTCSrIh5VSFZ1SFv1aWlpru/+/3bni45DSSI7/k1fYpYgAFTkawdgW7wQ+OTWh0wLpZiT0RSg+O2Pr15v3m/t/vGafqaS33+ilDaPOgT+z1dedB3PiBagXvwknhJfERLoey2ArwWAiCdliBK/ObKtEG5Ri5S1HGmr2O0R3dvZbr55eD/Fflz3MXT7VuZ0fu2+srABFqN+tS1+CJTgg/EL1ApIwRwT3RHHrzfeXVllklex6/9Fsv3ZeH/vcL/5SsU4ASdSfROt3986st+8xnrudhMviZ/EU+Ir8RbX9HVeAF8LAICX2tHR4ez+kayq8LSsw/a/3O3dv7xg/+aVHzhFJhLpx3C7H0A0BDDPRgDcDiZP3nu0ZZV5Hw8vMpp5ckN3uIjCzrKplPjYI+/ECS37Dp+2b7uJgEKAKxFCE6VapdZvFQvSeu72PYmXxE/iKfGVtADiL8bLdW31QueEbwWA6O6/uLg4B8//UqSqZXj+L6+urnbdSP3s6eNm++kThP35dtwWOt6zfg+8TSk//CwqgtkWXwTkxfxpEgQpJ4Pbi1Z87/TG1SeJfPjkPfebTTV1fuhOUvchOxwypSpX7UEMvVdAax1/jvVc67rbTfxEmmBpAZaJt8gR4GstgG8FAAZK7BtGkiok13IlUlVtY2NjHakXXe2zwkb+B9KidsRaiJOlKQQwD+k/6JnAEmW8asjL/jN3bQ1scZa5joO0HA9R4OdHN90z16/Yz7mIQCgtnUqgWZ4k0XHxNt50aq3jUa2u22GB4ieyA9aJr8RbJAZSWlXtqny5m3SVTN80CvP4Q7v/9vb2dJwocgHS2f1jT6nD89/1uP9vkUBC9n+lxE2mtgSbcyU7Ttv8g8CH124y9y2rM4qLT8TmhPyxQfrNtzxqVI3ONn8gIDPMtQTzQNF6rnVd67vbTTwlvpIWgNw1peIx8VlEq+325ed1fl8KANxBCjmVw1NTUwXYUZb29PTUkHKx3u2sf71U+fvCrlccB6x5oZgAH1ZVurp8WwPAT0MpUvxlkuHkpYcTMk2w1Myf2vqgabQhf36admY560BaAmo/5Vir9V3rvJtNPCW+Em+RHbBCPCY+45q+41vfdSgyMGmAlj05OVkkZwrUKnU013P+f+Xga+b45S6TlmS7f2Gu0J8KqwGITD//PK0jI97H1qxPuDTBUv2/u6nVfBQth23+QqAqv4BIAL9Sw8Kx0rqu9V3rvNtNfCXeQoNdLR4Tn3FN34WU+W6UUZOoT2EcKPKxoVSQ+a+GVIv1OFO4qiPsHho0/7x3F8V+kkv1H/0hyE5W5PirRI/YZ78g8FN332+WFxQFPkd7FE+p/mvy8s2vbnubSU1CYTuKg1+fy9gIJGr0k9Z3rfOXWO/dbOIr8Zb4SzwmPuN6SgzkK871VWc0IIcPH06jslKWSivKiYIkQLXguNTNwdK5Hydl5NmeKwld7W82DBWGlUMYVmG2DcGaDaN4HldugE/e8wAZWhMnM8AvYdqoRqixzX8IaB1IRA2AkFZyIK3zX/YgRbB4S/wlHhOfidfEb34acV8JAHKSKC0tDQFQrpwncKKoqaysVOpfxVK61roGB8yXD7xGSdbk3P3LFluRk2NyEQJs8ycC71m11ty/rN6MozoPcpND43uaV5j3rlwb5NtI6L7nEQpcgSNgooagap3Xeq91380m3hJ/icfEZ1wrV/zmJ2dAXwkAAJQKQBkU/SkkfGIp2ZSqCf2rcnOQdO6vMBnO9V5NmBSY88WLCWkKMrJMhvXEni90nn0+nYxmnyJNcAZhWkHVA4hQKrNzzS8+8LANN/Vs5sz/QlmhkCmiOFWiRQJEkZC/k9Z7rftuN/GXeEx8Jl4Tv3FN3+w0fSMARKSidJwmcpCWSq5cuVJdUlJSx8PV2LRLSIGaCMlq+9cPQAJAbkYGzo++0k65/dsM3Pk31tSaj6xeF9hiQTI1/Txlj2tsxUlfz70MNIF5mZkJlQvgZsC13mvd1/rvZiORXZ54THwmXhO/cT3fhAT6RgAAlBRC/jKQlAp4VOD9r93/MpyEXM3G89WDe00b0mCyFPyZabJrYc7NcNXKMtNl7bEFIPBTd91nqgKYIVBe/w9T7fAj66zX/wKG3dOvyCE4O5SRkKGnUSC13mvd1/rvZiM98BLxmPhMvCZ+E89xTV9wry86ERkAJ/QPdUkR0lIliRRqly5d6qqX0JXhIaTAvUm9+xf2ygFQY0MAI9PQ309VBYXmxzfcTUTAlL87Oq13yjJZiID5S6j+k1nQngaJ71+SwzahnE5nAvyGFmCvuQwPuNnEY+Iz8Zr4zU8hgb4QAB577DH1I4SNJBcJqQwJqaq2trbG7cQ/3zlyEI/Qy0m/KCnkpxzbrG3BQOCj6zeblaUVlAy+FogOq7Tx/0eq35Zy11N5BAKPIHRSG4LUFFeVr3GHIRoR8AQ84GYTj4nPCAmUFqBMPMf1QhHec/PSdzy3LwSAz3zmM6lkTMocHx8vhPwVOlFNOkVXV4vB8TE8QfdYZySmiASAEpsD4I4/Fr98QP4aP0dugCAUDBb5byivMv9h891+gc/2Yw4IlLIhSNRQwOm3n4KQIx4QH7jZEADKOX+1+A1fgCLxnXjPzWvO5dxxFwCizn+Akot6RE4SleXl5ar856pR+vsnjpij3ZeSNvRv+uRQAaACmwNgOiS+f/22lhXm3upaMgT61xSgaIV05tYvP/BWkxOW2dO2oCBQQBRAMhRDU0igeOD7J466OjSEBGZVVFTUkB64isyAxeI7Lhh3Z8C4CwCAkEKihAxAyeO5HBVJ1fLly6vcnHyjOCT9y7497KBsEwLSAOgHb1twEFBY4M+THCjMAubXsEA5/n1wxVqzta4hOMDanjoIFJAHIFnWR93nv+zdTXSNezk2xGckBlIkQKV4LsJ3cXcG9IMAkAYYWXKOYPdPPpqcmrKysgI3f4cvtZ0x+y90JL3tXxgrCVAhu7NsmwTIzSnnyrm3kBjokYZmtADuLVwL7bgT808Gw59FSGErudDT2O/FCYE8nDaTpSKqfAH2XWw3L7SdchVtNAD54jfxnPhOvMcF4xp7HVcBQOp/siTJ+S8Hm0ipUiZWV1er+I9rthHFvD+OzWcC1ambWgZXZ1IMT64QwEJifjPT4joPY3hHyXMqmW4+cfd9CG/+Sw40de26+ekt95pqG/MfyAmZnZ5mSjOzEjYb4PRBEQ9M4lD7L6QH1nroVktPT08Vv4nnxHfiPfFfxAzu1mVve964CgD0LDWDBhAFEbVIFc5/Zbft8SLfPNh1wbzYdjpppNs7wSWBKJfUnyGbBOhOUPny/VVLq8yHVq4jRfCEb/rnOP4tXWo+RrSCbcFEICONZEA4mwYjzmTxGIcwpb3cdtYcvNi5+JPd5gziN3b+EgLKxXviPz7u2ob3Nl1x3oqrANDe3p6OJJSN97+j/idNYhXOf64ao79+aJ8ZGBuzu//IzHAEANR96VYDcKffim/f//cb7yLOntStLu5e5nrz2j+lUUr2P27dRmppW1tirrj57XNh1oOccGJnA5yOubQAigT4GvzgZhO/iedkBhDvif/Eg25e83bnjpsAAPGkoA4JAXwuWZJKcY5YWldXV4la0zWDYWd/n+PtKc9k224goAU7k9zf1hwS3BnRUFJmPkyxIJm14t3G0EQ82thiHljeFO+u2OsvAgGVac5mXdAGIVmaeOHpk0eNeMKtJn5DC1AlvhPvif/Eg+JDt655u/PG5aLqkMoi4vGfCRAFPMqw+1fhJOFq5r+nnMHtTdqiPzNNBP3AszAB2BZsBH6URDvl5HKIpxbA8SdBm/RJ8v1bgTLY80m917qQqAWBZhodFQkS+T/lckggmQELSREsIaBM/CcepD9xMQPETQBYuXKlBIDskZGRIjIkLSX2vzIrK8s1naFC/751+ABpb+N2yzPNubgf06RflmuzAMZ9IBbZgUpSBKtQUDzzAiga4aOr15umMldzeC0SKfv1uSJQgDC3JHkUAA4s4odvHTlgxBdutezs7BCb3aXiPfGfeJBrxcUMEBc2lLqDjEhhqT/kDYkUVFFTU+PqqvFqe5s5fOmCDf27aVZrAqgUsG3BR+Cj6zabpXHSAijsrza/wPzY5nuCD6S9AweBSpKDJXo64JuHWiGB4gnxhZuNzIAV4j3x38TERK74MB5mgLgIAACbxk1nctMF2EHKiI1c6nbZ32/g3DE+aUP/bp7UUtUWEO5jW/ARUKGgD8VJC6Awqh+jSFFFnqspPII/SAG6g2KEySVJpjHVeiieEF+42SgTnI8moFL8hxagQHzI9TyPxY6LANDV1aUUiNlKhqBwCKlDiIZw7ebbeq6aF86edtKSujmoQTy3kwWQPAC2JQYCH0MLUOGxFkDk31Jcaj60dmNigGjvwkEgBx8A1zyyfYyxnAHFF2fhDbea+E68J/4TD4oPxYtuXW+283ouAEjNgb1ftv4cbB8lxEKW4wXpqvr/6ZPHzKWhAVv4Z4ZZIInXOgHOAExAD0kL8GGvtQA4kv7MXVuNihTZljgI5KMZ1AYh2ZoSbIkvnjl1zNVbJxpA5F8hHuRCOeJFr80AngsA3Ggato9MJB+p/0tR/1eiDslxC2nld37y+CHr/DcbwPzAc60JYDZ0AnncSy2AQg/XVVSaR1tXBxIr2+nZEXDCg2d/O6HfkTPgk0cPuVofoKioKCcvL88JBxQfihcB1TVN+EwD5rkAIDVHxPu/UOoPNTdT/+4j5/+Rri7r/DfD6DthW6j5bBrgGcAJ8CFpAd7busqTGgHaH/74prtJ+uO59jLAIxSMrudgGpSGMBmbnAGPXOoy4g+3mniPujfl4kH8AAoj0QCJKwBIvYHtI4TDQw43XEwmpLKqqipXU/9q9z8yOZ60E/l2k5fxMLnhsMkg6YdtiYXAR9ZuMsVodtzMC6CQw3soSfxI04rEAs/ejYNAdlr6jXoASZQMKDr0EnzEG9+FP9xs4j/4sFR8KF70OhrAaw2AvP8zUHXkI/WU4gVZ4ab6/+rIsHn+zCl2/5bgZprESvKRRyXAMD902xILgcbSMvOu5pWuagHSSNr5kxT8Cdvdf2JNnsjdpEOCSglMOsCEvL873ZR4Ywf8IR5xq4n/yH8jZ0CFw+eLH7mWZ1oAzwUAdp3Z3KQT/if1Rzgcdu1md7WfM+fw5FSyG9tuRUC/63QmeTI6+tyKRuId+Qhe+TnpYcS82Dft/u+uqjX31zfG/uT2jL5AQLUclAxIJcOTsYk3xB/iEbea+E88qHBA8aL4kWu5xok334dnzCj1PwUQ5OXohP/h/ViK97+r6v/vHjvsTN7ktGLdPNS3/s1YmDzsfLYQ0K3YJMKR1Tjnva2hmbjm2Gc1S2N3+BOkH06z5qNEmCoz3oMIMJmrhIo3JPyIR9xs4kHxYTQcMMKTnnCzJxeJgJdKJaQwN5nLDZbgC1BeWFjoWg7aiwP9ZldHm3X+u8PMlbNLsjr63AGa4L8NSX9s3SaTgRo3lns4Z/eP7f/+5Xb3H/xJMvsdaGOQjY+QNgrJ2rQ+vgqPXIBP3GriQfGheFH8KJ7kWp5wsycXiQCXSgRAFnYOqf9LsH2UYvtwzfj88vkzpoPCDlb9P/u0lYNYFj4AidoUotbND7fPRRue37HbVFOLo96ymFYK1KLxMcwLibz7H6JkuOaOqhsmc0vkMZ7LuIo/Ovv6zMvnzszl4wv6jHhQfCheJC9OgXiSE3liBvDkIkiQ0qYo+1+Wwh0QAkopBuSq+v/7x4/ivLKg8UiqL4UTUIV77fo1862D+8z/3rPTdA4OOFqgTUurzA9RqGYbpWqTqWkB++H1m80P2s7G5LYnrk2ZDZgW3trUGpPz+e0kx7oumC/t3W12tJ0ywxMThl2K+cDKteaHN95lMrGJJ1tzKgImsQbAGW/Y6/tUCPyhVetcG34qBJZ1dHSUUhugMDc3VwKA+HIM7ayrLOaJAMDNpCDdhCcnJ5X9r4ha06W0fLfQ7OzvdeI3pb6xbXYEhE9Nbt7sHwjgO9r1/9Gz3zNfhPyxbTgaIP2CvnP8iPkeGSHfT8KaX3nobaY0iSog3oej3pryCrOPIidy+lxUgwz+/Ya7Ei7ufwrB5gs7XzL/49UXTPfwMLbvVMc0Jg/w39v+fbP/Yqf5g3e831GJLwq/gH05iwiPZPehSiMpkPIBtPf1mOr8QldGUHxIBsJS8aPMAOJLBAGFH0y5csHISb1iSMofp2Xg5ZinG8TmoQJArumed3WcN/IBsOr/O0+dRNMA/M+Xdph/2P2Ko55OZxFXhEMqD4Uzydfhy4f3m0989Z/NmcvddwYnQT4hb+4Pr9lgrl1b3GZCOf+bi0rNW5oSS4syMDpqfue73zZ/+PzTpm98nJ1+urN2aO5ISM4Cv28ePWh+/5knXc2r4MfpVkVdiRTCPZO5iUe64JNXXYwGEB+SGbBM/MjOP1d8Ceaub9A9EQCwaaRxY1moNwoU70grQQvg2qxS7n/b7oyAFriSbNf8MO/cgRh/4rXzbezgXjKhCNnffHoJAHKI289O+JPffDyphIBHW1aaejIETi5CnauSvz+yYTPJo1yT3W8eMtf/HoHwf+PJb5gvHXjNpKemOcLiTBfNJFfGVw/vM08dczcxzEzXjucxYWLbDQSeOXncNSjEh+JF8aN4Unwp3nTtgpETuy4AIM2o+E86Wf8U/qeyh0VUQSpy68a089/b2W69/+cIcKJoSSZR/f/FyzvM0MT4HfMaSBtw9Eq3+TUW/qtDg3NEKtgfK8zKNh/Ehjk5tbCQwCn8KuryC8w7mlcFG4hpvZdG43P/9l3zXey72vXfbkci4XEKBcrfIWCOIjQkSytBA6C8+MnepAnaf7HD0Sy7hQU8WTQ1NSUTQIH4Urwp/nTrejqvqyePdDwFaSaMd2MOzn+FmZmZZRRAcK0AvWw18gFIFGJzdfBR7anmdyK0XefPmhfPnWX3PzcbtzQBuxEUf/epJ1yJk/cjpu9DAChBEFhIeuDJqWvm/SvXmKKcxJgvGp9/eOUH5p8O7LmR7W4OAyaT0l58AZ47fWIOn06Mj9h19MY4Cgfxyl4XawPk5+dniR/Fk+JL8SZXd5WjXT155CeQhkNDJjv/XPIcF2PnKOImXQv/e+7MiWTNXLmgFSdRsgB+89B+M8budslt93FvhkgFbJ44ccT8466X3/xGgv5VjQngPezg5ck/nyaBoYS6Ah9cvWE+X/P1Z3ecOm7+DH+R+aQJl4ZAWHz90L6kiY3XBiFR1ojFTkhZz3bAL2418SI5AIrEk+JL8SbXctUM4LoA0N3dnZaenp6JWiNfBQ9Qa6j2sSutf2zU7MEBUE5ftt0ZAZFlDqk+g946e3vN9rMnseHObfc//X5T8Yr/S3aC+5g3ydA+tGa9ycapbT7ugEr882hzq6kudM1y5yn0Hb095vee+a4ZxwQwX3LTHNvded60Xb3iaZ/jdbH54hOvfnpxXfHKbtaJPpxG3WriR7hSZoB81P+Z4k+3rqXzuioAyH6BY0M6Ko1sHgVcr5C8x66F/x25dNGc48dt1VZznDJMaKfYxxw/7tePKUlH99AQi/n8p7MWuP7xUfMHhA4mg2135TzTA0tQyEFg+CjVBROhXYP0/3TH0+ZU71VCIhc2XxQa6GZiGD/hnJvEJYFvHgfxivjlaPfFm9+K2d/iR3izSHxJ2Hy2+NNNP4D5/wLmd6uO/R/Hhmx2/wVU/1P2P9e2nK9gAx4l77kcdmy7PQJa2LOxgy9kEbz9mb1/9wcy+3DZhY668p3LH+Af97zifec9vqJ+Gx8ni18G9zwXLcA4ZpUHa5ebVQgOidC+jfpeOSEWJ/guMS+ePZ0IcNzxHhRRY9sNBPTbGYNfxDNuNTgyUzwpvhRvuu0H4KoAcPbs2TRCGqT+zyW8QfH/BeQ8nr+edg5oK0TpZQbGqqzmABYf0U6oCPW/En0EufWNjJjdeOcuNukTYThO/oAzRAckettYU2e21TfcsVSwBITQklTz8fWbEkKo7iSRy39/8blFCYuaG5pr+y51kmJ6JNGnipM4KgshYC7CYsKDwQ2KX8Qz4hs3GtUBU8WT4kvxpvhTPOrGtXROVwUAJJk0iMZJAMTNFJLvuNitGznf12tOX71s7f9uAezT857s7jI9ZG5brOAn+94lQgL/5qXnE97BS6rMH9t09x21AMqqeFd1jbkbDUAitL96cYc5ixCwWGFRc01zTnMv0Zu0hNooaMNgm3H4RTxznnnkVhNPii955Ik/xaNuXcs1AQC7xZJQKKTtZRZqDNn9C7BnuJZ15jA5vLtZwK39f25T5RoyfT4/7AwSnAS5HWDcB8bHYrJDlbrzO8cPm1eSQL27SVqAuttrAbQ4fAzb/0KcK/02p3a2nTbfOHLQSQS12L5JFdxPsaBD+BzZllwIiF/EM4e73Bv7CE8WIACIN7PEo+JTN5B2TQCgs6mkM1T5XzkA5mP7L5F9w42b0DlfcrFak1t9jud5FdKindBid87xvAdd+zDq/4U4/83Ub/3Cxtj1/g1RAdr9JnLTQvaJu+83ynA3U14AhQquc4r+BD/tr+y2n0f1PzrPMNHbjT95282py5du95GEeE+hstooaMNg2xsIuMk34knxpXhT/Cke5cqumM5dFQCwY4SJZ5QjA+GNBbL/u6LKGKVkpxI0BJ3M3phe3rxyUp+ykAW1aWHfzy4sllof7XZfbm8z209STTLB27qqGvOxVetn9AVIwaXyp7ZspehP8CvgPYHj307Ct2KpyUgjiZY0AG7Zgv0y9SRcK1eCNgy23UBAPCO+GaVapBtNPEmyPKUDVubcbLIC6kcYLAFAeYxRW2TQ+VzVOMaxwZ0ySiBz+soNm0wsicCNgfXTORkbp7KZnN+C2rr6+0wPjlixFPykBVC+fFUTTHQtgMb9U/dvM2vKljrRM9F5MMLC9sjyJvNI84roocA+9w4Pmb/d9RL71+sLjhKZ6eY15y4MDZheQgITuek+F+szkWj4iGfkA3AKXwC3Gm4AygaolMC5RARIc+7K5tmV7V/E/u8IAKgx8ohnzMOu4Vrd2cPEZfaPxpYI3BpYe97YIdDBj3BI9v/YndI5k3aKqii5PQmKSqlGwOcoc9tSXGKGqaMgPPX617a9PaaalRgP0ZxP96XXXjXHiOyYT8a/uZ18iRkZnzBdfX1z+3hAP5WOX0xORkbCO8bOZ3gkFIlvjrjoBFpSUpIv3hR/aiPd09MjPo31UudaFMASNvzp3EAWUkwumQCL3bT/72o/DwnEHJv5zInAfVYaPdn3gtxOk8xlkN1qrPM+vK4FoKxwMmgBVlQsNV/86I+aX7v/reant9xr/vqHPm5qi1wL2PFsynVTGOzxg3tjqvqPdh4OMIMIS+0DvdFD9jmJEBDf7MZU6FZTeWDxpvhTPCo+5VoxJzlX1Ap0NPXKlSthEhkoAiAvNzc3nzzHrhgTlfhHEQDJXrN6vhNRJoCgJ/m4QOhnzH8RESAdLQApX+UL8LaWxKmAN9s8KcvNMz9/37bZ3g7k8S+g+m9DS+RGpIsIYBxHyXbMUIne9FuwLgBvHmXxjXxAxD8qLBbrJr4Ub4o/xaPiU8wCstfGNB7TFRMAnUyl044DIPaLfBwa8lXvONYg6Xxne66YDlX/W0AaWDf6Y8/pHQLneq4i+LkzhTVZJymB+39QIU/Os3iOdwjYK82GQDvaoa8fPsDuP/aLc/SaEgIuDw5E/0zY58wQtSOsF+Cbxld808EGRPzjRhNfUh0wT/wpR0DxKdeJucOWK6unChgQviAHwBxUGPkUAHQt//9xQnF6cMSJpSOYGwPqt3Mq8U1ltmtpGVy/XX4QTlIXNws/RX0Bdradcf1+7AVii8A/4cTZpbwg0tW71LTmyBE10Zsl/1tHWGMv3jnhYigoav8C8ScmgGzxqRuFgWIuAMhRAduF4wBI53P4O4cbca2I+IELncjh7v3Ibx36xDgiu3lOgH0AVPnxKhEALq7vzrwaRdD40r5ddgcUoGmv3f83SfqT5nKEi0jg/EBfwmfJywuF7Qo7w/wX7+wjHNCtJt4Uf6IFyOVZPgExdwSMuQAAGEuQVuSwkIH9IofcxkUkNXDF/q8EJgex/8faCcytAfXbeWNqTPL45voh/4kYJnaZrfshSGQHmQGPMs9sCwYC/7J3l7no8u5fSDgaAKpQKnlUIrel2TnWx2qGARbvHMYPYKZEWjN8fN6HxJviT/hUG+iMCK/GdLcbcwFg9+7dqdj809n9Z0pyUSOxgSsCQNfAgOl07P8xxWTeAxXELwixQjJ8BbX1EN+tREBuN/3IB8fHzZcPvOb2pez5Y4BAN2vCvx47jO0/5kvbrb1jbigR0BDaqERuQd4ouDkuMi/JD6DLJT8Q8SbRALmEAiqXTqZ4Vfway3uK+a8Ex4WUgYGBMEUMspBYcnUDbjkAnuu7ai4BviRx2+aHgGMCCGfM70s++rTsu8NOCKD7nZIq+XsnjhhFHdjmbwS+sn+P4/nvhVOwVp1xhNCB0cQWAMI4UtoV9tZ5L94R/5zD5ORGE29C+o4AID4Vr4pfY3mtmJ5MHWtsbFQEQAiJJYvnXHkyxrLD0891lEQMY1IDWwFgOixzfu2W6mrOHVjEBy+TiEOqVy/8PyTpXxwcNN8+vH8RPbZfdRsBmYW+eeQACYxiukm6bbdVM2GIwkCJ3IplArBRVrcMsXhH/HP0Utct78XqAAKAwgClARCfhsSvsTq3zhNzAeDq1asqARwmAiCLRzYlALJj2eHp5zok+7+VTadDMq/Xsm8HtfV7HPmhcMMnUC2PYA6wzZ8IfAsB7QTpWb1KXat9hxJF9Y+N+BOQGPXK7v5nB1L8Iz80t5r4UzwqPhWvil9jea2YCgCKAKBykTqYodAFVBi5ODIofjHmbRLbm2oA2N3/wqCV+kppYIPa+qjH7qXsl44AcIyQn+dPnwgqZAnd71HSGH+NrH/emgNJB4wJ4AraKNuSEwHNt1NXu13LGCr+hFKVDVCLNTmH0mIaCRBTAYAOslFKSSNxQRgHwGycGPJ5uJJvtmuw31yiGIebcb7JOaWDcdeDOF6pYp2XbQKh8+uH9tuQQC9Bn+O1dpw6YQ5hElTuBq+aZp82IkMeOKN6dU8zXYc0rnajNRMwHJMA0I0fwEX4yI0m/iQSIF98Kl4Vv+qysbpWzE4U6VBKX19fCGklA5sF6f+zs0KhkCsCQCcJOC7jCGZtU7GaCkE6z3VzdXjQ80VJJpOX2s9SB747SGAlfF/lif9/cf5TFUevm8wAgwmuAVAqZW9Fba9HceHXE/9cJhT0Qr87AoD4Ey1AtvhUvCp+pbcx4+2YnSgCYQpe/+lIKpnqMK9zZDt1o52+esXJw6wfoG3zR0Cmk9yAhgFeY53vw/HK66EXZgNc9xuomm3zDwL7qdmws+OcCbm01tzuTmUDViRAIjfvxargoCn+kSPgGZdSAos/iaR3BADxKvUAfK0BSCX5T0gdxWNR/O9artnj3Zf46XlNAcGZmHfqqcgM49KdPubL97Xju0AeAG/tvTegUC3w71MgqC/B68D7cuBn6ZR2/4P4AMTLH2iMcNREbprzts2OgHjouIulgcWj4lPxKk6AMa0JEOuRTcVTMURnM9VZQgCzZodtce+cxPFC0pdtC0cgqJK9MgDGK4RRHuZne3uMbM62xR+BDmKwd5w5aRSrHo8moUO14RO55WdlxUXYDgqm4qGTRJ+41cSjkU11pviV68TM0SVmAoAiALBPkDMlNUTIglLMyQfAlQiAARzAlAUwXhK/WwNtzzs3BIYJxZtU+tU4SYBT2JqfPH7YOgPObbhc/dS3qfjXhQ02HtogV2/MRye3mtbbD4Z4SHwkXnKjRXhUoYCOACCeFd/G4loxEwDozBLsFal0UkmAMvFczKV4gSsOgJdIymIrAC58+LV7LsH+n+XO8Cy8YwH5puMMeN46A8Z7uBQJoqI/8VZRKxLAtuRFQMKn+Ei85EYTj+IMmCdehfhxdXEyXflSAJBkEpa6gpZNp13Ryyn8r9fjRDBuDGy8zskYmUzs/14lTIn1fSrjmxyvYvILWEDnHLUvzoBKPGNb/BCQGeYUzlfxnMdOGBjrkTFBNajdefz0O7Pa1tlx0hwQH4mX3GjiUcIBHTOA+BUBQLwak+UvlhqAFCSUNEIVohqADDoey/O/jm07OdmVgtOqpl6HZN4vgrxcyQkwXj4AUaC163wKZ0DtQm3zHoFrhIJ8/dA+M3U9/rtvCdSJ3HJDIVNB0jD97my7FQHxkPjoPL5BbjTxqBICSQMQ4VcJADHh1picJHLTKdj/pfKPagBccwAU0Jb8FzfVrrNjCeqyFRPRd3HwObvO0z1XzSttpxd5Jvv1hSBwpKvT7GxX6F/M/KEW0o2k+I52/9bH4vZDLT4655IAoCujAVAiIPnWhSM8GxPujslJItCkYP9P4xFGSslSEqDI8Zg/tblUfSnmHfXpCUX8mWmhwC6eA+NjjsQdb3hl+/3moQPWGTAOA6Hdv+aBVU3HAXx7yVsRYFfS1nvl1uMxOoIGQLt/1ddRnR1/agAI/1MSoAwVLaDDrtSaler3AlkA7Q9/4TNLGOaEwhIlF36SOH5zeGLMUUeyMYlrU9pZZQZsczEEKK436NOLXyED6L+dOu5p2t/bQRHnaXi7rsXkPW0YgqsvjAkEdzyJNAAX+gcwSbmjV2VDrdw64dHR0QzxLB2KyeY9JieJoKMywBIAwhIAyF7kSghgHzG3vTiBWZXUHefkbT8QbBOAP5ZczcGrzMXv4Ilum3cIPEUI5rn+3rh7/+uOtYB24wA2pfSUCdoU9ZKFxjBx73DxA6e1IMpNiz/brWcgGVBYDoDi1ogAEJPdW0wEADqmHAASABwTAF6KmW5FAFwhA1wf5TdT/MEBt46UPZJUCMgZ8HsnjpjhBK8J75dBVf6Hf6Usc4ycoBd9W9JEXhkdjbtT6qJv5DYnUJKlbDSG8Xa8vU0X4/6W+EhloXtGhlzpi/hUvCoTgHhWfCveXezFYiIA0Iklw8PD+CakptM5BMbUbJUtXGznZvq+dv/9Th74Rd/7TKe3xywC80JAIWjHKUu9A5W0be4jsLfjnNnT2WFUntkvLdG1kdYEcOeZJhOAeEn85EYjFYAoNSvCr+niW66zaBKM1a9oCSr/FNQTEgDIARQmzDwtJiqKm8G8TBW4sckJ6wNwMzDz/DszPWRI2zjPb/nj43K+89NuRHa/r1AgiPnvD4ASuBdfoxzz8GT88v4nMLT21haBgDRB4iXxkxtNfIoQ4PgBiGfFt1zHPwKAshMhlaQTqxhGXaHkRa6wy8UBd8ouujFofj2n4pYzyALImPm1i7ft10VsrsMUYPFLKKh2o6+wMz14oeO2/bZvLg6BS/z2lfc/FKe8/4vrvf124iOwxLjFT+JTNtbKsRMWz+IM6C8NgDoEsaQrUYE6ihlg0dLJTBPGqQGweMFnplMn1bEgO/RIgPFT/yX9DyGQfNWWCXb1N/Tk0UPmIhEAia5ydxXEBZxceAdVW7iA213wV0R4XYPuZAMUnyIEqNBeunhWG24ut2iOjdUW0KkDQCeVCTBMR12JANDIuAWwzm2bRWChCMhT+umTx8wFslTaFnsEJibl/Hco9ie2Z7wjAlifTU44w+a7uCNSxlyiKJBbTbZ1hdmLZ30nAOCdmAr5p2OfkAbANQGgmx0AAqltFgFfIaBdknan37b1AVwZl32dmFguXfRN7L8rN2lPGmgExEvdLvkACBiyAYbEr+JZ8S2HFs2EMdEAtLe3s/4tkQkgTV6KbgkAyrfsZP9a/H0HeqLZzvsTAflUPEGImsoV2xZbBOT8NyLn39ie1p7NIhAzBKKRAOIpNxqadSUBColnxbfi3cVeZ9EnUAeqq6uXIJU4GgCe8QEMuVIGeJAwixEWV9lcbVs4Ak4mQPeUNAvvWMC/KWfAY5e7zUtnTwX8TvzV/cvYVW84/7niV7zom5VPim0WAfGS+Ek85UZjY60oO/nZ6ZEq3l3sdWIiAHR3d6dgm0hFLeE4J6CqcCUHwCDgDk8gACz2ru33AxsB4PehG0f6/wZmAEsKsRupp08cNV2O819MlqvYdYwzifrzEaatY2JMYQ3kycRLI4SoDrmkARSvRrTsaeJb8e5igVr0CdSB/8feewDGlZxngkUi55wjQRLMmZzhzFDDGU1QliVLGjmeZe3Jllby2es7SQ53lsZ7J5993ltZTutw69P61mtL8lmylaUxJ3FmOMwkQOQMNEIjdwMdAez3PXSTTQxANNCvXr9QxenpRof3qv56r76//vD9ZWVlO9A5xCakpDJHkZpKoh1b7/eLKP6hmQGVBWA98aj3TCABBgO+MdQveqfcJuiN9bvAErTfB/WvWffYtKaxVG6KzalJSXhl1jkwy1VOCwDTkxewSZXRiKtUAHDsNOItcTfR8ySsAKBD7MROmiRgnmDnyFgkxVZHwfq1/O9Eh61+ryQgRwLcCU6Dp+BbqFanWuISaB8fFdfAr0D3ilmbE4AxG1YOZdV68BVIICQ+LWCjKqMRV6Fk0A3AbDstCDCCv9s+nS531eDg4E7yAMAFwPQEaRwAPgjXHw6rGIBtT7f6oRESSEGK7o+6O6X5Ao0Yg1nO8V3k/tOkquJ+zDIjqh8bSYDXKPGJOCWjwcLOdPt04izxdnZ2NmH8TvgAGOiOwsJCjH0HqYCpAKBKaooex32LDL0BFt1QdKtvEcw23nDCrmUbYtHlJzSX9s5MiVd6u3Q5nlMPQpcfg/9YcEk1JQErSID4JCsIkLhKfIUcaAnYubCwkHAqYMJ31vPPP0/tnG0nTBPoY0oaX8uYrPmAHN+KjL6a+ZgMocjQiKTM3MuN+0Yze8LOr40Pr8snIfiuv9veosymCUjzzYE+0QNFigqVakoCVpGAJ+iX0lXiKvGVLgBk2lEZ2EH8TaQlfGd94QtfoAJAHoCd8EdQRWEmQMLHXW9QXk2wZl/61+u5ud6DIUnU5+Wbq1Nb6E0Vgq5y0lmf3Lx2DAYDXhoeFAPTU1sYmfpqrAS+19km/Evh2LfUayUBk0tgh/BISgMkxhJfudHm66qqKkH8TaTpAdREZPRH2/WzY3QDSEFpL0yCUg6ciAQt+lvMk0V7johTC1gA2McpBAPSCqDa1iXA3P/XYAGgIqWakoBVJMBVlTglo2H3r1Huw9VOzp2dXq+X+J3QQq6HAnCfCwCRitRSEurURsLzBeUEV2x0PvW+kkAiEsB9IH7Y3a6VCU3kOE787Ys9ncj990DZ02WJcqII1ZiTJAFZOEVcxYPZdpoFgLvuRIeox93FTuwgNzFTEqil6NGx9QZGEiDVlASsIgH6rjvBDHh1aMAqXTZFP5luxsJKS4phzxTzwU7U5OQpsqM4Z0MWTlEBAL7S3a7hLbqjYW+c3Vr3a3ooAGJ6eprH2UHNBJ3U4gHWPVuCbzLHUjUlAatIgHcn04K+3aaq2G1lzoZnZ8T10WFV+GcrQpP83Zy0NFh6JZ/EJoeXlQYI8TAIUMNZvN4Rwd2EpKaLApCVlRV1A2hRCuhkQp3a6McBBASpi3Aj6aj3zSgB5MSK1wZ7xRSobFWLTwI/7mwXU4uLltlxmjcUNT55x/MtJ4wxHjls9h3iU1BS4Cr21qRwZ7C9ZmUn7ibadFEAsrOz2Y8dCE7QOob/JdqvdX8fQE1w1ZQErCQB5rC7PPPiAkzaqm0uAVLrvtLfLTR+0c2/nvRvEBgzU6WUPkn62FQHticBWThFBYAAi17RCqALdutyEJgi2CGtU+ifLsdcT/SyNKv1zmX396ys0edlZIIaFlYmiwyCvuwfoaANfduqPVgC/dOT4vq4yzLR/5zTkuxcDErOpufB0lKfmlECoWV5qatQAu6CP9gAE77o9ADrHSUlJZpmQtOEzAkJg1xFNX0kkG5hIqCs1DSNHc4qcMpUtsuuIUFwU+3BEvh+xx0x7/cDTqUuJQ/uhPpUSSABCYSX5OEUMZYPNuIuWkI3ih4KgNYJ+v2RAkgfRUIdepDcV2mApR3+Qae21WfMUS/LybHsmKwC/FEB414V86Cx/hGIbVTbWAJhlFK+2N/DzcTGX1KfKAmYXAJLEunqia/EWeKtz+dL+EbRSwEQdAFENBNp0xOSqFlJ67RJD6wWWaMnZod4Cbz2BDnV1pdAj9stWibGVPT/+uJR71pCAjtwj8uzAHDdJs4Sb/UQh24KgB6d2ewYZqZ+3azv6nP9JGBF5YXZALfHx8SdUZd+grDZkV4AaZKXlf8sNi4GeqqmJBCVAANZrdLUlWuVmVL9vCuBAqS/ZCAv2Tq32aqjbgFEVi+obIC78xj7Ynl5RVwZHkrQoxl7RGNeYzcminIYBKiakoD1JKC7AsAbQjUlAZkSoAXAartEyoPhMa8O9Cpq4HUujsGZSXFjfMQy0f+xQ7DitRjb/3heM25ItfgkIFNWeuOrLgoAghEomRX4JVbABSBNA7Dmsh/fRaO+Fb8EpF1g8XdhW98kNXDH5IS4OYKdrmr3SeBCT5cWKKnu8fvEYpo/ynPzUYReF7gwzZjkdGRFavlq4itxFn3XZRnUY0ZXFhcXoZhouQm6dGqjiUlL0aO7Gx1dvW8VCWQwCpZ+V4tZmwhui6AG/mGXIgWKvda4q3kdlf8sNp3aELjgUbGze5O5q7W77PQcH6yfzAHU8Ja4i2MnhLm6XLnFxcUrSEvgA/EP8nIgViuDJTRePedCHStJEshKS19VAJJ0/kROS7B4fbBP+BDsptqqBFxzMzD/uywZ/U/lJT/buim16hrUXwLcoMhqtAAQZ3n8zMzMhMFQFwUAmgj7s4IcRU0z4U0hozlB05YhNzseU84VJl9StFz0z06LmyAGUm1VApew+5/1WYf7f+28Ke/4Womov2VIgLhKgMWx7yoBiZ5HFwWAMQDsGBs0lGX4KBLt17q/J6OaakoCNEfmaVkA1lMDCBa+cAjlbjvVREYkwMDIsDzDoZKzkoChEkjbKccCQFwlvkZdAJHYu4TGposCABcATRLUSpbYQeoBCfVqgx/LNK1scEr1tgklgIrYogqpV/LCTeUOOhU0zMoNsCrjmcUFcXXEmqV/yUuSDXNveZZWDE3uRaOObhkJZKRK26hyc63hLISxEsHdhOSihwKgmSTS09OXqJmwg2wJ9WqDH2ekpFoyUGiD4ai3E5CApEssgR7F/9OoG+CWcgOIW65hMbHoRelfPZai+OdAr29yLjOVZVIvcVr+OES+dOCUjMa9NfCVG+wV4i3OoWFvIufS5a5jh9CWaQEIh0GEiI4m0qmNfpsJs69qSgJkiynIzKbbyZLC0NwAoZDGCWDJAejY6Qt9XcKPzAgr+9GteRXqOInqUPdJIEsSThFX8VgizhJvCbr3nXgbf+iiAOC87AvN/uwYNYCEO7beWLIR/a2akgAlkJeZmVj+S5LFmIKU1ov9ziYF8kMJugFOBNIkW7Gt7vZSRCGUUdWUBKISyJakANC6TgUAwfZLKAi0nJubm7CrPWEF4Pnnn9fAnwoAXACMAQjhkXDHosKMfc5KVxaAWHmo19aVAOMAuqenROfEuHUHkWDP+6bcom92xrIpnRw+XRdWVWASnD718w0kkJUuZ6NKjCW+Ri0Ao6Ojgvi7QTfiejthBeALX/hCNANAcwFASwmxo3GdfYtfyk3PsPSub4vDlfr1hK4aqT2L7+AlCALEdRbfl034LZq8WRvgxd4uE/bOmC690t9tyeI/sdLBpsfSCkzsWNTrxCXAFYk4JaMRV4mvUADCwWCQysAK8TeRlrACgJOvzM7Oom8ryzBN0PwfYqBCIp3a6Le56Zk83UYfq/fjlAAXrcxUa1tTOAarNw7hytAAU3usPpQt9x8OTET/W5sLgSsRM5PyUJxKNSWBVQmsiLwMOQoAcZX4ivNoMQA5OTnRQMBtC18PBUDU19cvg5VoCZGJVACC6KgUlM7PkGNa2bb0LPpDQme+xVOX8rHoEkSs3Gg6vuUeEwNwBTitMf2vBeWR7WA+t74q6rSrT+5487SNqv7nIK4SX4mzxNvCwsKEdw4JKwDYiXEVXkZQAqMTw3iNRICwFCag3IxMy6YL6X85OPuIaZJSbYyUKmsDeAMBcAL0GnlaU5zrFnb/02D/s7QlBwpoKpS4bEk+X1NMlOrEliTAmJBcSRYA4ios7ZoLgHiLjml1AbbUwTVfTlgB4PHcbveK3++HgrIUhhsgFAgEaKbQvTG9IhMmNwhB92OrA1pLAiXIAsjitWBxlxDuaPHaQJ/jrulXUA/Bv2Tt9D/eMXTj2EEZtdbdb87eEpeIT7LSAImrUJi5yQ4Rb4m7iUpCFwWgrKxsOS0tjS4AZgAsoXPspO4tB2mA5AJIeNS690wd0GgJZCOGwQ61IUhvfX10REzDJO6UFkLe/20QADETwsqNC36RZpVUTgArz6NefScuEZ9y0uTEABBXqQDQ0k68Je4m2nddFIDh4eEVmiTwCOGBAMWgFAtANqIrs7DwKwtAotNu/d/n4FoggFjdGMS6BlMwhV8bHrD+pMQ5gpG5WdE9My1SLR7IydW3FLE0KRYfR5zTpr62iQSIS+QAyJHkEqIFAOBPjOVjibi7SZc2/VgXBaC2tpasRKQCpnYSREcDm555G1/IhWAp4IRHvY1zq5+YSwK0BtnBAkCpBmAKfxmkQE5pl4f6xUIwYG3/f2Sy0ln4RSkATrl0HzhO4lJWaro0BSAUCvmJr8RZ4i1x94EdiuNDXRQAnEfjJqZmghgAiQoALABQApQFII6ZtflXshFowwhyOyiDabBk3IYbgMx4TmjXYP6Hr9DyQ2UWSklOjuXHoQagjwSIS/T/ywoChAsgSHwlzsbUAkio87opAPT9MwgQnQtAU5FiAeCCzxQLqwd+JTRj6seaBDJwo7EQix0aqxv2zU4LMuPZvWFxEG0To7ZI/+NcqRLldr9i4x8fcSkfwclU6GU0Wtbh+2e0PdPtE+YAYB/1WkFXmJcI04QWA4B+SuMCKIPGbXW/r4yLw2nHZOoVU7DscDFo6YDBoCAznt3bIHz//bOztvCbc8dXmK3qANj9mo13fMSl0uzceL++pe8B9FewsQ4yBoA4azoFgB3Kzs4OZWRkBBgEiCaFC6AiN39LglNftqcEqGWn2cQCEJ2hq8NDtndvvYH0P7v4/7njoyVKNSWBqAQqcvOiL3V9Jp5yY018Jc5yw40TJOwB1c0C4PF4SAXMKEVaKnyyyIAq8vKUC0DXS8uaB2MAYH1+PjkxrTmANb2me6tlYkxML9g7HfDWmMs2c8aap7kw+aqmJEAJcCUiPsloxFMoAT7iK3GWeBs5ZUKn000BgFbCGAAqADT/L6DDUrgAKvOUBSChGbfLjxF5XZCRZZsdM8x6GjPeTZe1+fEfdHnR/9/pHl913Tzoixb4jIt9BpTQfEk53xYQgeriWySwImThE/EUbTGCryHiLU6f8O5HFwUAi9dKQUGBRgWM6MQA3AE+uAGkKAD0sWRoXABvkb56w2ESKEAd9oTvAJPIjFQyZMa7CBO5XdsQAh0H5u3h/+fay0JAhSACUk1JgIZI4pKsGADiKXGV+AolIEy8Je4mKnldFIBIJ6gAhBClSBNFACYKKZkAhSgCk48UsBVh/TSiRCfP6b8vwLVgp5RQujVax0YEymnacmqvI8ZhEcGOtHZYvXHBJxGVLNIXq8vHaf0nHhGXiE8yGvEU942GrcRZnEOXRUI3BQCsRMsRBcBPBWBxcdEvQxAl2TkQNCvByTi6OqaVJJAH/6udUkJTUUikA5UBR+ZmrDQNcff15rjLFvn/0QEzCJWMlKopCRCP8mENKpJUZXVhYYEkQAEE//GZCoAuO2DdFACyEsE8EaaJAlwAwP/FRRmXRUFmFoRsr52fDDltdkw77MI0BcBGiiDnZAEkmpcH+zebPst9HkRIUIdN/P8UPi+79FTwkmA9Uk1JgJZIuiQLJSkAxFPiKvGVOAuJm0sBYIcYBIhnkhX4ZCkA5E6vyi+wfC34ZN4yvFg9fl8yu6BfAlWQAABAAElEQVTLucuyckS2DSoCxgqDDHnXR4dj37LF6wnPvOiZmbI8///dycA9lAIXAIu/qKYksAyVsDI/Txq/BVgAF4irkHQggrPmUwCQo0gmQOYq+pAK6Efggi6dXHt5NRQWr31L/b0FCXD3shiQ4qHZQi8S/yp9bmRioz/WLo3pgLdAC4woWrsMSRtHC2IbfKQ6toH/PzoxJKJigTLVlARoEmooLJEiCOIoNtQB4moEX01pAVhBlCLLFd61AMjKBKgrLLKV71fKVbPJQe3gAsiH+dVutdhJCzw0Pyf6pyc3mUFrfXwF/P9+uAGsH/63KnfWAahEPJJd6KitdTWZr7eMRWoALsloxFFYABZpASC+EmdxHl22PbrFALBD6NgS/BOaBQCdnicdoAyB1BYUanzLdgoAkyEnux+TCkAG/LC63AkmERZpgRkpf3V40CQ9SrwbWBdE/9SkrcCS11wBglD1XEATl7Q6QjIkQBwiM2mtJAWAOEo8pQWA+EqcxTh1WfZ0u36hmZALIAz/RBCdpK8CgYsLUlIBy3PytGALauGqOVcCNL/SD2srHwCmk3f3DbgB7NLm/H7RPum2TflmzgvXnjLyvtvIpWGX683ocfBaYPAfcUlGi+DoAnGV+EqcJd7qcS7dFIBIZ5aQAsiCBT6aK+bn56VkApTn5mrpFkoB0OMSsO4xWBo6F0qALneCicTA9DIGAnqREWCH1o8qh3OIObGD2yk6HwykpQVKNSUB4hDT/yqASzLa3NycZv4nrhJfcQ5dOADYV90VgJycnCDBHx1dAHmBR4ZA8pBvqdUEgOBVc64EUrH7qgX3tt0UQdw7YgI1AXonx20xuVdAb7wYAgGQLUazOgiuPAWSUr5sJCZHDIXKIPGIuCSjeb1eD/GUuEp8xTlMqwAso7NkA/SBsAAvvV76/2S03cWldrP8yhCTvY8JBaAEqYC8Ae3UCJQEzEtDA7YYVhfM/3ZT0njN5UlifbPFpDtoEFx+iEcyGvET++gF4ilxlfiK8+gGqnpbAJbhnwgiVcGPgAXGACCeKSglEHBfWQVMv/Za+GVcQHY/ZklOru3AhXPGa7t1fNTy8Q0kAOqZnLBFAaDovcS5yQL/RKlSAKIicfQzr4fm0gopMiB+IgVwgXhKXCW+4kTmVQCgsYShqQSysrIWkLqA+B+/FAWgqbhEZJIERukAUi48qxy0KAcWAPyzW9P4AKAAeC3OB+D2ekT/3Kx9CIBwoXHNyUhJFUVgflPN2RKIXgvEIxmN+AlOnTniKXGV+IrzmFMBYGQiaxaig35oKwuIWPSQwECGYKrBBliq7f50k4WMbqpjSpaAXQOxdqIugNsGcQDt4P+3GwEQL2kqaPlIA1TN2RJYXlkGDuWAnVZOmXriJyDVQzyFpEGlEdYtA4Azp7cLQBQXF4cRsEC+YvIWL8zOzrLjureK3Hwt7WJJmQB0l62VDlidmydy09JtZwWwSxxAq3sCq1bIdgGArANgV+VzvfvfbjEc641xO+9RLmVYgyqBRzIa8ZM4SjwlrhJf9TyP7gpAd3c3ywKTDGgRzx6kMMzr2eHosVg6tUkLBLSf+Tc6RvW8uQRYfjMd5lg76oF34wCs6uLApPQgBdB2ufIYFxkocx1kARj3zosl7HZVu18CVAB2F5dpFqH7P9HnL6TSzxNHI3gaJL7qc+TVo+iuAADwl/Py8li3eDE7O9uDqEUPXAFSUPpgRZXtdn56Tq4TjsX82wytIJD9Rksz823GAQSsWReAjIbtE+PSFsdkzTgXs0KkfGWmOqcQkN0ybfS6dqikHwYOyWjETabSE0eJp8RV4que59JdATh16tQSlJYQNBYfO86GQAYpK9j+8orV3Z+eElHHspQE8qEApMEca0cTAOMArMwHwF3jhG9Bfz9jkq9Q7vqqEH+UolgAkzwTyT09FUEGgxKHZDQE/wWJn8RR4ilxlfiq57l0VwDQuRXkLDLy38/cRQxiGoEMUhSAhoJisC+BCEYS14CegjbbsexCykI64FIoAXbcoVg9DqBzYkz4bVYBkPcxFYCKPPh8lQJgtmXN0P4Qd8qBP/WSqtMijT5I/CSOYmDEU+KqrtZ03RUAZgKgdgEjFckF4MWzd2ZmhgPQvZF9qTq/EL4pXWWiez/NeEAUlTZjt7bcp51YhOvyCkCNZc9rwMpxALfGoQDYqAJg9OKkslmUrVIAo/Jw6jNxpwbZaNyEymjETeInUgA9xNMIruq60OmuAFAQZWVlYWgrfkQueqEEzE1PT8/JEBAX/4MVlbbc/cmQV/SY3MG4F6ToZNFTGPpMLdyuOqDGBzBmvTgAgmQfKgDCd2notWDEyXj/FKAUsGrOlgCvccahEYdkNCgAs8RPpgAST4mrep9H1t3JTIAAiAsWoL3MwXcxJysQ8FhVjQoE1PuqsNjxiuGPtaMLgNOg8QEsoi6A21p1AWC8FO1T47aqAMj54PYrHUpNhaTKbzyHataQAK1zR4E/Mhrxkhl0xE/iKPEU59HdbCtNASgpKQmg04soXjCPOIY5n88nJQ6gubRcVQaUcQVa6JgltABYqL9b6erdOIDhga38LOnfHZmbEVOLi7YLAKSpKSstDaWAlQsg6RdZEjtAKxAzkIg/MhrxkrhJ/CSOEk9xHssoACswX4RgutBSAeG7mEI6oF+GoBqLSuCHYRyArtkRMrqqjilJAnVQAHKwKFMjt2PjqG6Ojlgq06EbBECBJVgsJZlHkzXPmgUAkd+s/66acyVAvKkpKBTEHxmNeEncZAYAcZR4ivPovsBJsQAgYGGlqKgoDP8FAwHnMYB5t9sthRCI9QAYB7C8rLtsZMyrOqYECbAgUBZysqGU27KlR/gA5vw+y4zvBvgLAuElWzEAUvi8xDLSlAJgmQtRUkeJN4fKKrV6NDJOQbwkbhI/iaPEU+Kq3ueSogBEOhmGBsNAQOYwgtFwdlrvzkePd7q23ra7v+gY1fPGEuBuLB2Lsu53x8anNPQT3Pgwpy8IptVZoTE9anBqypYBgNQyM6BsOq0U8AiKOqlN1r27j9bGU3UN997Q+RXxkrhJ/CSO4vC6BwCyyzIVgKX8/HwtEBB+DCoAsyAEkjKIA9DEWJiDfhnVnCcBRmTTAmBXE8BqHEBIXBzss8TkLpABcGpCpNk0A6AWLicSwDipLYSDtlWwtzqPxBnWgTiAkvQyGnGSeEncZAAgcRTn0d3/z75LVQBQuCiA9IUF+DHmQAY0CWIDKXEAu1EToK6gSCwpQqC4r0dZqStxd0DHL2bARN5ocz4IptPdco1AyTV/rMvA9KSY8fns5v7Xrlgu/tUgAaJVxklth+2cOdufPeJMHfz/xB0ZjThJvCRuEj+JoziPtRQA+iuCwSADF5gJQB6AWWlxAAgAO15VqywAcV6NXMTGYVK2TcNiXIsb0s4WIO6mW5EKOOk1P39Dy5grUgHQfiDJa0xjAbTNzaMGslUJ8Bog3mQCd2S0CE7SAkDcREmNYEiG/599l2kBENBkWBrYDzPGPDSZmcnJSWlxAGfrd8mYC1sekxfwtIUCyuKZhEowctmVC4Dj546TQYCXB/vjEUdSv3MHikpoWcqGJanj4sl57xQh6FQ1Z0tAJt4QJ4mXxE3iJ3FUlrSlKgCNjY3h3NxcHwbjwfMM3Boz4DaWsjIcAiNTGW5M5QaI71KxkwuAI17lArB3DEgAoPqayeMAwuhj9+SEQO5yfBeihb7FwC9mZFSxDoBqjpQA8YU4w8wzGY34SJyE359KAHHTRxyVcS4eU6oCgOMvw4xBQqAFPM9Ak3HjISWXiT6ZXfDJqLoAsi4Vcx+3ARaAPBQGsrMVIH1nirjmGoJNkC5Bcza6KDoQA5CGSoZ2a9j8i2yYfcuzlQXAbnMb73iIL03FJaIeMWcyGvGROAngnyVughGQBHrSAn+k3qUwWy7DnxFCMAMDAWcxkBn8LaUuQAp8pGfrG23tB5ZxwdnlmKXQylmf3c42AAYCDs7NituuYdNOWxv8/z6G/sBlYcfGa6wkx3l1AOCIlsZ5b6XrhC6gh+FuJt7IaOPj4/PATS0FEDwAC7AIBGX5/9l/OaOIkQwLGCCP0Ud/BniNpyYmJiZjPtb1JRUAEgPZeReoq8BsdLAScLOnc+5trAIQUn0or/tKf69pZ+5N16DwhUO2jBnnusJrrNCBhYDCNqkemsiNw/nPwPwTZ2Q1+P/dMP3T/D8H4PdFCgBJ29dIVwAgqDBMGaxlPF9QUDCFyoDT4DlmdoDujXwAdYUqHTAewdotViIPPBDlWJjtrvylYufx2kCPCKLMrtkaUxS7QAFMS4UdG6+tMpBO5cDVpJrzJMA1sx74sh84I6MRF4mPxElsmD15eXl0l0u90Y24U5ehxZAS2IsBIT3YN4HqgIsyBEgyoJPVdSoOYBPhMgAQtqVNvmWtj2mS2wte7iWbU0IzuK4TLHu3TOgGoP//9viYVi3PWldPfL2l/7cRvl9Z5t/4eqG+lSwJcP6JLwXAGRkN1f8WiY/ESeIl4+dwHmn+f45BugLAOAD4NUKgNFyAVsPAhumxsTFp6YDnm/bY1f0o45qz1TGroZ3TR2fnRjfAIljZvt9xx3TDbAFRkScAri+b+v9pAajMVxkAprvwDOoQL2vii6wGXJwhPhIniZfETeKnrPPxuNIVAJ6koqIiDG1mkbWNEd04iUDASdY75md6NxI0VJMVTrEC6i1a0x+vHOlZdo4BiE5AGqwAF/q6xCzK7ZqpvTLYK3yoAGjP8D9QsUEBKEO2idMaF2q7K9abzSnxpBpzT3yR0YiH9P8TH4mTxEvipoxzxR7TEAUAJwSbYdjP6kYwb0yhtOGErPLAlQCB49U1IqwUgNh5dsTreqSC5miZAFJ0S9PIMAUpdkPIBrjQ3W6aPgUR+HdjZAj8//bL/6eQqViScro6z3kKQBCBpx7fokZGZZoLzuCOEE+OVtYI4ouMRjyE+5/m/yniJPES57GNArCMgbEugAeazTS0HRgB5KQDcnKe3rNfxhzZ6ph2DJYjQUu2xgVgq6ladzC0C/7TnZvCLNHZraMu0T0zZVv/OD1LuenpolYSAKw7ySZ6094qdXyCfvvuffF9cRvfIh6iiqab+EicJF7iMFLN/+ymIRaASB4j/RmLMHHMgBPAPTo6OrENOcX1k9M1dZqmptwA64sL8yA8fr8ImTCSfP0ex/eulgqIXZpT3ADXXC5xZWggPuFI/tYLPZ2CVQDtav4nALIMcLEDOQAkXzqmPzxxpCIvTzxUVy+tr8BDN3GR+EicxImk8f/HDsIQBSBywjB8GouscQztBu6OyUlUPJKSDsgYgGNVyg0QO9FrXzNly25WAGaB1GCH5gR/JYHWtxQSX736Buq0S98orL187vvbHwqKl/q6RSqUL9s2mABY/KXIoSyAdlXs4rlew1grj8H8XyuJ/Y84CDh0ExeJj8RJ9Eu6+Z9jN1IBWAbHseYGwECnwHA0hlgATzwTsJ3vPLMXbgAnX7XbEZrFf8P8872gg162eSpgdJrSUZP+pf4e8WpvV/StpDy/DmKibtD/2jk9jgGApJsmEYzTGv3ffgZ32jS7Y9P5hPnn2eYDm35tu1+A799LPCQu0vxPnMSxDNHqDVMAcPEsFxYWktaQdQE4ULfL5ZLmBmC1JgbsKDfA+pcl63vbUT+qIhEUHbYOaJy/EHYnf3bpFdDvkjLc+EYr0jduXxdBMMXZ8XqKSpRWpYbCYkeCYAABnkAoQ3eLUbkn+zka/S+z+h/M/+PEQ+Ii8TGCk/ZSACITGeYAmecIbWeCcQDQfKSYOhgQdqa2QWUDrHMHkQjIi4IyARvSe5IJMgXjc4YKILSo+ysgBfp/L7++zkzLf+sqYhBeAjMhKXLt3KgAVCHLRDVnSYDWD+KIrAqQxD/iIPGQuOj3+w0z/3MmDbMARC6bMAbpx2Me5Q7d8H2M0vwh65J6Z/NBrYCFU8AgXjlyp+YDmUzQhjXbydRm96qAa+c5Bal3f3X1dXFloG/tR1L/5u7/ry+/hmvJvrn/FCDXDyqVzAN3arOzdWejOeW8c7NEHJHViH9oo8z/RxDgHHBROv1v7FgMVQDoBoCGE8RAPSwMBLajiZGREWlugNN1daKhqFi5AWJnPPLari4AkgFlIVjLSY3g5IFF57d++G3RP+U2bOjfa2vRYhAyEItg50ZFR0sBdKgCQJeaZi3EdeakRvM/8eO0xOh/4h/M/xPg/5/ExtiL5wBx0ig5G6oAcFCIcAzB17EABWAG2g7YDsfHg8HgkowBF2fliHONu+EGkHJ4GV027Ji4yGzpsy3NzRWFyAZwQiZA7MVCAp5e5OF/+ltfhxIgreDm3VMOTU+JP3z1Xx0Tb5GFFMCKXDkkMHeFatIXXsSXuEEEREXTSY248TbgRzEKQMloxD3iH3EQ4D9DXMR5pLjEN+q/4QoABwgLgI/mDvg93CgMJNUN8K59BwVvXrulvG00ofG8T7PWjN+nmW7j+b6VvpOG3ej+knLbFwVab07oh2+HBeDffvMfUCxoaL2v6PLepNcjPv/9b4lBsBGyOqHdG9eObJAAleTm2X2oanwRCXDOM4Eb7wR+yGo0/xP/iINQAmaJiziXvRUAmje46QfdIVkBJ2H+GBscHByXJWRyNx8or1TBgDEC5u7fA63ejkGAHGZDcQksAIZZ0WIkm/yXpKvtQkreJ/7xv4m/vfK67mRPI7Mz4lf/+evi0vCgSLdz3n/MVNIE3oTYkkybBzrGDPm+l1wvnNYY/EfckMX9T3kS94h/xEH86SUuEh+NlHVS1He6ATDQBQx+muYPVEEaRWyAFM2Hebu0Aiw5FBA2uph4U9v1tq7T0rU2Grn932exoDnEBPz7Cz8UH/vafxE/aG8Ri/g7kUbK4e/duS1+8et/Ky6NDDoqH57KZFNJqW2rHG52XXhhLXSaBZV48e59h6Rd58Q74h7xjzhIPCQubjYXen+erOgdZgP4EPAwi5zHCTQXmJDma2tri/UeII/3FGoD/OWbr4k5+LFIFqPaqgTs6idvQuBOHky2gaVlLYrXifNNN89OKAKXUKDn6uiw2FdSBn/mHnGuoUnsragUBaiZACvcA0VDhkH3gke83tcr/rH1hriMY3F7QgIiJzXeJ5UOTgEkxwQj4u26YVh7LfO6L8vJA27I4/4n3i0sLLhqamomEA83SzxEP6RsgteOL/bvpNzJNHNAowzMzc0xG4AcyGNDQ0NjshSARgDCucYm8c3WWyJDKQDa/PMin11ABibM5XZrlYjWzk7LgALAe8rZLWqmvzPpFi0T4+I/X3tDFGVmCypJzJjIy8wSxQiaLEPAbLSGgsvrFZOLXuH2zIs294SY9C3ApULgTzE8bzjZs0eZZGLcTC91aqO10EkthLXxMeAFcUNWg/l/lLhH/AP4e4yO/o+OKykKAE9+586dcENDA7MBpmEFGIP/wwVegD0QSnq0c3o+f+DQMfHdjlbNlOW0C3ojOVKrt2MrzckVBQC1KVp8HLZ4bTSfaVR8I8ov5TIO5Y+mbe5uGd3NEsPRRv8nrw1S+zLILxUZBk5tEI/IgbXEyRwAIbh/eD04QQ2gqyM9NUV84NBRaZc8cC4Iq/doUVERFYDpSPS/4eZ/DvDeXS9tuOsf+ODBg2EMnNkAsyBBYCrgCBiRZtb/duLvks3pYHmVCgaMEaVd/XppMG0fQQCPooGOmeyYl1SKuJtnlHN2WrpW5Y6FfKIPFr0hl4K243e4AkXgYxXActCKO7XNLC44JgaAyi9x4iHghaxGnCPeEfeIf8RBnCspuepJUwDoBhgeHg4ChFj72A1NaKy/v98F0zTvOd0bI3jfd+CICgaMSJY7v+kFpp3as9UVMRNAyqVkT4GpUa0rgRUAQhUtSllZ637uhDedsPOPziOD/4gTVI5lNOIbcQ7Ar6X/Ef+Ig8RDGefb7JhJUwDYMfj8Q9CCFsAIOA1zyCgCI4YRF0AuZCntWVQIZKlgtTNcFa+dMyP2IOgtFWZtpQJIuZUcc1CmAO4rLbd1pcPNJjOASoBOuI+ihX+0SrKbCWWbnxPfiHN0exP3iH/EwW0eLuGfJVUBQO+XkA7hhwVglukQKSkpLgRHSKMGph/vaUR2MshDNdSbtPEOuRFFgfIy0h1julTXsxwJUAGokxgMJqfX+h51MhIvou9RzXc04gIj/2skUj4T35B9M0K8I+4R/yCJpJj/OQNJVQBg9lgpLS2l+cMLTciNSMgRmENGQqGQNIF86PBxAEOG44GB4O8Go5tdWw0UAAZvRSPb7TpONS55EuCul8GTDXAnOblhfbb98BkPlYv14icPHZc2VuIa8Q3g7yLeEfci+Jc0A0tSFYCIpMPQiBZJhkCziMfjGQJBwpysWThcUSUeQS4065c7vdnZBZCfkSkakLstKaTE6ZeOI8ZPUCCfRKODOQA40dM++xMBsTLq2YZGcaSyWtq1TVwjvhHniHfEPZzM8Nz/2AGaQQFYhkbkhzBYIngcEZEjfX19w7z5ZDRqs88dOSHIlibrHDL6rfcxOXbSAdu17cDO7UB5hQr6tOsEGzAurkCZyJKoKHBuBgDF7EaJejmh2QZMYhynoJWQ6a7PHTkpLW2Y621vb+8Igv9cxLkI3tH8n1R/dNIVAAAy7zMGQXghlCloRy5wAgwhWEIai8ujDbug6dU4OiWQvs1RkL3YudUXl9l64bLz3JlhbCuICK8CI1whSJJUs68EwmAMPQo8YOVYWY3Bf8j9H0Sw+whxDufh4ktKfDk73TgHknQFgP18/vnnl8rLyxdZEhFxAKPQloYHBgbG4hzDlr/GFI+fOnbSEZGtWxaOjX7QXFwq8rCDc7Klx0bTafhQlrDtbS4tc3QGAIW+GAhI2xkbPqnrnJAI/FPHTkkt9gQ8GyeuEd9gBZgm3hH31umOoW+ZQgH44he/SDNIEIER83ABTBQXF1MBGAJZgjT/yLPNB7T0nhB8P05sJINZwI1t59aA6O0cBnzaeZBqbNIkoDIAVkVr57Rprv9M83y2eb+064g4RjwjrhHfMjIyGH0djOCetPPGc2BTKACRjoaRBqhVCCwpKSEt8ODIyIg0ZsA8RHx+5OhJBIkl1QUTzxxJ+46db2wKrRRc9xXZObZOd5R2cTj8wPQLp8MvTD4JJ7clBEv7QyHB2Ck7NgYJEwfy0jOlDY84hsI/g8Q1Bv8R53AyaZvbrQzETArAMswjfmhIpEYcQ6DEUE9PzwAuQGkbuPceOKSl+JD+0WmNNzRvbDsrAQzsOagogZ12aesyXsYgM1243uEZAFwbuU7YsXFstBISB2Q14hdxDNg2RFwjvhHncD5TgI5pFIBoMCCCAL3wkUxCWxp2u90DLJsoa3JKs3PFh5EREHaoG8AfCgpq+HZuzVAAWPRGNSWBrUiAcSPZLAIEPgknN947NJPb0QDAdZ/rP3FAViN+EceIZ8Q14hvOlfTgv+h4TaMARDq0BGH54SOZgaY0ivoAQ93d3SPRzsp4JjFQfWGx4zICaAEIhMO2H/deBAJmI+hTBQLKuHvse0wSZTWAES4PfBJObtz9z/t9YIyzlwuAu3+u+x/G+i+zEb+IY8Qz4hrxDeczza7LVAoArQAQEJPTPdCW3GBJGnK5XP0yUwIrcvPERxxoBeDEe4MBECKZwhUl7R7cVVIqcrGIS/MjSeu5OnAyJUCSrANlFbb1fccrWypCdiQM4+6f63451n9ZjbhF/CKOEc9wHg/xLWLtlnXaLR3XVAoAe84ywWVlZYsQ2DTiAFw7d+4cQPWk0S2Naotf/vCR46IO2qCd/eFvEQksAGRDtHv8QzkDAVHNzc51D94yt+qNhCVA4psGhwcAUogeuAnHUTU0BfE0dmlc57ne0/wvsxG3iF/EMeIZcY34JvOcWz226WYV2hEdtgFwAsxBcGNInRgCM2AfsgKkRaJU5OZr2qCTUgJToABM+BbFok0DfKI3AhkfD6lAwKg41HMcEqC7KC8tTewtdnYNAIqKYGk36xnXee7+af2V1YhXxC3iF8z/48QznCsQwTdZp93ycU2nAERGoKUEgjFpGhWTRlAwaQCylEYMxHM+d/SE4zICuND5w9L0qi1fjLJ+sK+CgYB2W8ZkSUsdl1cKAwBrsUt0eptdJA2wfe6d1cj/Em29lzm3xCviFoL+huH7nzJT6l/suM2qACx7vd4AhMaUiVFoUYNIpegPBALSzCfloPz8meOnHZURwFznBa0aZewlYb/XB0orUOlLMQLab2bljIiAV56TI+g+cnoLYoNgH/hH8j12/1znud7LasQp4hVxC+R2Y8Qx4hnOZ7p0JFMqAAySqK2tDVVVVXmgQblpRoFJpR9sSpOyJo3H/cjhE6D+rBBhm6fGRWVIC8ACAgHt3pjrm01KYLsPVI1PFwnQ7E2lke4jp7dZG1UC5LreDNY/rvMy2+DgoNvn82nmfwb/EceIZ2YK/ouO35QKQKRzTJUIwAUwg4cLisAAtKo+0CpK06IKs7LEx049DDXNGVBBy57X5nTAvJbK8/PFLuRzOyrIM3ITqaetS4BR73vKyrf+Qxv+wgMLoV1SaLmuf+zUWRR3ypI2U6FQaBmpf/3EK+IW8v/JZstdlmlS/2IHb1oFIKItBaemprygT5xABOXA7Oxs7/DwsFQrwPsPHhHHqmq1CPlYQdnxNW/sGR9ZKe3ddu7YKY5UVNsyncneM2f86Kj6Z6akir0qA0AT/nzAZ4sNETOeuK5zfZfZiE/EKaT+DSKIfYL4hfOZKvUvdvymVQAinVyqqanxIQ5ACwaEVtXf2dnZSy0rdhB6vqap+JMPn9PqQ9tF891IPhTinANiADh+taPb6CpQ78dKgPc8yX92qQwATSyjC17US7G2RZRzSlrwT549p7kCY+dbz9fEpY6Ojl7iFIPXQQA0TfzCOUy5++fYTa0ARK0A8KdoVgBoVYZYAZ7c3SzO79orAjaPBaBJfNDLwlT2bwdB6pKPyG47RTTbf9aMH+GqApABt1GB8Sc34Rnn4CK0Nvxj+411/HzTXvFkU7NUCcfs/gdotSZu4YSm3f1TGKZWACKztYQgCs0KAEvACDSr/vb29r5wOCxNq4pqiywGYmfAgIKFQh8kXrR/a4RJtzArG4uZ1Zcz+89VMkfINLHjFVVSd4rJHN9Wz72IIGGWDrdq4/qdi3X8lyNWXVnjIB4Rl4hPxClarYlbOJ80nNJjLKZXAKJWADAqeaBVjZeXl5MauGdoaGhaDwFsdIwT1fAXHThqa6pc3ta+oDMUgLzMTHEIgV0qEHCjK169TwksATCaECmuGlLmsHNegAWAGwWrNlKdv//AEXES67nMRjwiLhGfiFPEK5zP1Lt/ysP0CkBk0sLQrO5aAaBd9UHb6oHPRap29UsPPyqq8wptS5fLG9sb8IsQigI5oR1kIKDF/ZlOmKdkjZG2oQz4ipkqphpK1kEBsPIGgdac6vxC8UsPPyZ1OolDxCPiUnT3j7R17v5Nv7BaQgGIWAECEOpdKwAiLXuYbylzZusKisS/OfOIbaPHVxUAFARyiAJwoLxCZCC3WzkBZN411j02/f/5sBTtRQEp1YTmHpzzLwrShlux0dr38dNnBddxmQ38NG7iUXT3T5yqqKgw/e6fMrGEAhCZvCWYVXxIrZiCNWAE5EC9d+7c6QEvgFQt66PHTooT1XW2dAVw8udhAQjYvCJg5PoR+8oqscBn2SavOTou9ayPBOgvLs7KEZUFhfoc0OJHWcDGYJKlgC1YCIim/5M1deKjx05JnQWy/rW1tfUQj4hLxCfiFE4qFZf0GpRlFABaAaBVkR7YA25lLRZgYWGhu7e3V2qNAKYF/sojj4t07hyxQNipscLX2OKC8DgkDqAC1K71iO5eBtGLakoCayXAHeMx1I3Iwj2vGknC/FqxMKvt/7lOp4PL4TOPPi5yJM8lOf9B86vt/olLxCfiVMRqbfrLyDIKQESSSxA2YwFoBRhGWmAf8i67YXKRWtHm8V17xPsQSGLHnTJvlnlUBXRCS4USdxzBQHYvgeyEuZQxRo0BEG4i1VYlMIdCQFZsXKffi/X68cY9UruPNL8QfP/dIKnj7n+YuER8wkmlxqbpOShLKQDUqpBaEWSEJUgWxkGz2AcTTHdXV9eInkJZeyz6yj/z6HlRg4ASu4EHzZ7TqPftlHYIKV47xQ4VB+CUCY9znFSEs1LTxL4SFQAYFdkkSYAgFys1rs9cp2m15bots4GUbgQu6G7iEPGIuER8ssrun7KxlAIQmcwl+Fl8ZFlCneURal9QADrn5+f9MiebgSSfOvs2y90Qm8mE5vAp3OhOaQcYBwCmN7u5c5wyf7LGSZjjdbFXZQDcFfH0ohf3ibXcZVRYPol1ug61P2Q2j8fjJ+4Qf4hDAP1p4hLOaZndP+VjOQUgol3FWgH6MY5uBGIMcEAy24cOHxfnGptEwEZR88yKc+NGd0prKCkRZTm5tlPknDJ/ssZJ4KjKzRNlqgTwXREPzs+JsIUsAFyXuT5/GOu07IYAdOINd//93P2j6p8l8v7XysVyCkBkAMvgW/bTCoDHCNIvevv7+zsnJiak8tpmpKaKX3/b20UBIsnpL7RDY+BT39ysHYYS1xgY4HUMbgC7uXLiGrz60oYS4PVwrKpalQCOkdAEAoTFilwzeszpEnrJ9Zjr8r8793bBdVpmc7vdHuINcYe+f+IQ8QjntBwoWFIBiFgBQggC9KalpU0gBaMPEZhdLS0t3cuSK1ccrawRv3jmLEgyLDfX694TpPn0INXHSe0IAgGVC8BJM775WHk9NMM9pNqqBLCOasHBO3daQwHgevxxcLYcq6qROoXEF+BMD0C/i7gD1j83fP80oYas5PuPCsmSCgA7D2Evoe5yAD6YGWhfI0i96IUFoBPkQFLLBfPcHz/1iDhT22ALbgDIUcxA0w+GpCZSUGymaccrq0WBzes8mEbYFugIzf/5uB4OqQyAu7Plx3ow5/NJD6S7e8IEXjDn/0xtvfjFU2cTOEp8PyW+jI+Pd9D3T9yB339menqaaX+W8v1HR2tZBYAD2LNnTwgBGAvp6emTmIz+oqKi7tu3b3ciM0AqmuWkp4vPPv6UyLVBdTlaAKaR7uNzkALAwkAFqjBQdA1w/DMLRJEgqqFYMQBGLwYvigBNoFKo2VkAqbxxHf7s408LrssyG0l/iC9I9+sm3hB3iD/EIZnnlXlsSysA0Lpohw/AFzOLSXFBK+tBbmYHcjOlpgVyQk5D4/wFaJzUPq3cSAY0jiDA+aDUJApTiYiFgVjxzQpxAFzgGKfBvvLB13zPzI3mdPaVQVlULBdRcZIPvuZ7/MxMLhh6DfcD/Auzc8wsVkP7NgO34DSIgMxeCZDr78dOn9XWY9kCAq4ME19gbSbvvwtugDmck7t/y/qD5UZLyJ6R1eMTgX3wxUzm5uYOYnK6QQ5UU19fXw6LQLbMLnzioUfFpaE+cXl4EBzz1hQlPXx+FP1wQ9uvKyyWKS5THfsY6J3/ueOOECmm6pbWGYJjaHkJQC9EIZSVPKSnRQObgqiC7YGyNquZZ4VI3ZlimkWa/Q7gWspOSxO7i0rEEbhamgCsRVmgX8bIpuFq6pmeFLfHXGJwZlr4oQyQYZNuqGS2EBSSg+hrcnuRTAm89dwTyABgHAD822/90CTvkPDnobpG8Ykzj0rv0czMzCLy/tuJL8QZ4g0CAMmUZOkdoDVRK2a6sXhg3VkJIiDDAxamMZhmejFZVTDV1Jw7d+6wzAuYpqfffOId4uPf+K/Ci0U5ZYd5b5YYkb3lJW/08fn5t7xv5zcYB5AHk2GQi1ySASgqZ4IkKzNmo1/ndu0Wz+zZLw6UV6KiWYGmBBCgvCjP6vLMiXb3uHihu0O8Ptgn5v1+kY7I52QCGCvHkTb7/QePiA8dPqEFY0WVluj4os+0BFxzDYuv37omftzdrlkF0qAIJKNR5qwASIIo1e5JYIwKABQ6s65ojPovhNvmN594Fqb/jHsdl/CK6yPwpBcB513EF4D/GEl/cCpLkf6sJxrLKwCRQTEAw4dczGkoA0OVlZVdSNOoHB4eroQlQKpjj1GnJAj6P1/8gUhJMevtst7U33uPZuVe7Myc1PaUVWBnmi3GFngfJxM6V6XOxZaPp/fuF7/88DkUoKpddzqKsrMFHwQs8lJwN/1Xl18TP+howzBWkqKE0qzPglmff+JpLTh23Y7HvJkFC8FjDbu0xyv9PeIPXvqxuDM+etfKEfNV6S9ptciHhWI/rgfV7kmgA+tBGCCbakYTGboZRtT/p84+Lj3qnxIBjky6XK62xsZGKgBDxBk8W470597s3ntlTcS613/tFa0AeMFADC8mZ4LUjDD/d968ebPd7/dLD9D4+RNnxJO7m7GTkX6qNSPX5094ZB3FBUCpcdE/XlGt+aP1keL2j0IFLBM7+N9++zvFn37guQ3Bf70z0Mz+R+/7sPj3z74XFo0Mw8dD8P+Jg0fFX3/oZ+IC/7VjeFvjbvGfP/yzUHz2aZaAtZ/L/ptKV3VuvqhSFQDvE/WoZhFMvmJ8X6cif3CdfTvWW667shvxgzhCPCGuEF9wTsum/a2Vly0UAA6KgRhIAwzAPDNLakZwMnehSFAHGJsG1w5a779p6vwtmKKqLVorYCdcF5OeeWjVlsxk2fZ0HkO5UMm0EZv2jeDP6OU/ePcHxS+cfGhbO3gu088dPSH+IxQBmkUZZGdEI/jT5P+ld75PFEKh2m4jM+N/ePdPakp0EMc0slFWxxUB0H0iZ0rwNOjBzeIai+0c54vrLE3/G7mYYr+f6OvW1tZBBP61E0+IK8QX4gzxJtFjm+H3tlEAKMyDBw+GEQewyAANxAQMwBWAuI3ONkyYdAf3LgQ7/QYuSqbN0KxopcYbnX5lxjE4qR0DqVMuwJe7wGQ0npVZGP/bU+8Sz8L0n2jjbvr/eMf7tFKosq9B+vxJivU7T70b1ou0RLsucpGH/7+/471iT2kZFBgjFdEVcRCWINXuSWAOGQBD8zMIMDUXPPCa5lr1ufNPi6YSqZ5dTRjEDfD9twH8O4knxBXiC3HmnrSs/cpcM5ygLKmVYbICmKx5pGiMMWITWls7TDidoVBIusb2nv2HxM8cP225ssG80UdgAXA7qCogL7V9IH4pReqXbLDc6LJmCtOHj5wQHzx0bKOvbPn9Z6BIfOzUw0hPlQeilBd3X59/4hktwn/LndzgB5Uwxf/Pb3sKSnSKIdUaqfjlw21ySPn/75uRUawFU8gyMZsFgFH/P33slFbq974OS/iDeHHjxo1OmPxJ+kPmvzHiCvHFLrt/is1WCgAHhMnhyofMtqUZgP8QOAK6wNR0h6Ub+bns9mvnnhSnauqT4s9MZGxLWAyHpqcSOYTlfpuLFLszCF5jGpjRjab/WpgyP/3I47qf+t+AEnU3dkiyXAFULp5GhsLZ+kbd+/7Unn3iEQQIhrDYy25UZIqhAO5SFQDvE/Xg1GTSrGL3dSTmD7qGuK6S69+IyATiBbLJ7mAT2UkcIZ6gO/4IvsT0zNovbacARKYjBOBnnQA3qBr7oMF1IhagdWpqSnrhe+ZsfwFm0dKcHI20xSqXB/3/HW7GtzirJasuAMH5vQcOiwpUoNO7MbvhuaMnpVx/BE3m7n/wsH5Wi9jxc9fJYzOlVrZjRvP/w/yfA/eDavckcHNiVOOhuPdOcl9RWS7BevoFuMryobTLbsQJ+P7vEDeIH8QR4gnOa80o7wcIzJYKQMREEyBTE6I3XYwFQLGg9uvXr3eGw2Hp273DlVXis+ef0RYw2YvYA+Z2Sx9RKO1TzlMAjiONk3UBjHQD8Fz0eb9736EtzdFWvvw0dtIFCMzTO76Bx6vMz99SpsJW+s3vPlq3SyvZLHtOOJbDkovHbHXsyf4+ZT44O4NdthH77M1HS5pmrqFcTw8j40V2Iz7A9N8Bf38bcYP4YQfGv43kZksFIDLYJZhwfGBrmoIWN4jJ7JicnGxFUMfoRsLQ8/0PYRfzc0hTsUpqIIPRhsHO5gsG9RSD6Y+1t6xcVKIGvN5A+aCB091SD9bFPahJIKvVFhSJ5pJy3a0A3I0dxq6Zli5ZjRaMPTDLywwG1JQwBIAerlAVAGPncR4BgP3gADBLACAzTbiOcj01oqHA3CjK/d4hXhA3iB+g/bVFzv968rOtAgArwEpjYyOLBUVLBvfCjNOOUo4tiAkghaPURg3619/2dvFoQ5Ml4gF4w/fMTotxr/SECaly3+rBM8Fed7rK2DgAgigpcmWmMXE+m6HckDFNz0blpbGoWOr+kCVoq6GULZELWVKjVJgySUVDtXsSGJ6bFUMIAuSGINmN4M/1k+uoERYJ4gIY/1pQZr4DAX9k/psgfkAOliz1G8/8JX+W4+nlNr8TCdjQuAHA3zzCgEBMatu1a9fajcgKIFXw7z7zXnDsFyGoSV5U9jbFc9/PaPAjRWvH+Nh97zvhj5O1davpmwYNlqBcBp+m7Faem6u7ZYM75wIAp+xGF8mq8VfOmaiE7YcFpkgVALpPwB1gZDQDHwjXS66bXD+5jspuxAPiAvGBpn/iBXP+cV7LlvqNR2a2VgAiAgjThENTDugbB6qrq9vpCmBlp3gElOh3mopLcBG/R+N35+7JzI1BUZdHhszcRSl9O1ZVq9WDl+1zju28Edz36TIKVEFTNMJdwltF5q6PCsBR0C1jkxA7LY5/fcU1pFEAJ1MQXCdZD4PrJtdPIxoKyA0TF4gPxIkY07/8dBQjBrjBOWyvANAVgLEHwQroTU1NHcfE9iK1ow1RnreNIAii3B/ftQf5zW/HwknSXfM2Loat4y6tII15e6l/z+qKi0VNXoEhwMbeE9h8KI8ru7EEr94gyij9SbDEyW6z8EXLAmfeg5nIZDisCgDdN42LwYBWkyGZ5n/ODRXMX0e6H9dNIxpxAFlit5Hj34agv17s/seJFzi35Yv9bCY/2ysAFAAWkuXBwUHNFYAJpiuAuZ1tV65caQsEAoZoeD934iGQWIAkyMT1Argr7Zh0i+E5prw6p3Gn/HBdg2FuGvq4xzwe6ZkHLlR005vMhel53VNuqcoSTdCMRE+BnGQ0WnrykE5GIijV7kmgHzwg3QgETkOJ6WQ1ro8k+/l50GIb0bj+EwcQ6d8G338nNogj8/Pzc8QL4oYRfUjmORyhAFDAp06dCrOCE8o4TpHWEdpeO2gdWxH00W/EBHAhJoXl47v2og66OdNJudx6sAu4PGiISIwQe9znYDW7NAQ+GWGhYYBeNxStBYkZFwyguoN4Dr13czxe28SYVCvAKAJRe6fdIlVSeW0tCLOwBOmMBXFfH0744sW+buFHHFCyGsGfu/7PPf607orrRmMCS2w/cYB4QFwgPqCC7CLxYqPf2Ol9xygA0Oa4toeg/XsR3DFBVwD8PXeQFni7r6/PkFq4DGb5PXC17y+rNC1dMP1vL/R2Sd+dmu0mYo5xAXaFRsQBsPgSay/cGJUXhtI+OS4GZqa04EY9Zb3qAlgQFwd69Tzsfcf6cXeHmAEVrSwXgEYAVF0DkHHM8neffNf7g0rRa1D8V+QYXdY75X3vkeZ3H9bFL73j/RpHxn0fSvqD635PT89t4gDxAKeZID7g2bZR/2tF6ag7gCadtra2AIQwh9KOI3AHsMRjK6I/b8PsY0glnCrsOn7/XR8QFTl5UvOc1050vH9nwA1w3TUshmGCdVKrRjnY+vwiLU5D9ri5xnKH/i9tLdJO9R0cewG7OVkg+vXb16XUG2DcwjdbbyJ2QU7jLoAFu/Yr/v/7BNwNFtAbYyNaIan7PjDgD/I9cD38/Xf9BCr9GWOV4XrPdZ/rfwQHGPU/R3wgThgwbFOcwlEKACX+xBNPLGHCfWAGnIZLYJCBH5jw1suXL7cbwRLIPhwBU+Dvon47q6iZLTOAgDHlWxQ/6GhlVx3TUqH4HENZWO4OjWiMt/hRd7toQdqV3q0XRC7fbL2lUfbqfWwej32/MjQovnXnlu6H/7sbVzTXhawsCVp48kFi1Kzy/++bu+/hfp8PBKQpXvedLOYPrn9cB7keHjGA6Y+nZsof/f4pKSktXP+JA8QD4gLxIaZ7tn/pOAUAAKe5AmDyWcCka7UCamtrW5ECcguZAYblwJGq9XOgt4TNWWpA1XauYJp5uTtlVLCT2m4Q52A6DGmUMVnX/sPLLyAmRD93I3dT//crF8T04oJUPyq6L7786gVd60dcRwraX1y6qHvcQuyEcnqzM9JFDXLMVVuVwCyule+0t0qV+3qy1tJJccNxHeR6aFRDxP/Q+Pi4Zvon1z9xgHiA8zvG9B+VteMUAA6cSsDXv/71IKI+58ERMIrnLpA/tML8c2N4eNiwknikuPzU2bdp0ecG4U503h/4zN3XnckJ8aOOtgd+z24fVqMwD9PDjJoLZh+82t8j/ujiBd1ESQD9QecdkY5yvTIbgwHHvR7x2e/9kxjSwV3UhaDIz33vW2IW1icqR/LailZoKMsAchl5Y9D3yN8D+PfNTRtK/8t7jGQ/n3rkbRrVr74j2vhoXN+5zsPv38p1P7L+zxMPIpvDjX9sw08cqQBwHp977jmaegJM+UAKyAgUgHaYgFrgCrhlVDwA+/GZR8+Lnz7O9ED9doE8bsINi/BfvnlRzCEYyyltbGEBdaTDhppBmRHw/1x+XdtNJ0qww/n649deMmwhZ1XAO2Oj4t9+8x/ELfiPt9suDw3gGH8v+uC6kGX6j/aNvAgMePNLzMCInssKzxOg/f3rK68bHhAZTff7zCPnDRMT13Wu71znud4j4p8Xrcb2F8EDw/pilhM5VgGITEA4GAwuQvObAvnDIKNB4SNsefPNN+/gfUOcwQSA337yHeLd+w+aKj2QKXHtSMX6Yx13p2a56DfqxxVEQRsdGY5rTwtK+7M3Xhaf/e43xSgW5K02N4h5/tcffFv8IdwJ3DvzmEY1Whra3ePiE//438RfQ5HxBOKPpSXZz5+8/rL45D/9vehHHQopzIVrBEHZ0D1ybXhgzSfO+5PxEF9+9V/FACw4XIeMakyDZiXM33r7Oww7L/L9l7muc33nOs/1HkF/ZIflDsdkuy+jZgIBscadynxnev7558VXvvKVZVwMywT89PT0ZQSG7ICZKH1paSkfASLFRvSaux4WvWhFQFgfyDiMvBkfND5GS99Gn4oRNHUEtKl2bj+EGfRPL70qsCsw1AJAmRKUqHhw/l/o6dA48GuQlZADOtQHtSkA2T8hYv4LP/6ueBk53LyOjAT/aN/oDuCizj68hBRSBpOxbjsBfW3Bo3koCAxS/PubV8WX/vUHgsFnDAQz6pqnahSCBYBFb57a3SyyNpFxdIx2fP4bWIz+Crv/VMyTUSojLZ3nGneLP3zPB6VWlFw7X8j378W6fnnXrl3XWBQOfn8XnqltM+rfKK/f2m4l/W+j5j3pA31QB772ta+lfOQjH8manZ0tmZqa2j0yMnICj0cefvjhc01NTYbRhU1gJ/dpmFOvgY9/7cL5oP7L/Ixm6VQA1CfOPCI+fuZRMKjJLwQjczxrjz3l9YpvtlwXf3H5NTEHszCVnmQ2mqdZLKgmv1A8BHbC41C8dqNoTTFK5NJ8PQMfOQH0BlI138Qulox59JkbBaCbyYZBiAR0cl6waiBLLUeLuXigGJCdcBC7fZIgUXFIVr+D8D+fRNT5r557EnJuFGmSYyY2k5uRn88uesWfv/6K+FtkXBD5jFIaCf4na+rEn/7Ec6Ic8TZGtd7e3vFLly69WlNT8zoe15H614Od/xT8/j6nmv6jsk/uahfthQmeYRpKm5mZyYYFoBzg34zHafx99vz5849BUzTsah2anRWfhD+03T0mMqCZm6HRVBjCws7qaU82NYsTVTWiIDvbdNkL8cpqdnFRozu+BUa7W6MjYhC7QYKR3OCzeHu3+j0qAkxJJG0w6XejfaNCxpoSYZTKpZuG/TZj4zVDRYYlfdlnLjQEGvaXDzMsPAxCo9XkOBSBwxXV2vVdkZ8P5TtNs8KYUa7b7ROVx2lsMG7gen+xr0t0wtKoWYy2e8At/o5EPyRA+08f+KhW5W+LP9/218Hz73nppZcuwu//BsD/Ch6dsO5OQClYPH36dPJoD7c9In1/aIb7UN8RbfNoWLAoizSPx5OHQhBVsATsx0Xy0PLy8qNPP/306ezsbPk1KSN9Z0T0p6AE9IPJzQi/aLwiIyARmLhrIyBZ1W5GcCIoEaC4CBJgVXOmBHgNUxHgNcHaA3TFcCGw6rW90SxyTLzmeQ9TATPS8hIE+DcWlYg//+BPib3YRBjVFhYWAi+88MIVuPVegyX3Tez827GOjyLlz4M+OC7lbz25KwUgRioRJSADF04BFIBaPA6BLvJhaI+Pnjt37ihqRRuGFHewO/3Mt76mmXgZba2akoCSgJKA1SRAV0s9OBf+5Cc+Ig6WVxnWfZL9vPrqq7dgxX0Nfv9LZPzDYxhF4ObQCUf7/WMnwTBAiz2pWV9HgkGCuEg8ZWVlYygO0Q2SoNsgjbiBokHkijasHSyvFH/0vg+LWgSDUYNWTUlASUBJwEoS4LrF9YvrmJHgTxlxvea6zfWb6zjXc67r+MiR+f4bXTdKAVgjGSgBTP8LwApAkiBXRUVFBy+i9vb26x0dHdtPdl5znnj+JDXmf3zvh0QVatVTk1ZNSUBJQEnAChLgesV168tYv4yi+I3Khes012uu21y/uY5zPcfnjuL5j8rjQc/miDJ7UA+T8BmUAAQyrzCheQampFQEAWYiTTDrxo0bmfAhZdbV1ZUY1a0T1bXiy9Cgf+1fvoEc8TlTxQQYJQN1HiUBJQHrSIA7fw38sW4xi8XINjg4OI11+hpSuG9g3Sbf/zAi/lnZzM913ci+WOFcygKw8SzR7u4D8M+AL3oAF1QrLqRbb7zxxnXEBrBkpGHtFFJnvvL+jyh3gGESVydSElAS2I4EomZ/rldct4xsXJeR7neN6zSY/u5w3caD1O6OJvt50BwoBWAD6UTiAWAACC2AKMiNAJI+XFS3QRt88+LFi7cYYbrBT6W8TUvAH7//OQTUFIug2WiDpYxYHVRJQEnAShLgusT1iesU1ysjG9djrstcn7lOc73mug0FwJFFfuKVvVIAHiApKgGNjY1BEAR5EUAyjmCSHuSR3oJScB0XW4vf7zc0Oo++NEbT7iouNV/tgAfIUX2kJKAkYG8JkOSH6xLXJ6N9/lyHuR6jnPs1rs9cp7lec92G1FW63wMuPaUAPEA4/AhKwDKCSYJer9eD6lGjuLi66uvrb+Liuo7CEh246AxNGWY07Z+BTOMAsgR406mmJKAkoCSQTAlwHeJ6xHXJ6Gh/rr9ch1Ho5xpiswj+XVynuV5z3eb6nUzZmP3cSgGIY4ZwES0hmtQP89IcTEojuMA6cLHdBLf0VQSc9JBExMi2t7QMpBofhZmtTtda8kaOQZ1LSUBJwPoS8AP8T8DXz/WI65KRjesu11+uw9z5c13m+sx1mus1120j+2PFcykFIM5Zi1xMflx0szAvDcPH1EYloKur60pLS8tgnIfR7Wt1BUXQuJ9DYY0mU1UR1G2A6kBKAkoCppYAC0Bx/fkzcPtzPTK6tba2DnL95TrM9Ri7/yGuz+iHAv84J0OlAcYpKH6NSgAuMB/M/9NgB9wJmuBU8EqngnQChQTTU/ft21e9hcMl/FUW1PjK+z4ifvuH/yK+h2p2GWlppuBYT3hg6gBKAkoCppUA7Z2BUEi8c/8h8aVn3ycKs4wvEIZcf9etW7fI7X8dO/9WrMcDWJtnoAT41M4//ktHKQDxy4rf5LUfRprJ4tzc3BQuPNBq70yhInD9+vUUUAWfNbJ6IDvEm+//evcHREl2jvg7VPdigRjcjYfBYwAAKr9JREFUAPxINSUBJQElAV0lAJDVyin/3Mkz4jeeeFZkpz24ZLWuJ48cjNX9sN5eRmr2VTxaEO3fjw3YFAh/FvEVBkYZ65OVMUiDjqkUgC0KGuCKe2AlxIsNgSduFJbYCX8TLQFpV65cSUU7iyBBw4iC2H3ehL/z1Ls0JeDPX39ZMOol2WVttyhW9XUlASUBk0uAZZ6x+olfefS8+PQjjxtaUCgqGhD9TGGdvYLN13Wsuy1Yf/vgkmW6H8FfRfxHBRXns1IA4hRU7NciSkAQFx132+6oFQCWgLTXX38dhoC0h6CZFsb+RvZrVvf61ceeEKU5OeL3X/yRFheQulMVEZItd3V8JQEnSCCMcuCZKJP8+SeeET97/ExShjw6OjqL9fUqzP03Irn+vWBm5SaMuf6K438bs6JsxdsQWvQnsARQfiwTnNff318Dt8C+kZGRkygnfPrxxx8/AyrKvOh3jXz+UVe7+MKPvyMmvB5FHWyk4NW5lARsKAGy+zHe6Pmn3yOe2bs/KSOcmJjwvPzyy5cB+PT7X4MFtgMcLazNwgI/qrrfNmdFZQFsU3D8GS0BeAoiDcXLalOIDeiqrq6+mZmZeR0X67XJyUlDKYOjQ+FN+p8++NOiubRCpQlGhaKelQSUBLYsAab5cR3hepIs8Oc6yvWU6yrXV66zXG+57mJAaue/5Vm99wNlAbgni22/giWAtvYM7PwLwEddMz09vR++qpNgDDz1xBNPnERkau62D57AD13z8+K3f/DP4qW+bpGRkqKCAxOQpfqpkoCTJIA1TQRQ0e/xXXvEl97xPlGdX5CU4WMt9b744ovX4Fa9itiqa1hL25HyNwJLwBw6xJ2/yvVPYGaUApCA8GJ/GlUCoK0W+nw+TQkYGBg4Caaq008++eRx+K2SogR4AgHxBy/9SPz9zasCKQtip8oQiJ029VpJQElgjQSWAf5Ly8vio8dOic+ff0bkZdDLaXybmZkh+F9HRb+rDQ0NGviD5IdEbMz1V+Cvw5SoIEAdhMhDUBOFEgCleWkO2in+XEXavr6+HbiIBSwBSVECePM+/8x7wNNdIr786gXhA3lHmgoO1GnW1WGUBOwlgRCC/bIQ7Pdr558WHzt1Nmkbhgj434iCP3b9HQB/FzZUauev4yWnLAA6CpOHoiVgfHw8E+mAmiUALoF9tATQHUBLAExYSQkMZN8u9HSKL/74u2J4blZkpCrdjzJRTUlASWBVAuT0ry0oFF98+t3iyd3NSRMLzP6eCxcu3KDZnzv/CPiPAPxnFcWvvtOiFAB95akdLeIOyAT4r1UCTiI74AQCWJKmBPRMTUIJ+I54baAPGQIqLkDC9KtDKglYSgJYrzR//6MNu8TvItK/qaQ0af13u92M9r8OYh/6/K8r8Jc7FUoBkCTftUoA6IOb4Q44GQgETpw/f/4klIB8Safe9LDeYEBzB/x/1y/TYqHFBmz6I/UFJQElAdtJgL5+eit/9sQZ8e/OPSly05Pj76dgAf7z9Pkj2v/arl27riHav5M+fygBit9f0pWnFABJguVho0pATHbAvqGhoeOoX33iscceO2k0WdDaof7/rTc10qDJBa9yCawVjvpbScDmEqDJn8Rhnz//rPjJw8eSOlqS/Fy8eDGa6ncDG6QOAP+Ix+NRlf0kzoxSACQKl4eOKgHIDihAdkA13ALNLpfrGC7s448++ugpVLIylDZ47XBbxkfF84gLuDoyiFTBVJUquFZA6m8lAZtJgFa/AMh9TtXUi995+l3iSIWhNczeIk3S+5LhD4x+N5jnD+Dnzt+FaH8G/KnKfm+RmH5vKAVAP1lueKSIEpCB3X8hggMrwWq1F4GCR+AWOH7q1Kkzu3fvLt/wxwZ8MOf3ia9cfFH8VxQTYgoQaYVVUxJQErCfBMIw+TMV+GePnxb/E6jDCzKNr+QXK9Wenp6Jq1evXgazn0bvC/bULgT7jWFjpFL9YgUl6bVSACQJdu1ho0rAwsJCPnxdlV6vt2lsbOwILAMn0M4YXUp4bf/497fbWsTvgzNgZH4OvN8qS2A9Gan3lASsKgGy+tWA0OdzSPF734EjSR9GZ2en69q1a5dZ2Ke2tvY2fP+9ZPhDcZ95dE7l+RswQ2qVN0DIPAUCbTSeAFzccwD/ZZi4wigiFEaeawga8BKCA08dPny4Ae8Z1KO3nua9Bw6LgxWV4vcu/FBc6O3UggNTdiSvP2/toXpHSUBJYKsSWFpZ1oh9nkJq328++axoKk5elD/7jqJporW1dfD27dtXWNKXVf1AlNaLjyawPpLbn/S+iuGPwpLclAVAsoDXHh6WACJqOmIAcoPBYBliAnbBHXCY1MHNzc0nYQ3YAzdBUueFwUF/e/1N8edvvCJm4R5gbIBqSgJKAtaTAO/lwqws8amzbxM/f+KhpAf7wry/cv369e6urq6rKOpzHQpAC3z+fUj7Y1W/KLc/K5qrZoAE1MpugJBjTwHNFm72lQAu9pX5+fllEANBIV5ewvvh7u7uAAIFAw8//PD+jIyMpM0NSYL+xzOPijO19eL3XvyheHNoQKsqqGiEY2dSvVYSMK8EGMvDKn4P1TWI33jiHeJ4VU3SOwsrZ/jSpUvtqJh6DTn+N2H6b8X61w/CH1XSN0mzk9SdZpLGbIrTQgmg7NPwyEFMQAmoLxtgDTiAQMFjMIedeOSRRw7n5uYmLyk3IiUvagn81eWL4qtXLwkP+AOUNcAUl4/qhJLAhhJghH8e8vl/AVS+n4Ain5skLv/YDsLtGXjttddasOm5hp3/Lez627DODcDnP4XvLeARwiaI1VVVM1ACSgEwUNhrTxWjBGTNzc0VgwKzDkGB5Ao4CjfASXAFHMWNkpQiQmv7enl4UPzhyz8WV/CcBgZBZQ1YKyH1t5JAciXAXX8IFfxOw3L3vzz+tGbBS26PVs+ONc2LNL+boEO/juj+W9j5d2DnP4TI/2l8w4eHAv8kTZRSAJIk+OhpI0pAKsA/CxpwITRkVhLcCzPZUZjMjp05c+YkzGXF0e8n85nWgL+5+ob4mytvCKYOqnoCyZwNdW4lgXsSoK+fKX2/ePqs9kgmo9+9XgmB2Kbpy5cvX4NL8yZ3/gD+rvz8/BGse7N4TfAPq51/rMSMfa0UAGPlveHZcEOkwhWQiSyAAjAHVkEJ2A12rMNwDRxDYOAppgniRtnw90Z+cGN0BFTC/ype7e/VLAGKN8BI6atzKQnckwDz+rnzP9fYJH7t3NtN4etn77CeiY6ODhcC/q7C1H+TwX4A/B5USh1FzNMcTP8k+AnfG4l6lQwJmANRkjFyE54TN41WSRBKQB4yBCpgDdgFJeAQHsf2799/4tixY01wDZgiL487jq/fvi7+4tKr4A1AdUHFImjCK0p1ya4SIMDS11+TXyh++eFz4iNHTpjGIodI/+WbN2/2QgG4XllZSfBvxa6fkf7jAH+PquhnnqtSKQDmmQutJ1QC8CIdQTO5eJQhNqAewYEHYUo7CpasE8wQQK5sulm6PTg7I/7ktZfEt9tbEHW8pFUYNEvfVD+UBOwogeh99t79h8VnHj0v6guLTDNMEJ0FGOkPaybJfejvb4OvfxABzW48oml+KsffJDOmFACTTERsN6AEcJefBgtADp6LcTPV47EPcQFHoEUfP3v27GGY0JJWUji2r9HXF3o6xZ++/oq47hrSqIRTkkhoFO2TelYSsJMEWLmPJv8T1XXi0488Lp7cvddUwwPF+fwbb7xxGxaAm2T2w06/A+b/IXRyGhaAaKS/yvE30awpBcBEkxHbFSgBnBumCWahZkARrAA1IA/aA0vAEVQTPHz69OnjTU1NFbG/SfZrlhn+h1vXtCBBF9wC6XALqGyBZM+KOr/VJRDN6a+GuZ9Bfj919KTISWLZ3vXk2dvbO37lypUbYDi9TfCHv78bO38XSvrO4Psq0n89oZngPaUAmGASNupCRAlIQRpNFoMDkRVQCUWgCdUED+K9I4gLOHbkyJFdINIwRVxAdBx0C/zlmxfFP7fdFgvIHEgHsZC60KLSUc9KAvFJgEnxQcTaEOzff/CI+KWHHjOVuZ+jQGrfMih9++DvZwnf26jm1wpTfx8e9PfPwQVA8CfRmcrxp8BM1tS6bLIJWa87Fy5cSH3iiSfS4V/LQ1ZAOWIDGmBu2w9FgK6Aow899NABMAtmrvfbZL53bWQIQYIXxUt9XZrpMh38AaopCSgJbC4B+vmZXXN+117xSw8/htK9dZv/yOBvwEXpR4pfGzYjTPEjpW87dv4D9PdHOP1VQR+D52Srp1Mr8lYlloTvf/WrX10+dOjQMrRpFg8K4HkRzwtIqVnEzeeH+S0EBQButvzk1vZcI5sqVB579/5DYl9ZuXDNzYoRPFbwb6cqMLRGUupPJYFVCYSXl0R4aVkcr64Vv/3UO8WvoGQvK/iZrYGsbPrixYtX4Y680tDQcB3A34rdv8bpDwWABX0I/srfb7aJW9MfZQFYIxAz/xkJDmSNAC0uABkCVUwVpEsAloEjBw4cOIaKgnVQDkzlEqBMF4JB8S9wCfyXa5dEh3tCqzSo+APMfLWpvhkpAQb3MciPyvL/cPJhrVxvTrppkn3uimJpaWm5paVlqK2t7SYC/Gjyv4ONRx+IfkaR8qf5+59//vnwF7/4RQX+d6Vm3hdKATDv3Kzbs2hcAD7MxO4/H3EBUZfAPigCh2AdOIoAwX0IwDGVNSA6mBnfovjHlpvi725cFv0zUyJtZ4qmDEQ/V89KAk6SAEE/hF1/Y1GJ+JnjZ8SHDh8TRVnZphQBNhw+BPp1YN25RV8/0pI7sNsfAPhPYN2ZR6f9eCh/vylnb/1OKQVgfbmY/l0oAhpfADqagxuyBJaAWjzvpTUAWjqzBA7DNFdm1oFMeD1axsA3QCY0NDcDf2eK5vM0a39Vv5QE9JQAd/w099cVFIkPg8TnOUT2V+SaKrP3vuEODAy4Af4tsC62cNcPwCel7zCeo8V8gjD5q/z++6Rm/j+UAmD+OdqwhxFrQBrSBLllKIy6BMAXcAAugUPNzc3wCBxugoZu2liPUc+c+MbtG7AK3BCDs9NKEdhwttUHdpBAFPjrC4ux2z8O8D8uqvLM5+OPyhoWxiVG+Xd1dd2Gyb8VwX5tNPnDwjiK78wizW8Rz6qYT1RgFntWCoDFJmxtd9dxCZSBL6Ae1oBmWgNgoqM1YD/Mdflrf2umv8c885oSQEVgYGYaboEdmjJgpj6qvigJbFcC3O0vLa+IhqJV4Cf4V+aZ+pYUJPbBrr8dWUfRXX8ngo0HsaFwK5P/dq8Ec/1OKQDmmo9t9ybqEgD450BrL8ZNWw0lYPfY2NgBFBc6cPDgQfIG1JillsBGA51Y8IrvgFaYrgEGC/ICTUX6oLpQN5KYet+sEmDiexjpfHxmcB9N/e8BfW95jikqfG8oNnL5I69/pLW19TYyjdoQ3NcGwO/BZsIF8J+GEkBWP2Xy31CC1vlAravWmatNexp1CcD8zwDAAigBFXjdAPKgZioCsAIcRGXBfUjZIcWwqdu83y9+2N0m/uHmNXFrzKUtpGlQBBSzoKmnTXUOEiBzX4h5/Lhej1ZWi48eOyme3XNA5GeajqrjLfOFKqQLqODXAerxVlD5tiO3vxOmf+b2j+PLc3itWP3eIjXrvqEUAOvO3bo9pxLw4osvpoA4KAMmvFywcZX4fL4aMggODw8fAHPXfrAHHt6zZ081rAGmn38/mNAuouzwN1quidcG+oQn4FeZA+vOvHoz2RKIRvTnZWSKRxt2iQ8fPikeQ5neTDBhmr1h17/S3d3tgr+/Bcyi7aDzZRGfXuz2R8BCOoXNAwv5BPBQUf5mn8wt9M/0ALCFsaivxkgAioBWUAiBgdl4rVkDECzYAEtAM7T7/WAQpDVgLzR8c9sjY8Z0G5YAxgj8uLtDuObnRMoOxAko90CMhNRLoyUQNfMvYddfDcKep/fs04L7jmDnb5WGXb/32rVrXVgX7mBdaIfJvxM7/kEE+I0hsn8OikA00E/l9ltlUuPsp1IA4hSUFb8WcQmk4sbOgC8vF/EBJVACapAyyHoC+2gNQGzAoX379pk+NiBW/sNgFPxRdzuIhVrEnfFRzdyq3AOxElKvZUsgaubndXewogrEPYfFM3v2i9qCQtmn1u34UV//nTt3WrnrJ/BHfP0j2PlPIXbIC4WAu/6w4vLXTeymOpBSAEw1HXI6E7EGpMMakAXqzkK4BCpwc9eNjo7upTUAVoADR48ebYbPz9xhyWvEQ/fAm0P94pt3bmluAveCB1aBnRqfABasNd9WfyoJJCYB3EdaTYullWVRlpOnmfc/cPCoeKiu0RJm/tjRj4+Pz8Pc34lA4Tbu+quqqrqwSRhCNb/xzMzMWez66etnoJ/a9ccKzmav1SppswndaDgRawD5ADJRVEizBiBAsJpUwlACmhEwuA+8AcwWqMcCwDLElmq905PiQk+X+H5nm7gzMSp8oaDiFLDUDJq3s9Hc/ay0dHGwvEq8s/mAeHL3XtFUXGreTm/QM2wAQtjxD3Z2drbBzN8B8O9EUHAfdvwu7vpRxIe+fsXot4H87Pa2UgDsNqObjAeKAJWANFoDYAkowKMcaYO1sAbsQdBgM3YA+48dO9ZcV1dXhuCfTY5mvo8ZfX1jdFh8t+OOeKWvWwyAXGgZ+desO5BiwfGYT8LO6BED+gj8O8FH0QDSnrft2iPeve+gOF5VK2j2t1pDMLBAAR/3zZs3O3HPtyOorxO7/m4A/hDM/27c9/T1q12/1SY2wf4qBSBBAVrx5zHWAC1TAPSeRYgNqCKBEBSBZigHe0H3eQDZAk1I+zEnMXkcgp9aXBBXhgfFD2AVuDw8oAUO8oKnIqCUgTgE6LCvEPT5YGAfA/rO1DaId+w7IE7X1IuSbNNnzm44W7D0LcLc34u4H0b2dwH4NUIfBPmNgjZ8JhLhH8QBlK9/Qyna8wOlANhzXuMaFRQBLVMAC0QmXufDPFgK9wBTBllhcC8Wh70IENwP10At3ALmz2V6wKhH5mfFpaEB8aOuNnFr1CXIPMimLAMPEJoDPoru9DlUMvMxb/+ZvQfEw/UNKMNrnYC+9aYK93MYpv5hkPq0Q8nvglLfhXifPuz6R3A/T8K/Pw8Fn+Z+UvkqX/96QrT5e0oBsPkExzG8HRcuXCBvQBpZBLFoFAaDwTIoBfWIDdgNt8AemAebYQ1oRnGhUrgFLH/NsPjQ1ZEh8QLSCW+6hoULygAUoFXLAIIHVQBhHFeNRb/CeWbKHoGf81wN0D9WXSueQvreqZo6rTiPRYd2t9sw96+geM8kg/xg7u/EDr8bfv4e7PiHEOg3wSA/+PsXwRcSfPLJJ1nAh0YP1RwoAcsv5g6cMylDxsLIayEVUcGkK8tFilAxAgQrERxYj4jhPXAL7EaWwD4ECTZZLVvgQQIjn8CtsRHxMuIFrkEZGEIdAl84pGUT0E2gmAcfJD1rfMaUPc28j+j9rNQ0UQc+/hMA/fPw6x+trNHM/dYYyea9JH8/KHx7cc92wNzfg3u1mzn9AP0xgP80juBFqh93/crcv7k4bf8NpQDYfoq3NkAoAlqQIH6ViXiAfPxdAvBntkADFpXdSB/c09jY2HzgwIEGLDCkHLZNI8tgm3tcvA7GwTcG+0Tf9JRwozYBGw0fTDFUCoH5p1sDfIA9gz/ZysC9v6u4RJyt3yUeAUPfgbIKQbY+OzXco762traB/v7+TgB9N6L7e7Hj78c96oKlYwp+f/q8GORH4Fdle+00+QmMRSkACQjPrj+NWAOoCKTjkYVdBd0CpSQRwqMRbIK78Z09iA1gq4GLwHJpg5vNHaGjD6mFbRNQCAZ7xc3RETEEAqJ5vw+FiXZoioCyEGwmRWM+j+7w+cx/+ZlZMOUXimNVNeKR+iZxoLwCCkCpLQtKwcQfgp9/BI9OAHs3yHxo6u/HYyQ9PX0S5v9ZzIIW3Y9nReNrzCVpmbMoBcAyU2V8RyOKAIP/mC2QA/9/IeIEyqEE1FIRYLVBpBDtQaDgnqampgpUCrN0oOCDJOwPhUT3lFu0o0IhMwraJsYEAwtnfIuaQkB/MqmJmTqpbqoHSTKxz6iYMaWNYB8F/KKsbC1g70B5pRa5vx+V9/aUlInMNNvppXeFh9TdcG9v7zgC/LrB6NlNBr8I8A/Dvz8BGc0C/Fm1TzH53ZWaerFWAmqtWisR9fdbJABFgNkCBPdMgD7jA4qQLVABt0AtAgV3sdAQ/Iy74RbYXV9fX46dh/UIBN4y6ge/QRZCWgi6Jt3iJmIISEnMeIJxr0cEl8KaUkB3gfZQSsGDhbnBp9zN04wfC/bpKamaSZ9peqTgZV5+c2mZZuLPhH/f7g1gv4QAPzfM/T2Iz+lBVH8vzP19+fn5w4juH0eBrxkoA1EyH5r7VXS/3S+KBManFIAEhOe0n0IRSIGPMQ07jUzsMPJgfixGTEA5/I91UAIaUVSkCT7HPVAEdqGaWCmsA7ZXBGKvASoAg7MzsBKMi5Zxl+iZmtRiCCYRRxBVCmgpiCoGfK1uwNUQdFxbAHru7PF3xJSfAUAvhf++HA+y7h0C4O/D7r6uoEjUwMTvJNkB+JdRzXOyvb29F/dbN3f7APo+KN7c8Y/DDTcN65MHljl/Y2Mj0/qUnz/25lSv15WAk+6hdQWg3tyaBLA485rRAgVhAciiIoAFqQRWAc01AAuBpggg7agJroGmmpqaEqcpAlGJkkluFErBqGdO9CKgsHNyAm6ESTHhnYfrwKe5D8hcSIUA/2lWg6iCQCHztV0aTferII99fQTg8aTt7tPBrFeUnS2KYcqvyM3XwH4vdvW7S0pFVV6BFqVPGTmxsWAPgH8Kpv5eKNi9uK96Afz9AP0RuNzG8ZhG0N88LAD084fwUH5+J14o2xyzM++qbQpL/eyeBKKKADIF0rELyYIlIB/KQDFiBDTXADIGGvC6EUQju/fu3atZBJzgGrgnoY1fzSPbYAKuggmvV4sjGARd8QAsB1QWZhFkOO/3C2Yk+JGOyEY7wSr+rVoMopaD2OeNzyb3k1Uwj+7a7z3jFYCe/2dbERkpaQjOyxAFCNDjowom/IbCIlEPmt1aEO5U5OWJ8tw8kZueIbfDFjk6gm61HX9XV1cfODl6APj9SOkboKmfO37s9jXghxvAhwh/svgp4LfI3Jqpm0oBMNNsWLAvUUUAgM+Sw6wvkI8ApWIsTBVkFYwqAli4mvbs2dOAGIEyq7MKypymaQQVToPCeBYWAr4mY6H2gNWArgRPIKAVOlpEUOIiCh4xODGAeARWqFttq0rCfX3EXX7vRr/36r7v3P1jFbL5p/bq3p/aN6KAzj+YFpmRmopKeGkiCwF3fGSjYA5T7Gi6r8jFA0Q7ZNgrBZVuIXb4pNTlTl+19SVA9r7BwUF3d3f3ABToHijXAwR+svfhtbbjh7l/Hgq3D+8zwE8B//qiVO/GIYHNVoM4DqG+oiQAsFgNFEwB4KfHKgJkFYTpshaPOvgnG7B47dq9e3cjswbwmmmGqm1BAgR6L5QAPjzBgFgMBjXiIioDHlgOaF1YVRKoGITwWVizJFBRoLshtLykmd2DeD/aaElggRsuBik7UzRGxDQELjKKXgN3gDz98QT4vIwMkQ+Az8vM1MCexDrZ6ekiBw/u3nPxeRqOodrWJADFOdjX1zcO4O/H6z7E0gwiwG8QJv9hWM7cNPXHAH90xx/V+rZ2MvVtJYGIBJQCoC4FXSWwVhHATiUPu5oixgggZqAaWQN1UAZYcrihsbFxF1qV3QiFdBWoOpitJUACHwD/KIJr+6AsDyC4bxBR/UOwmLkQ0U/a3hko1J7Ijl8Bv62vBuMHpxQA42XuiDNGXANMHWSNgSy4A/LgGigioRAWPVYeZNGhenyvHhkDtAjUILipAEVLHCEfNUjnSgBFtgSCZeeQxz+CAL9+WGAGESszxN0+zPyjJPDBjn8GZn8P/P3R4D5F3evcS0bayJUCIE206sCUQEQRiLIKZiBoMA8BTAWIEWDlwQq4BagI1EExqIUC0Aj3QB2CmoqxANqWVEhdGc6UAMl7UGVzGsA/BAWgH0A/DDP/EHb9I8zhB/hPIpB2Dte/BxKifz+6418TieFM+alR6y8BpQDoL1N1xHUkEKMIaIRCAP0c5DYXwLRZjIWxDIpANd6rhWJQi8WwHpUH+ahQ7oF1hKnespQEaOYHec84HoNQegcB9AT+YQC/C9e6G4rANFJl5/Aemfu0Qj14VsF9lppla3ZWKQDWnDfL9jqiCESZBTOwIGYzTgDPxdj9lGBnVIVYgSooBLX4bh24zesQJ1ADWtMCLJTKP2DZmXdWx2HRWgJ99hz8+yOonTEEMz93+sPw7Y/C0jUKK9gUwJ/V+Tzw+S/iWaPsxfMyvqt2/M66XJI2WqUAJE306sQA+KgikI5FMgsLH2mGC6AMlCBwsBz5z1XYPVXj7xosnHVoNXiUYyHNxneVAJUETCUBXM8Ciuvi0NDQBB4jUGSHAPIjsGK54OMfRUDfBP6eQnDfHL7rhXIbLdKjKHtNNZPO6YxaRZ0z16YdKRZDXodanAAWzUwoAdnYQeUD+Jk9UIJFtRLvV1IZgJWgGjuoWrgHquErBYFctkolNO3MOqNjsGAFEdsyAxO/CxasYYyagO+C0joGZXUMgM/d/gwsWPN4vYj3aeZX/n1nXB6mHqVSAEw9Pc7qXEQR0KwCSBXMAPhnQQK5iJpm0GAx8qNLoQhU0DKARbcKO6pquAaqYRWohP80H3nSKnDQWZdM0kaLazGMmJV57PTHYOp3wWJFf74Lu/1xPMZwLU7C1z+NrJY5dNIL4Pchyl+Z+ZM2Y+rE60lAKQDrSUW9l3QJQBmgIqBZBZAqRZbBbAQL5sECUAAloASvmU7ILIJKKAOVWHxpEaiCMlCB3Rf4aDJUvEDSZ9FeHcA1twTl0wvQH8eOfxQWKhcuS22XT+DHNTeJ3f0U/PtzeM3c/UWkuMZG8yviHntdEpYfjVIALD+F9h5ArFUAI00H02AWFldNGYCboBAKQAmeS/FMoqEKLNKVyJ2uAk1qBQoR/ff2zmW5aSWKorElvxObQJK6d8aAP+AH+DPgz/gAmFIMGDChCpynndjy23ftg5VKbhGKVOxUbG9XdVottVqt1R3v06db8pGMATwF9gxsdjdZ2d3p1bwS/e/fv7fpez/pY5rL/4HQ/8S938atf0J8Snwh0af/9el613P7VMyL+lbWOi74oQRsADyUoM9/NALv3r0rvn37Vp4B/fB7mbcK1jAQGrhjm8QxTcCo7ICXDB3KGMAwOMIz8C+LrY5wDoRngPTm/2j8o7XIZl6IPjSW6DPI/8ni1DZpvZynLdHHuDymD4V7n4WoHb2el3SPkIv++P379zP6qkf7m9k9NuqubABsVHNuz83kUwR8SZdww1Z4p0AYA6wb2MPz2sIo2JcxoBcOYRAcMZI7ZIT2D2sGDhmh8eTVYYs52prfPLg9feauO9Wb+egnGQZlh0H+MXP6x4zkfzDSP0bw2/STE1z8J4i9FvJ1mM+/RPx7PLuf0deGGJf5z/Ba9O+C7P1PkoANgCfZLK7UfQjIGPj8+XPKQsAS4l7mS1mvHm4Q72EYNBH/fYJ+qvgFnoFDLSZE+A+ZHjjCEDjAQ/CcL3otM7B34D7g1zgvfWNMf+gzwj9D+E8Y8bdZX3KMyEv0TwinGABnhHOEvkvfuNRInzjDSBjRzyT6fnxvjfuAq37zV0JNwwTWnACGgAzaWDzIl3QYAzyWJc9AndDAKGjiIdBUgbwDYRAgBAcYCc/54td6gRcLg6DJF73WGngh4Zr3ibz6WsBHW2cIfnch+KcYgm3E/Yy2DsFH4M/oB5rLv2C7q1E+oc9jpzdFf0qZntfPwTpeawL2AKx187nyfyBQWBgEiaYJcNOWEf0K4i9joI5HYI94j/QzhGFfUwZ4B/So4QvK3EcA5CHYx6vwHKOgSZrBoBcT/oH3kzpE+05o7wFi3+VxvTNG+Oekj6nkOSJ/yny+xF6/tHeOi/8Cob+kfeXa75Pu095D+s3ohntfb+fzG/qeVCu7Mg8lYAPgoQR9/loQQOzlGVBIecdAmZFfmbncKqKglw41GCHuIhpN4hbhmQwDeQo4to9r+BlCccBqb31kFKAfTRkEVcpYi/vf5Eri1dmh7Qa49Ad4fLo8EXJOuMCwO+GRvAvm7SXyIfQa3RM6NF2X+IpjWsDXp4wBnqARz+rrBT0Tgkb5ntPf5I7je9uxAeBOsHUEFp6BmCrg5lPeJ1BB6CssBqtiGNQQjjAIMAT03gH9ToGmDVpojILeTtgkn9YNyCCQMSCjoMGIsqzA+gL/X62oV9FGc7w0IwUMuSvaTlrfRfwvEPAzDLIu4q6RfUeB7Q5tdYnYK1yxr0e+jLbLaMcRi/vyl/PYtb+iNnOxT5eAv6iebtu4Zo9EIPcOfPv2LWU0mCIU5dwgwACoIhh1hKeB+OuthLukNXXQRIQUWuTV2oI9ztPUgQyCXYSlxQxCA8GpMMqUYWBXwT3bE7YT2I4wyIZ48PsSe6ZprjDIuhhn5xhaVzDXo3jxOB6j/K7EXvsR/itirdTv4wUYsD2gGThtOOL8ycuXLz3Kv2d7OPvmEbABsHlt6jt6IIHcIKCYhDnkEsJRYmSpRw0riJHc/lXESUaB1hI0EP8GOiVPgaYRdhGZpowE9jUQHs0VtDAM6oRdRp67GAh1xKqCOKWEEiImb8RWfmA1g5U+mrMfIvJ9jKtLAsleH54dOHdpA4m5RvEa4V/BUu77S9qiR7oH5z6GQLjyMboG5B3SLkPaYMwajnhMD8B2629lL/NN30XABsBdZLzfBCCwmC7Q/0nx69evyatXrxJGozIISohLhViPHVbJJ09BDUHTrxrGNALH6zIO2KcnEBryIpBHHgTlqSNSTQRNXoIqAf2qEdVriFsZUeOwLhOfIn/X7n+Ve58TZoSJBB4xH8NBI3rsp0ziniH0A/jILd+FYZ97zrjXHkEi34OPtnuw0XafPBlxpnwwHMBrQPEjje51GbwuY9ppSjuFS19NSD4v3vN/swn8hsDafan85h68ywQelcDCKMgXFepRwYR56DAKcDeXcFNrgWGF6QOtLZBxUEHsqqRrCF1VhgEhthE/vdZY2zUMBAXlq1BmDZFrIHY1eSAWgSTzE2XZB9UymwmBS6Up1y0yClZA7wpK3owL7N9hx19zos471EUG0Jz6LKJfMfvnBHbPQtyp/4Qw5R65rZEEXSPvGH0Tj0lm3F+Pi2fUa0hd5JIPEaf+EnQJv1z0GSG2yZdxjwPqPGTfQOch8EO8JyMuO5bYs/4iH9nnYu/H8/66hZ3RBPweAPcBE1gKARQyvASfPn0qMv1flKeARWohzohdCREsKWYErEWC2o7HEjmvjJjplw9zg6HCvkgjqDHtgNiWEdDIR6zjZUKJ/SlxikjKACgrqFzi2LewC0iyAw8CebUpw2CHfLesAYk6H7HIBX8nFH7xh/pJ/SfSfPJKhCNoH+VNKE/xmDBCzIdcb0g8Yn+kEfPYx3EJ+pB6RFr5dA6j/aHKJYzZjhjDYKw0156wOn+ikT1TBLPXr19rdb7Ffik914VsMwEvTNrm1ve9L40AIib11EhU4fqDQMpTUPjy5UsR4Sri/kYTkwThSxC1FJFPEXUZBBLxCIiexL2EyEZgFC2hLymghYpTGRSUmyqfYvYllKOyYptzE44l7C8qcF6BdJH9VPXXJ68k+2KTfHPqkQtrbFOniBHwKdtTzpwi7BMFbXOixD/eiifBZt+Ee1McAbGfcN5YQfkUuF5uSKicMWXLqNCiPLkVphhQUwypXOjlwvfjeHljOTaBJRKwAbBEmC7KBP5P4IZ4XRsG6Gw++i7IY/DmzZvwHnBuTCvgOZBrP0E0NVxPMBK0HUFpDILYRuwThF15JfAh+BJ9RLWAgSAjo6C8lMtpqDUGgOqnbYRYLv6oB9ty6Ye7X8eVJpI9EDEiPqX8OdeRm0CegBl5ppQ/5TozxVwzxFt5OR7bEnMZDov0jGtMMXrEIUbwij98+DDn/pUO9wNVi5i0PyZgAismkH8RrfgyLt4ETOA+BBBN/W/eFcKrwBMKRVa4K0+RNQjXE/88aVBgcd0vXz9lsOCuwGK5iFUHthXd+t8nz7UBwIK7EGG8FfPFNtWZz3lyYcbz9rGNuM+Yg1e+GfWYU49cxPNYx24Fi7uw+2MCJmACJmACSyKAIsdrj/VzyWwryPWfh1gnQDrijx8/xlQC6d/Gi+O3zrlRVkwpLK6Tv2p5SXfhYkzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABEzABExgJQT+A2l/kEzj7avfAAAAAElFTkSuQmCC
+  plans:
+  - id: 19ed3cb3-f767-4b56-9a29-bc5254980eed
+    name: hobby-dev-aws
+    human_name: Hobby (1.5.10)
+    description: Memcached 1.5.10 - 1xCPU 512MB Ram
+    version: 1.5.10
+    type: memcached
+    scheme: memcached
+    categories: Data Stores
+    cost_cents: 1500
+    attributes:
+      ram: 512MB
+      cpu: 1xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 20
+      shards: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-memcached-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.t2.micro
+      CacheParameterGroupName: default.memcached1.5
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${MEMCACHED_SUBNET_GROUP}
+      Engine: memcached
+      EngineVersion: 1.5.10
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: null
+      SnapshotWindow: null
+      Tags: null
+  - id: eb120f94-2cd2-4133-8196-c2ef98deffb4
+    name: standard-0-aws
+    human_name: Standard-0 (1.5.10)
+    description: Memcached 1.5.10 - 1xCPU 1.5GB Ram
+    version: 1.5.10
+    type: memcached
+    scheme: memcached
+    categories: Data Stores
+    cost_cents: 3000
+    attributes:
+      ram: 1524MB
+      cpu: 1xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 100
+      shards: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-memcached-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.t2.small
+      CacheParameterGroupName: default.memcached1.5
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${MEMCACHED_SUBNET_GROUP}
+      Engine: memcached
+      EngineVersion: 1.5.10
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: null
+      SnapshotWindow: null
+      Tags: null
+  - id: b9bde884-1cb0-44cb-b333-2597a3bbe921
+    name: standard-1-aws
+    human_name: Standard-1 (1.5.10)
+    description: Memcached 1.5.10 - 2xCPU 3GB Ram
+    version: 1.5.10
+    type: memcached
+    scheme: memcached
+    categories: Data Stores
+    cost_cents: 5000
+    attributes:
+      ram: 3GB
+      cpu: 2xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 250
+      shards: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-memcached-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.t2.medium
+      CacheParameterGroupName: default.memcached1.5
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${MEMCACHED_SUBNET_GROUP}
+      Engine: memcached
+      EngineVersion: 1.5.10
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: null
+      SnapshotWindow: null
+      Tags: null
+  - id: f3ed90a7-0da2-4369-a341-b8b8f536d073
+    name: premium-0-aws
+    human_name: Premium-0 (1.5.10)
+    description: Memcached 1.5.10 - 2xCPU 12GB Ram
+    version: 1.5.10
+    type: memcached
+    scheme: memcached
+    categories: Data Stores
+    cost_cents: 13500
+    attributes:
+      ram: 12GB
+      cpu: 2xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 500
+      shards: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-memcached-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.r5.large
+      CacheParameterGroupName: default.memcached1.5
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${MEMCACHED_SUBNET_GROUP}
+      Engine: memcached
+      EngineVersion: 1.5.10
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: null
+      SnapshotWindow: null
+      Tags: null
+  - id: f67c1968-5b98-4a2c-8fcf-28e9ab8b8436
+    name: premium-1-aws
+    human_name: Premium-1 (1.5.10)
+    description: Memcached 1.5.10 - 4xCPU 13GB Ram
+    version: 1.5.10
+    type: memcached
+    scheme: memcached
+    categories: Data Stores
+    cost_cents: 27000
+    attributes:
+      ram: 13GB
+      cpu: 4xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 1000
+      shards: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: aws-memcached-instance
+    provider_private_details:
+      AZMode: null
+      AuthToken: null
+      AutoMinorVersionUpgrade: true
+      CacheClusterId: null
+      CacheNodeType: cache.m5.xlarge
+      CacheParameterGroupName: default.memcached1.5
+      CacheSecurityGroupNames: null
+      CacheSubnetGroupName: ${MEMCACHED_SUBNET_GROUP}
+      Engine: memcached
+      EngineVersion: 1.5.10
+      NotificationTopicArn: null
+      NumCacheNodes: 1
+      Port: 6379
+      PreferredAvailabilityZone: null
+      PreferredAvailabilityZones: null
+      PreferredMaintenanceWindow: null
+      ReplicationGroupId: null
+      SecurityGroupIds:
+      - ${ELASTICACHE_SECURITY_GROUP}
+      SnapshotArns: null
+      SnapshotName: null
+      SnapshotRetentionLimit: null
+      SnapshotWindow: null
+      Tags: null
+  - id: 36ed3cb3-f767-1b56-9a29-bc5254980ee6
+    name: hobby-dev
+    human_name: Hobby (1.6.6)
+    description: Memcached 1.6.6 - 1xCPU 1GB Ram
+    version: 1.6.6
+    type: memcached
+    scheme: memcached
+    categories: Data Stores
+    cost_cents: 750
+    attributes:
+      ram: 512MB
+      cpu: 1xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 20
+      shards: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-memcached-instance
+    provider_private_details:
+      size_in_megabytes: '1000'
+      version: 1.6.6
+  - id: 36120f94-2cd2-3133-8196-c2ef98deffb6
+    name: standard-0
+    human_name: Standard-0 (1.6.6)
+    description: Memcached 1.6.6 - 1xCPU 2GB Ram
+    version: 1.6.6
+    type: memcached
+    scheme: memcached
+    categories: Data Stores
+    cost_cents: 1500
+    attributes:
+      ram: 2GB
+      cpu: 1xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 100
+      shards: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-memcached-instance
+    provider_private_details:
+      size_in_megabytes: '2000'
+      version: 1.6.6
+  - id: 36bde884-1cb0-44cb-b333-2597a3bbe926
+    name: standard-1
+    human_name: Standard-1 (1.6.6)
+    description: Memcached 1.6.6 - 2xCPU 4GB Ram
+    version: 1.6.6
+    type: memcached
+    scheme: memcached
+    categories: Data Stores
+    cost_cents: 2500
+    attributes:
+      ram: 4GB
+      cpu: 2xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 250
+      shards: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-memcached-instance
+    provider_private_details:
+      size_in_megabytes: '4000'
+      version: 1.6.6
+  - id: 36ed90a7-0da2-6369-a341-b8b8f536d076
+    name: premium-0
+    human_name: Premium-0 (1.6.6)
+    description: Memcached 1.6.6 - 2xCPU 12GB Ram
+    version: 1.6.6
+    type: memcached
+    scheme: memcached
+    categories: Data Stores
+    cost_cents: 7000
+    attributes:
+      ram: 12GB
+      cpu: 2xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 500
+      shards: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-memcached-instance
+    provider_private_details:
+      size_in_megabytes: '12000'
+      version: 1.6.6
+  - id: 367c1968-5b98-7a2c-8fcf-28e9ab8b8436
+    name: premium-1
+    human_name: Premium-1 (1.6.6)
+    description: Memcached 1.6.6 - 4xCPU 14GB Ram
+    version: 1.6.6
+    type: memcached
+    scheme: memcached
+    categories: Data Stores
+    cost_cents: 13500
+    attributes:
+      ram: 14GB
+      cpu: 4xCPU
+      presistance: false
+      restartable: true
+      data_clips: false
+      connection_limit: 1000
+      shards: 0
+      high_availability: false
+      rollback: none
+      encryption_at_rest: false
+      dedicated: true
+    provider: kubernetes-memcached-instance
+    provider_private_details:
+      size_in_megabytes: '14000'
+      version: 1.6.6
+`
+
+// defaultCatalog returns the default services and plans, with or without the plans of the
+// kubernetes providers.
+func defaultCatalog(kubernetes bool) (*Catalog, error) {
+	catalog, err := ParseCatalog([]byte(defaultCatalogFile))
+	if err != nil {
+		return nil, err
+	}
+	if kubernetes {
+		return catalog, nil
+	}
+	plans := make([]PlanDefinition, 0)
+	for _, plan := range catalog.Plans {
+		if !strings.HasPrefix(plan.Provider, "kubernetes-") {
+			plans = append(plans, plan)
+		}
+	}
+	catalog.Plans = plans
+	return catalog, nil
+}
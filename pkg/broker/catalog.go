@@ -0,0 +1,539 @@
+package broker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/ghodss/yaml"
+	"io/ioutil"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ServiceDefinition is a row of the services table as it is managed by operators.
+type ServiceDefinition struct {
+	Id          string    `json:"id"`
+	Name        string    `json:"name"`
+	HumanName   string    `json:"human_name"`
+	Description string    `json:"description"`
+	Categories  string    `json:"categories"`
+	Image       string    `json:"image"`
+	Beta        bool      `json:"beta"`
+	Deprecated  bool      `json:"deprecated"`
+	Deleted     bool      `json:"deleted"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+}
+
+// PlanDefinition is a row of the plans table as it is managed by operators. Unlike
+// ProviderPlan the provider private details are kept as they are stored, environment
+// variables in them are not expanded.
+type PlanDefinition struct {
+	Id                               string          `json:"id"`
+	Service                          string          `json:"service"`
+	Name                             string          `json:"name"`
+	HumanName                        string          `json:"human_name"`
+	Description                      string          `json:"description"`
+	Version                          string          `json:"version"`
+	Type                             string          `json:"type"`
+	Scheme                           string          `json:"scheme"`
+	Categories                       string          `json:"categories"`
+	CostCents                        int             `json:"cost_cents"`
+	CostUnit                         string          `json:"cost_unit"`
+	Attributes                       json.RawMessage `json:"attributes"`
+	Provider                         string          `json:"provider"`
+	ProviderPrivateDetails           json.RawMessage `json:"provider_private_details"`
+	ParametersSchema                 json.RawMessage `json:"parameters_schema"`
+	InstallableInsidePrivateNetwork  bool            `json:"installable_inside_private_network"`
+	InstallableOutsidePrivateNetwork bool            `json:"installable_outside_private_network"`
+	SupportsMultipleInstallations    bool            `json:"supports_multiple_installations"`
+	SupportsSharing                  bool            `json:"supports_sharing"`
+	Preprovision                     int             `json:"preprovision"`
+	Beta                             bool            `json:"beta"`
+	Deprecated                       bool            `json:"deprecated"`
+	Deleted                          bool            `json:"deleted"`
+	Created                          time.Time       `json:"created"`
+	Updated                          time.Time       `json:"updated"`
+}
+
+// NewServiceDefinition returns a service with the same defaults as the services table.
+func NewServiceDefinition() ServiceDefinition {
+	return ServiceDefinition{Categories: "Data Stores"}
+}
+
+// NewPlanDefinition returns a plan with the same defaults as the plans table.
+func NewPlanDefinition() PlanDefinition {
+	return PlanDefinition{
+		CostUnit:                         "month",
+		Attributes:                       json.RawMessage("{}"),
+		ProviderPrivateDetails:           json.RawMessage("{}"),
+		ParametersSchema:                 json.RawMessage("{}"),
+		InstallableInsidePrivateNetwork:  true,
+		InstallableOutsidePrivateNetwork: true,
+		SupportsMultipleInstallations:    true,
+		SupportsSharing:                  true,
+	}
+}
+
+// These mirror the domains and enums in the database so mistakes are reported before
+// they become a failed insert.
+var catalogNameRegex = regexp.MustCompile("^[A-Za-z0-9\\-]+$")
+var catalogIdRegex = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+var catalogCostUnits = []string{"year", "month", "day", "hour", "minute", "second", "cycle", "byte", "megabyte", "gigabyte", "terabyte", "petabyte", "op", "unit"}
+
+func catalogContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeStrict unmarshals JSON and fails on fields the target does not have, these are
+// almost always a misspelling that would otherwise be silently ignored.
+func decodeStrict(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// providerSettings returns what a provider reads a plan's provider_private_details into
+// and the engine it runs.
+func providerSettings(provider Providers) (interface{}, string, error) {
+	switch provider {
+	case AWSRedisInstance:
+		return &elasticache.CreateCacheClusterInput{}, "redis", nil
+	case AWSMemcachedInstance:
+		return &elasticache.CreateCacheClusterInput{}, "memcached", nil
+	case AWSRedisReplicationGroup, AWSRedisCluster:
+		return &elasticache.CreateReplicationGroupInput{}, "redis", nil
+	case KubernetesRedisInstance, KubernetesRedisPersistent:
+		return &struct {
+			redisProviderPlan
+			KubernetesSettings
+		}{}, "redis", nil
+	case KubernetesMemcachedInstance:
+		return &struct {
+			MemcachedProviderPlan
+			KubernetesSettings
+		}{}, "memcached", nil
+	}
+	return nil, "", errors.New("The provider " + string(provider) + " is not known.")
+}
+
+func validateSizeInMegabytes(size string) error {
+	if megabytes, err := strconv.Atoi(size); err != nil || megabytes < 1 {
+		return errors.New("The size_in_megabytes must be a positive number.")
+	}
+	return nil
+}
+
+// ValidateProviderPrivateDetails checks the details parse into the settings of the provider,
+// environment variables are expanded first just as they are when the plan is used.
+func ValidateProviderPrivateDetails(provider Providers, engine string, details string) error {
+	settings, providerEngine, err := providerSettings(provider)
+	if err != nil {
+		return err
+	}
+	if engine != providerEngine {
+		return errors.New("The provider " + string(provider) + " only supports " + providerEngine + " plans.")
+	}
+	expanded := os.ExpandEnv(details)
+	if err := decodeStrict([]byte(expanded), settings); err != nil {
+		return errors.New("The provider_private_details are not valid for " + string(provider) + ": " + err.Error())
+	}
+	switch s := settings.(type) {
+	case *elasticache.CreateCacheClusterInput:
+		if s.CacheNodeType == nil || *s.CacheNodeType == "" {
+			return errors.New("The provider_private_details must include a CacheNodeType.")
+		}
+		if s.Engine == nil || *s.Engine != engine {
+			return errors.New("The provider_private_details must have an Engine of " + engine + ".")
+		}
+	case *elasticache.CreateReplicationGroupInput:
+		if s.CacheNodeType == nil || *s.CacheNodeType == "" {
+			return errors.New("The provider_private_details must include a CacheNodeType.")
+		}
+		if s.Engine != nil && *s.Engine != engine {
+			return errors.New("The provider_private_details must have an Engine of " + engine + ".")
+		}
+	case *struct {
+		redisProviderPlan
+		KubernetesSettings
+	}:
+		if err := validateSizeInMegabytes(s.SizeInMegabytes); err != nil {
+			return err
+		}
+		if s.Version == "" {
+			return errors.New("The provider_private_details must include a version.")
+		}
+		if provider == KubernetesRedisPersistent && s.StorageSize != "" {
+			if _, err := resource.ParseQuantity(s.StorageSize); err != nil {
+				return errors.New("The storage_size is not a valid quantity: " + err.Error())
+			}
+		}
+	case *struct {
+		MemcachedProviderPlan
+		KubernetesSettings
+	}:
+		if err := validateSizeInMegabytes(s.SizeInMegabytes); err != nil {
+			return err
+		}
+		if s.Version == "" {
+			return errors.New("The provider_private_details must include a version.")
+		}
+	}
+	if provider == KubernetesRedisInstance || provider == KubernetesRedisPersistent || provider == KubernetesMemcachedInstance {
+		if _, err := GetKubernetesSettings(&ProviderPlan{providerPrivateDetails: expanded}, engine); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateServiceDefinition checks a service before it is added or changed.
+func ValidateServiceDefinition(service *ServiceDefinition) error {
+	if service.Id != "" && !catalogIdRegex.MatchString(service.Id) {
+		return errors.New("The id must be a uuid.")
+	}
+	if !catalogNameRegex.MatchString(service.Name) || len(service.Name) > 128 {
+		return errors.New("The name must be alpha numeric or dashes and no longer than 128 characters.")
+	}
+	if service.HumanName == "" || service.Description == "" {
+		return errors.New("The human_name and description are required.")
+	}
+	return nil
+}
+
+// ValidatePlanDefinition checks a plan before it is added or changed, a plan that does not
+// pass would either fail to insert or break the catalog or provisioning once it is in use.
+func ValidatePlanDefinition(plan *PlanDefinition) error {
+	if plan.Id != "" && !catalogIdRegex.MatchString(plan.Id) {
+		return errors.New("The id must be a uuid.")
+	}
+	if !catalogIdRegex.MatchString(plan.Service) {
+		return errors.New("The service must be the id of a service.")
+	}
+	if !catalogNameRegex.MatchString(plan.Name) || len(plan.Name) > 128 {
+		return errors.New("The name must be alpha numeric or dashes and no longer than 128 characters.")
+	}
+	if plan.HumanName == "" || plan.Description == "" || plan.Version == "" {
+		return errors.New("The human_name, description and version are required.")
+	}
+	if plan.Type != "redis" && plan.Type != "memcached" {
+		return errors.New("The type must be redis or memcached.")
+	}
+	if plan.Scheme != "redis" && plan.Scheme != "memcached" && plan.Scheme != "" {
+		return errors.New("The scheme must be redis, memcached or empty.")
+	}
+	if plan.CostCents < 0 {
+		return errors.New("The cost_cents must not be negative.")
+	}
+	if !catalogContains(catalogCostUnits, plan.CostUnit) {
+		return fmt.Errorf("The cost_unit must be one of %v.", catalogCostUnits)
+	}
+	if plan.Preprovision < 0 {
+		return errors.New("The preprovision count must not be negative.")
+	}
+	// Every plan's attributes are read into a map when the catalog is built, anything but an
+	// object here would break the catalog for all plans.
+	var attributes map[string]interface{}
+	if err := json.Unmarshal(plan.Attributes, &attributes); err != nil || attributes == nil {
+		return errors.New("The attributes must be a JSON object.")
+	}
+	provider := GetProvidersFromString(plan.Provider)
+	if provider == Unknown {
+		return errors.New("The provider " + plan.Provider + " is not known.")
+	}
+	if err := ValidateProviderPrivateDetails(provider, plan.Type, string(plan.ProviderPrivateDetails)); err != nil {
+		return err
+	}
+	return ValidateParametersSchema(provider, string(plan.ParametersSchema))
+}
+
+// Catalog is the services and plans the broker should offer, as given in a catalog file.
+type Catalog struct {
+	Services []ServiceDefinition
+	Plans    []PlanDefinition
+}
+
+// CatalogChange is a difference between the catalog file and the services and plans tables.
+type CatalogChange struct {
+	Action string   `json:"action"` // add, update or deprecate
+	Kind   string   `json:"kind"`   // service or plan
+	Id     string   `json:"id"`
+	Name   string   `json:"name"`
+	Fields []string `json:"fields,omitempty"`
+}
+
+func (c CatalogChange) String() string {
+	description := c.Action + " " + c.Kind + " " + c.Name + " (" + c.Id + ")"
+	if len(c.Fields) > 0 {
+		description = description + ": " + strings.Join(c.Fields, ", ")
+	}
+	return description
+}
+
+// ParseCatalog reads a catalog in YAML or JSON. Services list their plans, which take
+// the same fields as the admin API, fields that are left out have the table's default.
+//
+//	services:
+//	- id: 0a8d668d-2971-4533-8e38-a816a7c01bec
+//	  name: akkeris-redis
+//	  ...
+//	  plans:
+//	  - id: c7b8e0b0-429a-4fa8-92a0-e60d9e781cac
+//	    name: hobby-dev
+//	    ...
+func ParseCatalog(data []byte) (*Catalog, error) {
+	data, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Services []json.RawMessage `json:"services"`
+	}
+	if err := decodeStrict(data, &file); err != nil {
+		return nil, err
+	}
+	catalog := Catalog{Services: make([]ServiceDefinition, 0), Plans: make([]PlanDefinition, 0)}
+	serviceIds := make(map[string]bool)
+	planIds := make(map[string]bool)
+	for _, rawService := range file.Services {
+		service := struct {
+			ServiceDefinition
+			Plans []json.RawMessage `json:"plans"`
+		}{ServiceDefinition: NewServiceDefinition()}
+		if err := decodeStrict(rawService, &service); err != nil {
+			return nil, err
+		}
+		if service.Id == "" {
+			return nil, errors.New("The service " + service.Name + " does not have an id, ids are required as they identify the service to clients.")
+		}
+		if err := ValidateServiceDefinition(&service.ServiceDefinition); err != nil {
+			return nil, errors.New("The service " + service.Name + " is not valid: " + err.Error())
+		}
+		if serviceIds[service.Id] {
+			return nil, errors.New("The service id " + service.Id + " is used more than once.")
+		}
+		serviceIds[service.Id] = true
+		catalog.Services = append(catalog.Services, service.ServiceDefinition)
+
+		planNames := make(map[string]bool)
+		for _, rawPlan := range service.Plans {
+			plan := NewPlanDefinition()
+			if err := decodeStrict(rawPlan, &plan); err != nil {
+				return nil, errors.New("A plan of " + service.Name + " is not valid: " + err.Error())
+			}
+			if plan.Service != "" && plan.Service != service.Id {
+				return nil, errors.New("The plan " + plan.Name + " is listed under " + service.Name + " but has the service " + plan.Service + ".")
+			}
+			plan.Service = service.Id
+			if plan.Id == "" {
+				return nil, errors.New("The plan " + plan.Name + " does not have an id, ids are required as they identify the plan to clients.")
+			}
+			if err := ValidatePlanDefinition(&plan); err != nil {
+				return nil, errors.New("The plan " + service.Name + ":" + plan.Name + " is not valid: " + err.Error())
+			}
+			if planIds[plan.Id] {
+				return nil, errors.New("The plan id " + plan.Id + " is used more than once.")
+			}
+			if planNames[plan.Name] {
+				return nil, errors.New("The service " + service.Name + " has more than one plan named " + plan.Name + ".")
+			}
+			planIds[plan.Id] = true
+			planNames[plan.Name] = true
+			catalog.Plans = append(catalog.Plans, plan)
+		}
+	}
+	return &catalog, nil
+}
+
+func LoadCatalogFile(file string) (*Catalog, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	catalog, err := ParseCatalog(data)
+	if err != nil {
+		return nil, errors.New("Unable to read the catalog " + file + ": " + err.Error())
+	}
+	return catalog, nil
+}
+
+// changedFields compares two services or plans by their JSON, so attributes and provider
+// private details that differ only in formatting are not considered changed.
+func changedFields(current interface{}, desired interface{}) []string {
+	var a, b map[string]interface{}
+	currentJson, _ := json.Marshal(current)
+	desiredJson, _ := json.Marshal(desired)
+	json.Unmarshal(currentJson, &a)
+	json.Unmarshal(desiredJson, &b)
+	fields := make([]string, 0)
+	for key := range b {
+		if key == "id" || key == "deleted" || key == "created" || key == "updated" {
+			continue
+		}
+		if !reflect.DeepEqual(a[key], b[key]) {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// SyncCatalog brings the services and plans in storage in line with the catalog. New services
+// and plans are added, changed ones updated and those missing from the catalog deprecated,
+// nothing is ever deleted. With dryRun the changes are worked out but not made.
+func SyncCatalog(storage Storage, catalog *Catalog, dryRun bool) ([]CatalogChange, error) {
+	changes := make([]CatalogChange, 0)
+
+	services, err := storage.ListServiceDefinitions(true)
+	if err != nil {
+		return nil, err
+	}
+	existingServices := make(map[string]ServiceDefinition)
+	for _, service := range services {
+		existingServices[service.Id] = service
+	}
+	wantedServices := make(map[string]bool)
+	for _, service := range catalog.Services {
+		wantedServices[service.Id] = true
+		existing, ok := existingServices[service.Id]
+		if ok && existing.Deleted {
+			return nil, errors.New("The service " + service.Name + " (" + service.Id + ") was deleted, it cannot be added again with the same id.")
+		}
+		if !ok {
+			changes = append(changes, CatalogChange{Action: "add", Kind: "service", Id: service.Id, Name: service.Name})
+			if !dryRun {
+				if err := storage.AddService(&service); err != nil {
+					return nil, err
+				}
+			}
+		} else if fields := changedFields(existing, service); len(fields) > 0 {
+			changes = append(changes, CatalogChange{Action: "update", Kind: "service", Id: service.Id, Name: service.Name, Fields: fields})
+			if !dryRun {
+				if err := storage.UpdateService(&service); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	plans, err := storage.ListPlanDefinitions("", true)
+	if err != nil {
+		return nil, err
+	}
+	existingPlans := make(map[string]PlanDefinition)
+	for _, plan := range plans {
+		existingPlans[plan.Id] = plan
+	}
+	wantedPlans := make(map[string]bool)
+	for _, plan := range catalog.Plans {
+		wantedPlans[plan.Id] = true
+		existing, ok := existingPlans[plan.Id]
+		if ok && existing.Deleted {
+			return nil, errors.New("The plan " + plan.Name + " (" + plan.Id + ") was deleted, it cannot be added again with the same id.")
+		}
+		if !ok {
+			changes = append(changes, CatalogChange{Action: "add", Kind: "plan", Id: plan.Id, Name: plan.Name})
+			if !dryRun {
+				if err := storage.AddPlan(&plan); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		fields := changedFields(existing, plan)
+		if len(fields) == 0 {
+			continue
+		}
+		if plan.Provider != existing.Provider || plan.Type != existing.Type || plan.Service != existing.Service {
+			count, err := storage.CountInstancesOnPlan(plan.Id)
+			if err != nil {
+				return nil, err
+			}
+			if count > 0 {
+				return nil, fmt.Errorf("The provider, type and service of the plan %s (%s) cannot be changed while it is used by %d instances.", plan.Name, plan.Id, count)
+			}
+		}
+		changes = append(changes, CatalogChange{Action: "update", Kind: "plan", Id: plan.Id, Name: plan.Name, Fields: fields})
+		if !dryRun {
+			if err := storage.UpdatePlan(&plan); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, plan := range plans {
+		if !wantedPlans[plan.Id] && !plan.Deleted && !plan.Deprecated {
+			changes = append(changes, CatalogChange{Action: "deprecate", Kind: "plan", Id: plan.Id, Name: plan.Name})
+			if !dryRun {
+				plan.Deprecated = true
+				if err := storage.UpdatePlan(&plan); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	for _, service := range services {
+		if !wantedServices[service.Id] && !service.Deleted && !service.Deprecated {
+			changes = append(changes, CatalogChange{Action: "deprecate", Kind: "service", Id: service.Id, Name: service.Name})
+			if !dryRun {
+				service.Deprecated = true
+				if err := storage.UpdateService(&service); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return changes, nil
+}
+
+// addMissingCatalog adds the services and plans of the catalog that storage does not have,
+// those it has (even deleted ones) are left as they are. This is how the default catalog is
+// added, so changes made to its plans through the admin API are kept.
+func addMissingCatalog(storage Storage, catalog *Catalog) ([]CatalogChange, error) {
+	changes := make([]CatalogChange, 0)
+	services, err := storage.ListServiceDefinitions(true)
+	if err != nil {
+		return nil, err
+	}
+	plans, err := storage.ListPlanDefinitions("", true)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool)
+	for _, service := range services {
+		existing[service.Id] = true
+	}
+	for _, plan := range plans {
+		existing[plan.Id] = true
+	}
+	for _, service := range catalog.Services {
+		if !existing[service.Id] {
+			changes = append(changes, CatalogChange{Action: "add", Kind: "service", Id: service.Id, Name: service.Name})
+			if err := storage.AddService(&service); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, plan := range catalog.Plans {
+		if !existing[plan.Id] {
+			changes = append(changes, CatalogChange{Action: "add", Kind: "plan", Id: plan.Id, Name: plan.Name})
+			if err := storage.AddPlan(&plan); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return changes, nil
+}
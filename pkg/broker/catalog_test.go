@@ -0,0 +1,227 @@
+package broker
+
+import (
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"strings"
+	"testing"
+)
+
+func TestCatalogValidation(t *testing.T) {
+	Convey("Given the default catalog.", t, func() {
+		catalog, err := defaultCatalog(true)
+		So(err, ShouldBeNil)
+		So(len(catalog.Plans), ShouldBeGreaterThan, 20)
+
+		Convey("Ensure every plan's provider private details are valid", func() {
+			for _, plan := range catalog.Plans {
+				provider := GetProvidersFromString(plan.Provider)
+				_, engine, err := providerSettings(provider)
+				So(err, ShouldBeNil)
+				So(ValidateProviderPrivateDetails(provider, engine, string(plan.ProviderPrivateDetails)), ShouldBeNil)
+			}
+		})
+	})
+
+	Convey("Given a new plan.", t, func() {
+		plan := NewPlanDefinition()
+		plan.Service = "0a8d668d-2971-4533-8e38-a816a7c01bec"
+		plan.Name = "ephemeral-9"
+		plan.HumanName = "Ephemeral-9 (6.0.7)"
+		plan.Description = "Redis 6.0.7 - 1xCPU 512MB Ram"
+		plan.Version = "6.0.7"
+		plan.Type = "redis"
+		plan.Scheme = "redis"
+		plan.Provider = "kubernetes-redis-instance"
+		plan.ProviderPrivateDetails = json.RawMessage(`{"size_in_megabytes":"512","version":"6.0.7","namespace":"cache"}`)
+
+		Convey("Ensure it is valid", func() {
+			So(ValidatePlanDefinition(&plan), ShouldBeNil)
+		})
+
+		Convey("Ensure misspelled provider settings are rejected", func() {
+			plan.ProviderPrivateDetails = json.RawMessage(`{"size_in_megabyte":"512","version":"6.0.7"}`)
+			So(ValidatePlanDefinition(&plan), ShouldNotBeNil)
+		})
+
+		Convey("Ensure provider settings of the wrong type are rejected", func() {
+			plan.ProviderPrivateDetails = json.RawMessage(`{"size_in_megabytes":512,"version":"6.0.7"}`)
+			So(ValidatePlanDefinition(&plan), ShouldNotBeNil)
+			plan.ProviderPrivateDetails = json.RawMessage(`{"size_in_megabytes":"512","version":"6.0.7","service_type":"ExternalName"}`)
+			So(ValidatePlanDefinition(&plan), ShouldNotBeNil)
+		})
+
+		Convey("Ensure a provider for another engine is rejected", func() {
+			plan.Provider = "aws-memcached-instance"
+			plan.ProviderPrivateDetails = json.RawMessage(`{"CacheNodeType":"cache.t2.micro","Engine":"memcached"}`)
+			So(ValidatePlanDefinition(&plan), ShouldNotBeNil)
+			plan.Type = "memcached"
+			So(ValidatePlanDefinition(&plan), ShouldBeNil)
+		})
+
+		Convey("Ensure attributes must be an object", func() {
+			plan.Attributes = json.RawMessage(`["ram"]`)
+			So(ValidatePlanDefinition(&plan), ShouldNotBeNil)
+			plan.Attributes = json.RawMessage(`null`)
+			So(ValidatePlanDefinition(&plan), ShouldNotBeNil)
+		})
+
+		Convey("Ensure unknown providers and invalid names are rejected", func() {
+			plan.Provider = "gcp-memorystore"
+			So(ValidatePlanDefinition(&plan), ShouldNotBeNil)
+			plan.Provider = "kubernetes-redis-instance"
+			plan.Name = "ephemeral 9"
+			So(ValidatePlanDefinition(&plan), ShouldNotBeNil)
+		})
+	})
+}
+
+// catalogTestStorage keeps services and plans in memory for testing catalog syncs.
+type catalogTestStorage struct {
+	Storage
+	services  []ServiceDefinition
+	plans     []PlanDefinition
+	instances map[string]int64
+}
+
+func (s *catalogTestStorage) ListServiceDefinitions(includeDeleted bool) ([]ServiceDefinition, error) {
+	return append([]ServiceDefinition{}, s.services...), nil
+}
+
+func (s *catalogTestStorage) AddService(service *ServiceDefinition) error {
+	s.services = append(s.services, *service)
+	return nil
+}
+
+func (s *catalogTestStorage) UpdateService(service *ServiceDefinition) error {
+	for i := range s.services {
+		if s.services[i].Id == service.Id {
+			s.services[i] = *service
+		}
+	}
+	return nil
+}
+
+func (s *catalogTestStorage) ListPlanDefinitions(serviceId string, includeDeleted bool) ([]PlanDefinition, error) {
+	return append([]PlanDefinition{}, s.plans...), nil
+}
+
+func (s *catalogTestStorage) AddPlan(plan *PlanDefinition) error {
+	s.plans = append(s.plans, *plan)
+	return nil
+}
+
+func (s *catalogTestStorage) UpdatePlan(plan *PlanDefinition) error {
+	for i := range s.plans {
+		if s.plans[i].Id == plan.Id {
+			s.plans[i] = *plan
+		}
+	}
+	return nil
+}
+
+func (s *catalogTestStorage) CountInstancesOnPlan(Id string) (int64, error) {
+	return s.instances[Id], nil
+}
+
+const testCatalog = `
+services:
+- id: 0a8d668d-2971-4533-8e38-a816a7c01bec
+  name: akkeris-redis
+  human_name: Akkeris Redis
+  description: Reliable and powerful Redis as a service.
+  plans:
+  - id: e9f28df0-552e-4fa6-be84-92fc88998ff5
+    name: ephemeral-0
+    human_name: Ephemeral-0 (6.0.7)
+    description: Redis 6.0.7 - 1xCPU 512MB Ram
+    version: 6.0.7
+    type: redis
+    scheme: redis
+    cost_cents: 500
+    preprovision: 2
+    attributes: {ram: 512MB, cpu: 1xCPU}
+    provider: kubernetes-redis-instance
+    provider_private_details:
+      size_in_megabytes: "512"
+      version: 6.0.7
+`
+
+func TestCatalogSync(t *testing.T) {
+	Convey("Given a catalog file.", t, func() {
+		catalog, err := ParseCatalog([]byte(testCatalog))
+		So(err, ShouldBeNil)
+		So(len(catalog.Services), ShouldEqual, 1)
+		So(len(catalog.Plans), ShouldEqual, 1)
+		So(catalog.Plans[0].Service, ShouldEqual, "0a8d668d-2971-4533-8e38-a816a7c01bec")
+		So(catalog.Plans[0].Preprovision, ShouldEqual, 2)
+		So(catalog.Plans[0].SupportsSharing, ShouldBeTrue)
+		So(catalog.Services[0].Categories, ShouldEqual, "Data Stores")
+
+		storage := &catalogTestStorage{instances: make(map[string]int64)}
+
+		Convey("Ensure a dry run changes nothing", func() {
+			changes, err := SyncCatalog(storage, catalog, true)
+			So(err, ShouldBeNil)
+			So(len(changes), ShouldEqual, 2)
+			So(changes[0].String(), ShouldEqual, "add service akkeris-redis (0a8d668d-2971-4533-8e38-a816a7c01bec)")
+			So(len(storage.services), ShouldEqual, 0)
+			So(len(storage.plans), ShouldEqual, 0)
+		})
+
+		Convey("Ensure new plans are added, changed plans updated and removed plans deprecated", func() {
+			_, err := SyncCatalog(storage, catalog, false)
+			So(err, ShouldBeNil)
+			So(len(storage.plans), ShouldEqual, 1)
+
+			changes, err := SyncCatalog(storage, catalog, false)
+			So(err, ShouldBeNil)
+			So(len(changes), ShouldEqual, 0)
+
+			// Formatting of the JSON fields is not a change
+			storage.plans[0].Attributes = json.RawMessage(`{"cpu":"1xCPU",  "ram":"512MB"}`)
+			changes, err = SyncCatalog(storage, catalog, false)
+			So(err, ShouldBeNil)
+			So(len(changes), ShouldEqual, 0)
+
+			catalog.Plans[0].CostCents = 600
+			catalog.Plans[0].ProviderPrivateDetails = json.RawMessage(`{"size_in_megabytes":"1024","version":"6.0.7"}`)
+			changes, err = SyncCatalog(storage, catalog, false)
+			So(err, ShouldBeNil)
+			So(len(changes), ShouldEqual, 1)
+			So(changes[0].Fields, ShouldResemble, []string{"cost_cents", "provider_private_details"})
+			So(storage.plans[0].CostCents, ShouldEqual, 600)
+
+			catalog.Plans = []PlanDefinition{}
+			changes, err = SyncCatalog(storage, catalog, false)
+			So(err, ShouldBeNil)
+			So(len(changes), ShouldEqual, 1)
+			So(changes[0].Action, ShouldEqual, "deprecate")
+			So(storage.plans[0].Deprecated, ShouldBeTrue)
+		})
+
+		Convey("Ensure the provider of a plan in use cannot change", func() {
+			_, err := SyncCatalog(storage, catalog, false)
+			So(err, ShouldBeNil)
+			storage.instances[catalog.Plans[0].Id] = 3
+			catalog.Plans[0].Provider = "kubernetes-redis-persistent"
+			_, err = SyncCatalog(storage, catalog, false)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Ensure plans without ids or with misspelled fields are rejected", func() {
+			_, err := ParseCatalog([]byte(strings.Replace(testCatalog, "  - id: e9f28df0-552e-4fa6-be84-92fc88998ff5\n    name:", "  - name:", 1)))
+			So(err, ShouldNotBeNil)
+			_, err = ParseCatalog([]byte(strings.Replace(testCatalog, "cost_cents", "cost_cent", 1)))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestCatalogExample(t *testing.T) {
+	Convey("Given the example catalog.", t, func() {
+		catalog, err := LoadCatalogFile("../../docs/catalog.example.yaml")
+		So(err, ShouldBeNil)
+		So(len(catalog.Plans), ShouldEqual, 2)
+	})
+}
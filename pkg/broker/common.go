@@ -4,15 +4,20 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	cryptorand "crypto/rand"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
+	"github.com/go-redis/redis"
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	_ "github.com/lib/pq"
 	osb "github.com/pmorie/go-open-service-broker-client/v2"
 	"github.com/pmorie/osb-broker-lib/pkg/broker"
+	"math/big"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -57,6 +62,41 @@ func RandomString(n int) string {
 	return string(b)
 }
 
+// RandomSecureString uses a cryptographically secure source and is intended for
+// passwords and auth tokens, use RandomString for names and identifiers.
+func RandomSecureString(n int) (string, error) {
+	b := make([]byte, n)
+	max := big.NewInt(int64(len(letterBytes)))
+	for i := range b {
+		idx, err := cryptorand.Int(cryptorand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = letterBytes[idx.Int64()]
+	}
+	return string(b), nil
+}
+
+func redisUrl(instance *Instance, endpoint string) string {
+	u := url.URL{Scheme: instance.Scheme, Host: endpoint}
+	if instance.Password != "" {
+		u.User = url.UserPassword(instance.Username, instance.Password)
+	}
+	return u.String()
+}
+
+func redisOptions(instance *Instance) *redis.Options {
+	options := redis.Options{
+		Addr:     instance.Endpoint,
+		Password: instance.Password,
+		DB:       0,
+	}
+	if instance.Scheme == "rediss" {
+		options.TLSConfig = &tls.Config{}
+	}
+	return &options
+}
+
 func truePtr() *bool {
 	b := true
 	return &b
@@ -78,6 +118,29 @@ func HttpWrite(w http.ResponseWriter, status int, obj interface{}) {
 	w.Write(data)
 }
 
+// HttpWriteError writes the error as the OSB api does, errors that are not http errors are
+// reported as an internal server error.
+func HttpWriteError(w http.ResponseWriter, err error) {
+	type e struct {
+		ErrorMessage *string `json:"error,omitempty"`
+		Description  *string `json:"description,omitempty"`
+	}
+	if httpErr, ok := osb.IsHTTPError(err); ok {
+		body := &e{}
+		if httpErr.Description != nil {
+			body.Description = httpErr.Description
+		}
+		if httpErr.ErrorMessage != nil {
+			body.ErrorMessage = httpErr.ErrorMessage
+		}
+		HttpWrite(w, httpErr.StatusCode, body)
+		return
+	}
+	msg := "InternalServerError"
+	description := "Internal Server Error"
+	HttpWrite(w, 500, &e{ErrorMessage: &msg, Description: &description})
+}
+
 func InternalServerError() error {
 	description := "Internal Server Error"
 	return osb.HTTPStatusCodeError{
@@ -109,6 +172,14 @@ func UnprocessableEntity() error {
 	}
 }
 
+func Gone() error {
+	description := "Gone"
+	return osb.HTTPStatusCodeError{
+		StatusCode:  http.StatusGone,
+		Description: &description,
+	}
+}
+
 func NotFound() error {
 	description := "Not Found"
 	return osb.HTTPStatusCodeError{
@@ -136,10 +207,26 @@ func InitFromOptions(ctx context.Context, o Options) (Storage, string, error) {
 	if o.NamePrefix == "" {
 		return nil, "", errors.New("The name prefix was not specified, set NAME_PREFIX in your environment or provide it via the cli using -name-prefix")
 	}
-	storage, err := InitStorage(ctx, o)
+	storage, err := NewStorage(ctx, o)
 	return storage, o.NamePrefix, err
 }
 
+// NewStorage opens the storage the database url points to, postgres unless it starts with
+// memory:// in which case everything is kept in memory.
+func NewStorage(ctx context.Context, o Options) (Storage, error) {
+	if o.DatabaseUrl == "" && os.Getenv("DATABASE_URL") != "" {
+		o.DatabaseUrl = os.Getenv("DATABASE_URL")
+	}
+	if strings.HasPrefix(o.DatabaseUrl, memoryDatabaseScheme) {
+		return InitMemoryStorage(ctx, o)
+	}
+	storage, err := InitStorage(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	return storage, nil
+}
+
 func (b *ActionBase) ActionSchemaHandler(w http.ResponseWriter, r *http.Request) {
 	v := mux.Vars(r)
 	instance_id := v["instance_id"]
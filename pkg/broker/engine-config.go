@@ -0,0 +1,147 @@
+package broker
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Users may change a small set of engine settings on their own instances without changing
+// plans. On AWS the settings are kept in a parameter group for each instance, on kubernetes
+// they are given to redis-server or memcached as arguments. The settings are passed as the
+// "config" parameter when provisioning or updating, or with the config action.
+const engineConfigParameter = "config"
+
+var redisConfigAllowList = map[string]*regexp.Regexp{
+	"maxmemory-policy":         regexp.MustCompile(`^(volatile-lru|allkeys-lru|volatile-lfu|allkeys-lfu|volatile-random|allkeys-random|volatile-ttl|noeviction)$`),
+	"maxmemory-samples":        regexp.MustCompile(`^[0-9]+$`),
+	"notify-keyspace-events":   regexp.MustCompile(`^[KEg$lshzxetmdA]*$`),
+	"timeout":                  regexp.MustCompile(`^[0-9]+$`),
+	"tcp-keepalive":            regexp.MustCompile(`^[0-9]+$`),
+	"slowlog-log-slower-than":  regexp.MustCompile(`^-?[0-9]+$`),
+	"slowlog-max-len":          regexp.MustCompile(`^[0-9]+$`),
+	"lfu-log-factor":           regexp.MustCompile(`^[0-9]+$`),
+	"lfu-decay-time":           regexp.MustCompile(`^[0-9]+$`),
+	"lazyfree-lazy-eviction":   regexp.MustCompile(`^(yes|no)$`),
+	"lazyfree-lazy-expire":     regexp.MustCompile(`^(yes|no)$`),
+	"lazyfree-lazy-server-del": regexp.MustCompile(`^(yes|no)$`),
+}
+
+var memcachedConfigAllowList = map[string]*regexp.Regexp{
+	"max_item_size":            regexp.MustCompile(`^[0-9]+$`),
+	"chunk_size":               regexp.MustCompile(`^[0-9]+$`),
+	"chunk_size_growth_factor": regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`),
+	"idle_timeout":             regexp.MustCompile(`^[0-9]+$`),
+}
+
+func engineConfigAllowList(engine string) map[string]*regexp.Regexp {
+	if engine == "memcached" {
+		return memcachedConfigAllowList
+	}
+	return redisConfigAllowList
+}
+
+// EngineConfigNames lists the settings users may change on an engine.
+func EngineConfigNames(engine string) []string {
+	names := make([]string, 0)
+	for name := range engineConfigAllowList(engine) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// MergeEngineConfig checks the changes against the settings allowed on the engine and
+// applies them to the current settings, a setting given as null is reset to its default.
+func MergeEngineConfig(engine string, current map[string]string, changes map[string]interface{}) (map[string]string, error) {
+	allowed := engineConfigAllowList(engine)
+	config := make(map[string]string)
+	for name, value := range current {
+		config[name] = value
+	}
+	names := make([]string, 0)
+	for name := range changes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pattern, ok := allowed[name]
+		if !ok {
+			return nil, errors.New("The setting " + name + " cannot be changed on " + engine + ", allowed settings are " + strings.Join(EngineConfigNames(engine), ", ") + ".")
+		}
+		var value string
+		switch v := changes[name].(type) {
+		case nil:
+			delete(config, name)
+			continue
+		case string:
+			value = v
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			value = "no"
+			if v {
+				value = "yes"
+			}
+		default:
+			return nil, errors.New("The setting " + name + " must be a string or a number.")
+		}
+		if !pattern.MatchString(value) {
+			return nil, errors.New("The value " + value + " is not valid for the setting " + name + ".")
+		}
+		config[name] = value
+	}
+	return config, nil
+}
+
+// engineConfigFromParameters separates the engine settings from the other parameters.
+func engineConfigFromParameters(parameters map[string]interface{}) (map[string]interface{}, map[string]interface{}, error) {
+	value, ok := parameters[engineConfigParameter]
+	if !ok {
+		return nil, parameters, nil
+	}
+	config, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, nil, errors.New("The parameter config must be an object of engine settings.")
+	}
+	others := make(map[string]interface{})
+	for name, value := range parameters {
+		if name != engineConfigParameter {
+			others[name] = value
+		}
+	}
+	return config, others, nil
+}
+
+// WithConfig returns a copy of a kubernetes plan with the engine settings added to the
+// plan's own, the AWS providers use a parameter group for the instance instead.
+func (plan *ProviderPlan) WithConfig(config map[string]string) (*ProviderPlan, error) {
+	if len(config) == 0 || !strings.HasPrefix(string(plan.Provider), "kubernetes-") {
+		return plan, nil
+	}
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		settings = make(map[string]interface{})
+	}
+	merged, ok := settings["config"].(map[string]interface{})
+	if !ok {
+		merged = make(map[string]interface{})
+	}
+	for name, value := range config {
+		merged[name] = value
+	}
+	settings["config"] = merged
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	copied := *plan
+	copied.providerPrivateDetails = string(data)
+	return &copied, nil
+}
@@ -0,0 +1,113 @@
+package broker
+
+import (
+	"context"
+	. "github.com/smartystreets/goconvey/convey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"os"
+	"testing"
+)
+
+func TestEngineConfig(t *testing.T) {
+	Convey("Given changes to the engine settings.", t, func() {
+		Convey("Ensure allowed settings are merged and null resets them", func() {
+			config, err := MergeEngineConfig("redis", map[string]string{"timeout": "300", "maxmemory-policy": "noeviction"}, map[string]interface{}{
+				"maxmemory-policy":       "allkeys-lru",
+				"notify-keyspace-events": "Ex",
+				"timeout":                nil,
+				"tcp-keepalive":          float64(60),
+				"lazyfree-lazy-eviction": true,
+			})
+			So(err, ShouldBeNil)
+			So(config, ShouldResemble, map[string]string{"maxmemory-policy": "allkeys-lru", "notify-keyspace-events": "Ex", "tcp-keepalive": "60", "lazyfree-lazy-eviction": "yes"})
+		})
+
+		Convey("Ensure settings that are not allowed or invalid are rejected", func() {
+			_, err := MergeEngineConfig("redis", nil, map[string]interface{}{"appendonly": "yes"})
+			So(err, ShouldNotBeNil)
+			_, err = MergeEngineConfig("redis", nil, map[string]interface{}{"maxmemory-policy": "lru"})
+			So(err, ShouldNotBeNil)
+			_, err = MergeEngineConfig("redis", nil, map[string]interface{}{"timeout": float64(-1)})
+			So(err, ShouldNotBeNil)
+			_, err = MergeEngineConfig("memcached", nil, map[string]interface{}{"maxmemory-policy": "allkeys-lru"})
+			So(err, ShouldNotBeNil)
+			config, err := MergeEngineConfig("memcached", nil, map[string]interface{}{"chunk_size_growth_factor": float64(1.5)})
+			So(err, ShouldBeNil)
+			So(config["chunk_size_growth_factor"], ShouldEqual, "1.5")
+		})
+
+		Convey("Ensure the config parameter is separated from the others", func() {
+			config, others, err := engineConfigFromParameters(map[string]interface{}{"config": map[string]interface{}{"timeout": "300"}, "version": "6.0.7"})
+			So(err, ShouldBeNil)
+			So(config, ShouldResemble, map[string]interface{}{"timeout": "300"})
+			So(others, ShouldResemble, map[string]interface{}{"version": "6.0.7"})
+			_, _, err = engineConfigFromParameters(map[string]interface{}{"config": "timeout 300"})
+			So(err, ShouldNotBeNil)
+			_, err = ParseParametersSchema(`{"properties":{"config":{"type":"string"}}}`)
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given an instance with engine settings on AWS.", t, func() {
+		instance := &Instance{Name: "testabcdefgh", Engine: "redis", EngineVersion: "5.0.4"}
+
+		Convey("Ensure the parameter group family and name follow the engine version", func() {
+			So(parameterGroupFamily("redis", "5.0.4"), ShouldEqual, "redis5.0")
+			So(parameterGroupFamily("redis", "6.0.5"), ShouldEqual, "redis6.x")
+			So(parameterGroupFamily("redis", "7.0.7"), ShouldEqual, "redis7")
+			So(parameterGroupFamily("memcached", "1.5.16"), ShouldEqual, "memcached1.5")
+			So(parameterGroupName(instance, "redis5.0"), ShouldEqual, "testabcdefgh-redis5-0")
+		})
+
+		Convey("Ensure the plan is left alone", func() {
+			plan := &ProviderPlan{Provider: AWSRedisInstance, providerPrivateDetails: `{"CacheNodeType":"cache.t2.micro"}`}
+			modified, err := plan.WithConfig(map[string]string{"timeout": "300"})
+			So(err, ShouldBeNil)
+			So(modified.providerPrivateDetails, ShouldEqual, plan.providerPrivateDetails)
+		})
+	})
+
+	Convey("Given an instance with engine settings on kubernetes.", t, func() {
+		os.Setenv("TEST", "true")
+		config := map[string]string{"maxmemory-policy": "allkeys-lru", "timeout": "300"}
+
+		Convey("Ensure the settings are added to the plan's own", func() {
+			plan := &ProviderPlan{Provider: KubernetesRedisInstance, providerPrivateDetails: `{"size_in_megabytes":"512","version":"5.0.4","config":{"timeout":"0","appendonly":"no"}}`}
+			modified, err := plan.WithConfig(config)
+			So(err, ShouldBeNil)
+			So(modified.providerPrivateDetails, ShouldEqual, `{"config":{"appendonly":"no","maxmemory-policy":"allkeys-lru","timeout":"300"},"size_in_megabytes":"512","version":"5.0.4"}`)
+		})
+
+		Convey("Ensure redis is given the settings as arguments", func() {
+			provider, err := NewKubernetesInstanceRedisProvider(context.TODO(), "test")
+			So(err, ShouldBeNil)
+			plan := &ProviderPlan{ID: "config-redis", Provider: KubernetesRedisInstance, providerPrivateDetails: `{"size_in_megabytes":"512","version":"5.0.4","namespace":"config-test"}`}
+			instance, err := provider.Provision("config-redis", plan, "owner")
+			So(err, ShouldBeNil)
+
+			instance.Plan, err = plan.WithConfig(config)
+			So(err, ShouldBeNil)
+			So(provider.UpdateConfig(instance), ShouldBeNil)
+			deployment, err := provider.kubernetes.AppsV1().Deployments("config-test").Get(instance.Name, metav1.GetOptions{})
+			So(err, ShouldBeNil)
+			So(deployment.Spec.Template.Spec.Containers[0].Args, ShouldResemble, []string{"--maxmemory-policy", "allkeys-lru", "--timeout", "300"})
+			So(provider.Deprovision(instance, false), ShouldBeNil)
+		})
+
+		Convey("Ensure memcached is given the settings as arguments", func() {
+			provider, err := NewKubernetesInstanceMemcachedProvider(context.TODO(), "test")
+			So(err, ShouldBeNil)
+			plan := &ProviderPlan{ID: "config-memcached", Provider: KubernetesMemcachedInstance, providerPrivateDetails: `{"size_in_megabytes":"256","version":"1.5","namespace":"config-test"}`}
+			instance, err := provider.Provision("config-memcached", plan, "owner")
+			So(err, ShouldBeNil)
+
+			instance.Plan, err = plan.WithConfig(map[string]string{"max_item_size": "1048576", "idle_timeout": "600"})
+			So(err, ShouldBeNil)
+			So(provider.UpdateConfig(instance), ShouldBeNil)
+			deployment, err := provider.kubernetes.AppsV1().Deployments("config-test").Get(instance.Name, metav1.GetOptions{})
+			So(err, ShouldBeNil)
+			So(deployment.Spec.Template.Spec.Containers[0].Args, ShouldResemble, []string{"-m 256", "-I 1048576", "-p 11211", "-o idle_timeout=600"})
+			So(provider.Deprovision(instance, false), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,124 @@
+package broker
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Instances start on the engine version of their plan and may be upgraded to a newer one
+// the provider offers. The version an instance was upgraded to is kept with the instance
+// and replaces the plan's own, changing plans never moves an instance to an older version.
+
+// compareEngineVersions compares two dotted engine versions part by part, parts that are
+// not numbers (e.g., the x of redis 6.x) are treated as zero.
+func compareEngineVersions(a string, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bPart, _ = strconv.Atoi(bParts[i])
+		}
+		if aPart < bPart {
+			return -1
+		} else if aPart > bPart {
+			return 1
+		}
+	}
+	return 0
+}
+
+// upgradableEngineVersions returns the versions newer than the current one, oldest first.
+func upgradableEngineVersions(current string, available []string) []string {
+	versions := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, version := range available {
+		if version != "" && !seen[version] && compareEngineVersions(version, current) > 0 {
+			seen[version] = true
+			versions = append(versions, version)
+		}
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return compareEngineVersions(versions[i], versions[j]) < 0
+	})
+	return versions
+}
+
+// ValidateEngineUpgrade checks the version is one the instance can be upgraded to.
+func ValidateEngineUpgrade(current string, version string, versions []string) error {
+	if version == "" {
+		return errors.New("The version to upgrade to was not provided.")
+	}
+	if compareEngineVersions(version, current) <= 0 {
+		return errors.New("The version " + version + " is not newer than the current version " + current + ", engines cannot be downgraded.")
+	}
+	for _, allowed := range versions {
+		if allowed == version {
+			return nil
+		}
+	}
+	if len(versions) == 0 {
+		return errors.New("There are no versions this instance can be upgraded to.")
+	}
+	return errors.New("The version " + version + " is not available, this instance can be upgraded to " + strings.Join(versions, ", ") + ".")
+}
+
+// engineVersionSetting is the name of the plan setting holding the engine version.
+func engineVersionSetting(plan *ProviderPlan) string {
+	if strings.HasPrefix(string(plan.Provider), "kubernetes-") {
+		return "version"
+	}
+	return "EngineVersion"
+}
+
+// planEngineVersion is the engine version the plan provisions instances with.
+func planEngineVersion(plan *ProviderPlan) (string, error) {
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return "", err
+	}
+	version, _ := settings[engineVersionSetting(plan)].(string)
+	return version, nil
+}
+
+// WithEngineVersion returns a copy of the plan using the engine version given rather than
+// its own.
+func (plan *ProviderPlan) WithEngineVersion(version string) (*ProviderPlan, error) {
+	if version == "" {
+		return plan, nil
+	}
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		settings = make(map[string]interface{})
+	}
+	settings[engineVersionSetting(plan)] = version
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	copied := *plan
+	copied.providerPrivateDetails = string(data)
+	return &copied, nil
+}
+
+// planKeepingEngineVersion moves an instance's engine version to the plan it is changing
+// to when the plan's own version is older.
+func planKeepingEngineVersion(plan *ProviderPlan, version string) (*ProviderPlan, error) {
+	planVersion, err := planEngineVersion(plan)
+	if err != nil {
+		return nil, err
+	}
+	if version == "" || compareEngineVersions(version, planVersion) <= 0 {
+		return plan, nil
+	}
+	return plan.WithEngineVersion(version)
+}
@@ -0,0 +1,97 @@
+package broker
+
+import (
+	"context"
+	. "github.com/smartystreets/goconvey/convey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"os"
+	"testing"
+)
+
+func TestEngineVersions(t *testing.T) {
+	Convey("Given the versions an engine is offered in.", t, func() {
+		available := []string{"6.x", "5.0.6", "4.0.10", "5.0.4", "5.0.10", "5.0.6", "6.2.6"}
+
+		Convey("Ensure versions are compared part by part", func() {
+			So(compareEngineVersions("5.0.10", "5.0.6"), ShouldEqual, 1)
+			So(compareEngineVersions("5.0", "5.0.0"), ShouldEqual, 0)
+			So(compareEngineVersions("6.x", "6.2.6"), ShouldEqual, -1)
+			So(compareEngineVersions("1.5.10", "1.6"), ShouldEqual, -1)
+		})
+
+		Convey("Ensure only newer versions are offered, oldest first", func() {
+			So(upgradableEngineVersions("5.0.4", available), ShouldResemble, []string{"5.0.6", "5.0.10", "6.x", "6.2.6"})
+			So(upgradableEngineVersions("6.2.6", available), ShouldResemble, []string{})
+			So(redisEngineVersions("5.0.4", available), ShouldResemble, []string{"5.0.6", "5.0.10"})
+		})
+
+		Convey("Ensure upgrades are validated", func() {
+			versions := upgradableEngineVersions("5.0.4", available)
+			So(ValidateEngineUpgrade("5.0.4", "5.0.10", versions), ShouldBeNil)
+			So(ValidateEngineUpgrade("5.0.4", "", versions), ShouldNotBeNil)
+			So(ValidateEngineUpgrade("5.0.4", "4.0.10", versions), ShouldNotBeNil)
+			So(ValidateEngineUpgrade("5.0.4", "5.0.4", versions), ShouldNotBeNil)
+			So(ValidateEngineUpgrade("5.0.4", "5.0.8", versions), ShouldNotBeNil)
+			So(ValidateEngineUpgrade("6.2.6", "7.0.7", []string{}), ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given an instance that was upgraded.", t, func() {
+		Convey("Ensure the version replaces the plan's own", func() {
+			plan := &ProviderPlan{Provider: AWSRedisInstance, providerPrivateDetails: `{"CacheNodeType":"cache.t2.micro","EngineVersion":"5.0.4"}`}
+			upgraded, err := plan.WithEngineVersion("5.0.6")
+			So(err, ShouldBeNil)
+			So(upgraded.providerPrivateDetails, ShouldEqual, `{"CacheNodeType":"cache.t2.micro","EngineVersion":"5.0.6"}`)
+			version, err := planEngineVersion(upgraded)
+			So(err, ShouldBeNil)
+			So(version, ShouldEqual, "5.0.6")
+
+			plan = &ProviderPlan{Provider: KubernetesRedisInstance, providerPrivateDetails: `{"size_in_megabytes":"512","version":"5.0.4"}`}
+			upgraded, err = plan.WithEngineVersion("5.0.6")
+			So(err, ShouldBeNil)
+			So(upgraded.providerPrivateDetails, ShouldEqual, `{"size_in_megabytes":"512","version":"5.0.6"}`)
+		})
+
+		Convey("Ensure changing plans does not move it to an older version", func() {
+			plan := &ProviderPlan{Provider: KubernetesRedisPersistent, providerPrivateDetails: `{"size_in_megabytes":"1024","version":"5.0.4"}`}
+			kept, err := planKeepingEngineVersion(plan, "5.0.6")
+			So(err, ShouldBeNil)
+			So(kept.providerPrivateDetails, ShouldEqual, `{"size_in_megabytes":"1024","version":"5.0.6"}`)
+			kept, err = planKeepingEngineVersion(plan, "4.0.10")
+			So(err, ShouldBeNil)
+			So(kept, ShouldEqual, plan)
+		})
+	})
+
+	Convey("Given allowed image tags on kubernetes.", t, func() {
+		os.Setenv("TEST", "true")
+		os.Setenv("KUBERNETES_REDIS_VERSIONS", "5.0.6, 5.0.9,6.0.7")
+		defer os.Unsetenv("KUBERNETES_REDIS_VERSIONS")
+
+		Convey("Ensure the plan's tags are used over the broker's", func() {
+			kube, err := GetKubernetesSettings(nil, "redis")
+			So(err, ShouldBeNil)
+			So(kube.AllowedVersions, ShouldResemble, []string{"5.0.6", "5.0.9", "6.0.7"})
+			kube, err = GetKubernetesSettings(&ProviderPlan{providerPrivateDetails: `{"allowed_versions":["5.0.14"]}`}, "redis")
+			So(err, ShouldBeNil)
+			So(kube.AllowedVersions, ShouldResemble, []string{"5.0.14"})
+		})
+
+		Convey("Ensure redis is upgraded to an allowed tag", func() {
+			provider, err := NewKubernetesInstanceRedisProvider(context.TODO(), "test")
+			So(err, ShouldBeNil)
+			plan := &ProviderPlan{ID: "version-redis", Provider: KubernetesRedisInstance, providerPrivateDetails: `{"size_in_megabytes":"512","version":"5.0.4","namespace":"version-test"}`}
+			instance, err := provider.Provision("version-redis", plan, "owner")
+			So(err, ShouldBeNil)
+
+			versions, err := provider.EngineVersions(instance)
+			So(err, ShouldBeNil)
+			So(versions, ShouldResemble, []string{"5.0.6", "5.0.9"})
+			So(provider.UpgradeEngine(instance, "5.0.9"), ShouldBeNil)
+			deployment, err := provider.kubernetes.AppsV1().Deployments("version-test").Get(instance.Name, metav1.GetOptions{})
+			So(err, ShouldBeNil)
+			So(deployment.Spec.Template.Spec.Containers[0].Image, ShouldEqual, "redis:5.0.9")
+			So(provider.Deprovision(instance, false), ShouldBeNil)
+		})
+	})
+}
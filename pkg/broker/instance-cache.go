@@ -0,0 +1,131 @@
+package broker
+
+import (
+	"sync"
+	"time"
+)
+
+// instanceCacheTTL is how long providers keep the status of an instance before asking for
+// it again.
+var instanceCacheTTL = time.Second * 5
+
+type instanceCacheEntry struct {
+	instance *Instance
+	expires  time.Time
+}
+
+// InstanceCache keeps what providers last found out about their instances, keyed by the
+// instance name, for a short time so requests and tasks asking about the same instance do
+// not each call the provider's api. It is safe to use from many goroutines, callers get
+// their own copy of a cached instance. Anything that changes an instance must invalidate it
+// so the next status is read from the provider.
+type InstanceCache struct {
+	lock        sync.Mutex
+	refreshLock sync.Mutex
+	ttl         time.Duration
+	entries     map[string]instanceCacheEntry
+	invalidated map[string]time.Time
+	refreshed   time.Time
+}
+
+func NewInstanceCache(ttl time.Duration) *InstanceCache {
+	return &InstanceCache{
+		ttl:         ttl,
+		entries:     make(map[string]instanceCacheEntry),
+		invalidated: make(map[string]time.Time),
+	}
+}
+
+func copyInstance(instance *Instance) *Instance {
+	copied := *instance
+	return &copied
+}
+
+// Get returns a copy of the cached instance with the plan it is asked for. Instances cached
+// with a plan are only returned for that plan, instances cached without one (e.g., from a
+// refresh) are returned for any plan.
+func (cache *InstanceCache) Get(name string, plan *ProviderPlan) (*Instance, bool) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	entry, ok := cache.entries[name]
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(entry.expires) {
+		delete(cache.entries, name)
+		return nil, false
+	}
+	if entry.instance.Plan != nil && plan != nil && entry.instance.Plan.ID != plan.ID {
+		return nil, false
+	}
+	instance := copyInstance(entry.instance)
+	instance.Plan = plan
+	return instance, true
+}
+
+// Set caches a copy of the instance until the ttl passes.
+func (cache *InstanceCache) Set(name string, instance *Instance) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.prune()
+	cache.entries[name] = instanceCacheEntry{instance: copyInstance(instance), expires: time.Now().Add(cache.ttl)}
+}
+
+// Invalidate drops the cached instances, including those a refresh that is running has
+// yet to cache.
+func (cache *InstanceCache) Invalidate(names ...string) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	now := time.Now()
+	for _, name := range names {
+		delete(cache.entries, name)
+		cache.invalidated[name] = now
+	}
+}
+
+// Refresh caches every instance returned by list, so instances asked for one after another
+// (e.g., by tasks) are found with one call to the provider. The list is asked for at most
+// once a ttl, goroutines refreshing at the same time wait for the one already running.
+func (cache *InstanceCache) Refresh(list func() (map[string]*Instance, error)) error {
+	cache.refreshLock.Lock()
+	defer cache.refreshLock.Unlock()
+	cache.lock.Lock()
+	fresh := time.Since(cache.refreshed) < cache.ttl
+	cache.lock.Unlock()
+	if fresh {
+		return nil
+	}
+	started := time.Now()
+	instances, err := list()
+	if err != nil {
+		return err
+	}
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.prune()
+	expires := time.Now().Add(cache.ttl)
+	for name, instance := range instances {
+		if invalidated, ok := cache.invalidated[name]; ok && !invalidated.Before(started) {
+			continue
+		}
+		cache.entries[name] = instanceCacheEntry{instance: copyInstance(instance), expires: expires}
+	}
+	cache.refreshed = started
+	return nil
+}
+
+// prune drops expired entries and invalidations no refresh can still be running from,
+// the lock must be held.
+func (cache *InstanceCache) prune() {
+	now := time.Now()
+	for name, entry := range cache.entries {
+		if !now.Before(entry.expires) {
+			delete(cache.entries, name)
+		}
+	}
+	for name, invalidated := range cache.invalidated {
+		if invalidated.Before(cache.refreshed) {
+			delete(cache.invalidated, name)
+		}
+	}
+}
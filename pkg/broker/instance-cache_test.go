@@ -0,0 +1,197 @@
+package broker
+
+import (
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	. "github.com/smartystreets/goconvey/convey"
+	"sync"
+	"testing"
+	"time"
+)
+
+type countingElastiCache struct {
+	*FakeElastiCache
+	lock      sync.Mutex
+	describes int
+}
+
+func (c *countingElastiCache) DescribeCacheClusters(input *elasticache.DescribeCacheClustersInput) (*elasticache.DescribeCacheClustersOutput, error) {
+	c.lock.Lock()
+	c.describes++
+	c.lock.Unlock()
+	return c.FakeElastiCache.DescribeCacheClusters(input)
+}
+
+func (c *countingElastiCache) DescribeCacheClustersPages(input *elasticache.DescribeCacheClustersInput, fn func(*elasticache.DescribeCacheClustersOutput, bool) bool) error {
+	page, err := c.DescribeCacheClusters(input)
+	if err != nil {
+		return err
+	}
+	fn(page, true)
+	return nil
+}
+
+func TestInstanceCache(t *testing.T) {
+	hobby := &ProviderPlan{ID: "hobby", Scheme: "redis"}
+	standard := &ProviderPlan{ID: "standard", Scheme: "redis"}
+
+	Convey("Given an instance cache.", t, func() {
+		cache := NewInstanceCache(time.Minute)
+
+		Convey("Ensure cached instances are copies for the plan they were cached with", func() {
+			instance := &Instance{Name: "test1", Plan: hobby, Status: "available"}
+			cache.Set("test1", instance)
+			instance.Status = "modifying"
+
+			cached, ok := cache.Get("test1", hobby)
+			So(ok, ShouldBeTrue)
+			So(cached.Status, ShouldEqual, "available")
+			cached.Status = "deleting"
+			cached, ok = cache.Get("test1", hobby)
+			So(ok, ShouldBeTrue)
+			So(cached.Status, ShouldEqual, "available")
+
+			_, ok = cache.Get("test1", standard)
+			So(ok, ShouldBeFalse)
+			_, ok = cache.Get("test2", hobby)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Ensure instances cached without a plan are returned for any plan", func() {
+			cache.Set("test1", &Instance{Name: "test1", Status: "available"})
+			cached, ok := cache.Get("test1", standard)
+			So(ok, ShouldBeTrue)
+			So(cached.Plan, ShouldEqual, standard)
+		})
+
+		Convey("Ensure instances expire after the ttl", func() {
+			cache = NewInstanceCache(time.Millisecond * 10)
+			cache.Set("test1", &Instance{Name: "test1", Plan: hobby})
+			_, ok := cache.Get("test1", hobby)
+			So(ok, ShouldBeTrue)
+			time.Sleep(time.Millisecond * 20)
+			_, ok = cache.Get("test1", hobby)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Ensure invalidated instances are no longer cached", func() {
+			cache.Set("test1", &Instance{Name: "test1", Plan: hobby})
+			cache.Set("test2", &Instance{Name: "test2", Plan: hobby})
+			cache.Invalidate("test1")
+			_, ok := cache.Get("test1", hobby)
+			So(ok, ShouldBeFalse)
+			_, ok = cache.Get("test2", hobby)
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey("Ensure refreshes list the instances once a ttl", func() {
+			var lists int
+			list := func() (map[string]*Instance, error) {
+				lists++
+				return map[string]*Instance{"test1": {Name: "test1"}, "test2": {Name: "test2"}}, nil
+			}
+			So(cache.Refresh(list), ShouldBeNil)
+			So(cache.Refresh(list), ShouldBeNil)
+			So(lists, ShouldEqual, 1)
+			_, ok := cache.Get("test1", hobby)
+			So(ok, ShouldBeTrue)
+			_, ok = cache.Get("test2", standard)
+			So(ok, ShouldBeTrue)
+
+			err := NewInstanceCache(time.Minute).Refresh(func() (map[string]*Instance, error) {
+				return nil, errors.New("Unable to list instances.")
+			})
+			So(err.Error(), ShouldEqual, "Unable to list instances.")
+		})
+
+		Convey("Ensure instances invalidated during a refresh are not cached by it", func() {
+			So(cache.Refresh(func() (map[string]*Instance, error) {
+				cache.Invalidate("test1")
+				return map[string]*Instance{"test1": {Name: "test1"}, "test2": {Name: "test2"}}, nil
+			}), ShouldBeNil)
+			_, ok := cache.Get("test1", hobby)
+			So(ok, ShouldBeFalse)
+			_, ok = cache.Get("test2", hobby)
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey("Ensure the cache can be used from many goroutines", func() {
+			var wait sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wait.Add(1)
+				go (func(i int) {
+					defer wait.Done()
+					name := fmt.Sprintf("test%d", i%3)
+					for j := 0; j < 100; j++ {
+						cache.Set(name, &Instance{Name: name, Plan: hobby})
+						if instance, ok := cache.Get(name, hobby); ok {
+							instance.Status = "available"
+						}
+						cache.Invalidate(name)
+						cache.Refresh(func() (map[string]*Instance, error) {
+							return map[string]*Instance{name: {Name: name}}, nil
+						})
+					}
+				})(i)
+			}
+			wait.Wait()
+		})
+	})
+
+	Convey("Given cache clusters on a fake elasticache.", t, func() {
+		svc := &countingElastiCache{FakeElastiCache: NewFakeElastiCache()}
+		for _, name := range []string{"test1", "test2", "test3"} {
+			_, err := svc.CreateCacheCluster(&elasticache.CreateCacheClusterInput{
+				CacheClusterId: aws.String(name),
+				CacheNodeType:  aws.String("cache.t2.micro"),
+				Engine:         aws.String("redis"),
+				EngineVersion:  aws.String("5.0.6"),
+				NumCacheNodes:  aws.Int64(1),
+			})
+			So(err, ShouldBeNil)
+		}
+		_, err := svc.CreateCacheCluster(&elasticache.CreateCacheClusterInput{
+			CacheClusterId: aws.String("test4"),
+			CacheNodeType:  aws.String("cache.t2.micro"),
+			Engine:         aws.String("memcached"),
+			EngineVersion:  aws.String("1.6.6"),
+			NumCacheNodes:  aws.Int64(1),
+		})
+		So(err, ShouldBeNil)
+		cache := NewInstanceCache(time.Minute)
+
+		Convey("Ensure the cache clusters are described all at once", func() {
+			for _, name := range []string{"test1", "test2", "test3"} {
+				instance, err := getCacheClusterInstance(svc, cache, "test", "redis", name, hobby)
+				So(err, ShouldBeNil)
+				So(instance.Name, ShouldEqual, name)
+				So(instance.Plan, ShouldEqual, hobby)
+				So(instance.Scheme, ShouldEqual, "redis")
+				So(instance.Status, ShouldEqual, "creating")
+			}
+			So(svc.describes, ShouldEqual, 1)
+
+			// Other engines are not cached, so they are described on their own.
+			instance, err := getCacheClusterInstance(svc, cache, "test", "redis", "test4", hobby)
+			So(err, ShouldBeNil)
+			So(instance.Engine, ShouldEqual, "memcached")
+			So(svc.describes, ShouldEqual, 2)
+		})
+
+		Convey("Ensure invalidated cache clusters are described again", func() {
+			_, err := getCacheClusterInstance(svc, cache, "test", "redis", "test1", hobby)
+			So(err, ShouldBeNil)
+			cache.Invalidate("test1")
+			instance, err := getCacheClusterInstance(svc, cache, "test", "redis", "test1", hobby)
+			So(err, ShouldBeNil)
+			So(instance.Status, ShouldEqual, "available")
+			So(instance.Endpoint, ShouldEqual, "test1.0001.fake.cache.amazonaws.com:6379")
+			So(svc.describes, ShouldEqual, 2)
+
+			_, err = getCacheClusterInstance(svc, cache, "test", "redis", "does-not-exist", hobby)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -2,6 +2,7 @@ package broker
 
 import (
 	"reflect"
+	"time"
 )
 
 type Stat struct {
@@ -10,30 +11,61 @@ type Stat struct {
 }
 
 type Instance struct {
-	Id            string        `json:"id"`
-	Name          string        `json:"name"`
-	ProviderId    string        `json:"provider_id"`
-	Plan          *ProviderPlan `json:"plan,omitempty"`
-	Username      string        `json:"username"`
-	Password      string        `json:"password"`
-	Endpoint      string        `json:"endpoint"`
-	Status        string        `json:"status"`
-	Ready         bool          `json:"ready"`
-	Engine        string        `json:"engine"`
-	EngineVersion string        `json:"engine_version"`
-	Scheme        string        `json:"scheme"`
+	Id             string        `json:"id"`
+	Name           string        `json:"name"`
+	ProviderId     string        `json:"provider_id"`
+	Plan           *ProviderPlan `json:"plan,omitempty"`
+	Username       string        `json:"username"`
+	Password       string        `json:"password"`
+	Endpoint       string        `json:"endpoint"`
+	ReaderEndpoint string        `json:"reader_endpoint,omitempty"`
+	Status         string        `json:"status"`
+	StatusDetail   string        `json:"status_detail,omitempty"`
+	Ready          bool          `json:"ready"`
+	Engine         string        `json:"engine"`
+	EngineVersion  string        `json:"engine_version"`
+	Scheme         string        `json:"scheme"`
+	// The parameters given when provisioning or updating, they are applied to the plan's settings.
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	// Engine settings changed by the user, e.g., {"maxmemory-policy":"allkeys-lru"}.
+	Config map[string]string `json:"config,omitempty"`
 }
 
 type Entry struct {
-	Id       string
-	Name     string
-	PlanId   string
-	Claimed  bool
-	Tasks    int
-	Status   string
-	Username string
-	Password string
-	Endpoint string
+	Id         string
+	Name       string
+	PlanId     string
+	Claimed    bool
+	Tasks      int
+	Status     string
+	Username   string
+	Password   string
+	Endpoint   string
+	Parameters map[string]interface{}
+	Config     map[string]string
+	// The engine version the instance was upgraded to, if any, in place of its plan's.
+	EngineVersion string
+	Created       time.Time
+}
+
+type Binding struct {
+	Id         string
+	ResourceId string
+	App        string
+	Username   string
+	Password   string
+}
+
+// BindingInstance returns a copy of the instance carrying the bindings own credentials,
+// if the binding has none (or is nil) the instance is returned as is.
+func BindingInstance(instance *Instance, binding *Binding) *Instance {
+	if binding == nil || binding.Username == "" {
+		return instance
+	}
+	copied := *instance
+	copied.Username = binding.Username
+	copied.Password = binding.Password
+	return &copied
 }
 
 func (i *Instance) Match(other *Instance) bool {
@@ -71,6 +103,7 @@ func IsReady(status string) bool {
 		status == "configuring-enhanced-monitoring" ||
 		status == "storage-optimization" ||
 		status == "backing-up" ||
+		status == "snapshotting" ||
 		// gcloud states
 		status == "RUNNABLE" ||
 		status == "UNKNOWN_STATE" ||
@@ -83,6 +116,8 @@ func InProgress(status string) bool {
 		status == "renaming" || status == "upgrading" || status == "backtracking" ||
 		status == "maintenance" || status == "resetting-master-credentials" ||
 		status == "rebooting cluster nodes" ||
+		// kubernetes states
+		status == "unschedulable" ||
 		// gcloud states
 		status == "PENDING_CREATE" || status == "MAINTENANCE" ||
 		status == "Progressing" || status == "Creating" || status == "Starting"
@@ -101,7 +136,7 @@ func CanGetBindings(status string) bool {
 func CanBeModified(status string) bool {
 	// aws states
 	return status != "creating" && status != "starting" && status != "modifying" &&
-		status != "rebooting" && status != "moving-to-vpc" && status != "backing-up" &&
+		status != "rebooting" && status != "moving-to-vpc" && status != "backing-up" && status != "snapshotting" &&
 		status != "renaming" && status != "upgrading" && status != "backtracking" &&
 		status != "maintenance" && status != "resetting-master-credentials" &&
 		status != "deleted" && status != "rebooting cluster nodes" &&
@@ -113,5 +148,8 @@ func CanBeModified(status string) bool {
 //available, failed, incompatible-parameters, incompatible-network, restore-failed, recovering
 func CanBeDeleted(status string) bool {
 	return status == "available" || status == "failed" || status == "incompatible-parameters" ||
-		status == "incompatible-network" || status == "restore-failed" || status == "recovering"
+		status == "incompatible-network" || status == "restore-failed" || status == "recovering" ||
+		// kubernetes states
+		status == "image-pull-failed" || status == "crash-looping" || status == "out-of-memory" ||
+		status == "unschedulable"
 }
@@ -20,6 +20,7 @@ func NewBusinessLogic(ctx context.Context, o Options) (*BusinessLogic, error) {
 	if err != nil {
 		return nil, err
 	}
+	StartProviders(ctx)
 
 	bl := BusinessLogic{
 		storage:    storage,
@@ -34,6 +35,11 @@ func NewBusinessLogic(ctx context.Context, o Options) (*BusinessLogic, error) {
 	bl.AddActions("flush", "flush", "POST", bl.ActionFlushData)
 	bl.AddActions("stats", "stats", "POST", bl.ActionGetStats)
 	bl.AddActions("restart", "restart", "POST", bl.ActionRestart)
+	bl.AddActions("rotate_credentials", "credentials", "PUT", bl.ActionRotateCredentials)
+	bl.AddActions("get_config", "config", "GET", bl.ActionGetConfig)
+	bl.AddActions("update_config", "config", "PATCH", bl.ActionUpdateConfig)
+	bl.AddActions("list_engine_versions", "engine", "GET", bl.ActionListEngineVersions)
+	bl.AddActions("upgrade_engine", "engine", "PUT", bl.ActionUpgradeEngine)
 	return &bl, nil
 }
 
@@ -64,6 +70,159 @@ func (b *BusinessLogic) ActionRestoreBackup(InstanceID string, vars map[string]s
 	return map[string]interface{}{"status": "OK"}, nil
 }
 
+func (b *BusinessLogic) ActionRotateCredentials(InstanceID string, vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	instance, err := b.GetInstanceById(InstanceID)
+	if err != nil {
+		return nil, NotFound()
+	}
+	if !CanBeModified(instance.Status) {
+		return nil, UnprocessableEntityWithMessage("ServiceNotYetAvailable", "Credentials cannot be rotated while this service is under maintenance.")
+	}
+	if instance.Engine != "redis" || instance.Password == "" {
+		return nil, UnprocessableEntityWithMessage("RotationUnavailable", "This service does not use an auth token that can be rotated.")
+	}
+	rotating, err := b.storage.IsRotatingCredentials(instance.Id)
+	if err != nil {
+		glog.Errorf("Unable to rotate credentials, IsRotatingCredentials failed: %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	if rotating {
+		return nil, ConflictErrorWithMessage("The credentials for this service are already being rotated.")
+	}
+	var metadata RotateCredentialsTaskMetadata
+	// Bound apps are notified of the new credentials the same way provisioning uses callbacks,
+	// without a webhook they would lose access when the old token is removed.
+	if context != nil && context.Request != nil && context.Request.URL != nil && context.Request.URL.Query().Get("webhook") != "" && context.Request.URL.Query().Get("secret") != "" {
+		metadata.Url = context.Request.URL.Query().Get("webhook")
+		metadata.Secret = context.Request.URL.Query().Get("secret")
+	}
+	if metadata.Url == "" {
+		return nil, UnprocessableEntityWithMessage("WebhookRequired", "A webhook and secret must be given to notify bound apps of the new credentials.")
+	}
+	byteData, err := json.Marshal(metadata)
+	if err != nil {
+		glog.Errorf("Error: failed to marshal rotate credentials task metadata: %s\n", err)
+		return nil, InternalServerError()
+	}
+	if _, err = b.storage.AddTask(instance.Id, RotateCredentialsTask, string(byteData)); err != nil {
+		glog.Errorf("Error: Unable to schedule credential rotation! (%s): %s\n", instance.Name, err.Error())
+		return nil, InternalServerError()
+	}
+	return map[string]interface{}{"status": "OK"}, nil
+}
+
+func (b *BusinessLogic) ActionGetConfig(InstanceID string, vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	instance, err := b.GetInstanceById(InstanceID)
+	if err != nil {
+		return nil, NotFound()
+	}
+	config := instance.Config
+	if config == nil {
+		config = make(map[string]string)
+	}
+	return map[string]interface{}{"config": config, "allowed": EngineConfigNames(instance.Engine)}, nil
+}
+
+// ActionUpdateConfig changes the engine settings of an instance, the body is an object of
+// settings to change, a setting given as null is reset to its default.
+func (b *BusinessLogic) ActionUpdateConfig(InstanceID string, vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	instance, err := b.GetInstanceById(InstanceID)
+	if err != nil {
+		return nil, NotFound()
+	}
+	if !CanBeModified(instance.Status) {
+		return nil, UnprocessableEntityWithMessage("ServiceNotYetAvailable", "The config cannot be changed while this service is under maintenance.")
+	}
+	var changes map[string]interface{}
+	if context == nil || context.Request == nil || context.Request.Body == nil {
+		return nil, UnprocessableEntityWithMessage("InvalidBody", "The settings to change were not provided.")
+	}
+	if err = json.NewDecoder(context.Request.Body).Decode(&changes); err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidBody", "The settings to change must be a JSON object: "+err.Error())
+	}
+	config, err := MergeEngineConfig(instance.Engine, instance.Config, changes)
+	if err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidConfig", err.Error())
+	}
+	if err = b.storage.UpdateInstanceConfig(instance.Id, config); err != nil {
+		glog.Errorf("Unable to save the config of %s: %s\n", instance.Name, err.Error())
+		return nil, InternalServerError()
+	}
+	if _, err = b.storage.AddTask(instance.Id, ApplyConfigTask, ""); err != nil {
+		glog.Errorf("Error: Unable to schedule applying the config of %s: %s\n", instance.Name, err.Error())
+		return nil, InternalServerError()
+	}
+	return map[string]interface{}{"status": "OK", "config": config}, nil
+}
+
+func (b *BusinessLogic) ActionListEngineVersions(InstanceID string, vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	instance, err := b.GetInstanceById(InstanceID)
+	if err != nil {
+		return nil, NotFound()
+	}
+	provider, err := GetProviderByPlan(b.namePrefix, instance.Plan)
+	if err != nil {
+		glog.Errorf("Unable to list engine versions, cannot find provider (GetProviderByPlan failed): %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	versions, err := provider.EngineVersions(instance)
+	if err != nil {
+		glog.Errorf("Unable to list engine versions for %s: %s\n", instance.Name, err.Error())
+		return nil, InternalServerError()
+	}
+	return map[string]interface{}{"engine": instance.Engine, "version": instance.EngineVersion, "versions": versions}, nil
+}
+
+// ActionUpgradeEngine upgrades an instance to a newer engine version, the body is the version
+// to upgrade to, e.g., {"version":"5.0.6"}.
+func (b *BusinessLogic) ActionUpgradeEngine(InstanceID string, vars map[string]string, context *broker.RequestContext) (interface{}, error) {
+	instance, err := b.GetInstanceById(InstanceID)
+	if err != nil {
+		return nil, NotFound()
+	}
+	if !CanBeModified(instance.Status) {
+		return nil, UnprocessableEntityWithMessage("ServiceNotYetAvailable", "The engine cannot be upgraded while this service is under maintenance.")
+	}
+	var metadata UpgradeEngineTaskMetadata
+	if context == nil || context.Request == nil || context.Request.Body == nil {
+		return nil, UnprocessableEntityWithMessage("InvalidBody", "The version to upgrade to was not provided.")
+	}
+	if err = json.NewDecoder(context.Request.Body).Decode(&metadata); err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidBody", "The body must be a JSON object with the version to upgrade to: "+err.Error())
+	}
+	upgrading, err := b.storage.IsUpgrading(instance.Id)
+	if err != nil {
+		glog.Errorf("Unable to upgrade engine, IsUpgrading failed: %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	if upgrading {
+		return nil, ConflictErrorWithMessage("This service is already being upgraded.")
+	}
+	provider, err := GetProviderByPlan(b.namePrefix, instance.Plan)
+	if err != nil {
+		glog.Errorf("Unable to upgrade engine, cannot find provider (GetProviderByPlan failed): %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	versions, err := provider.EngineVersions(instance)
+	if err != nil {
+		glog.Errorf("Unable to list engine versions for %s: %s\n", instance.Name, err.Error())
+		return nil, InternalServerError()
+	}
+	if err = ValidateEngineUpgrade(instance.EngineVersion, metadata.Version, versions); err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidVersion", err.Error())
+	}
+	byteData, err := json.Marshal(metadata)
+	if err != nil {
+		glog.Errorf("Error: failed to marshal upgrade engine task metadata: %s\n", err)
+		return nil, InternalServerError()
+	}
+	if _, err = b.storage.AddTask(instance.Id, UpgradeEngineTask, string(byteData)); err != nil {
+		glog.Errorf("Error: Unable to schedule engine upgrade! (%s): %s\n", instance.Name, err.Error())
+		return nil, InternalServerError()
+	}
+	return map[string]interface{}{"status": "OK", "version": metadata.Version}, nil
+}
+
 func (b *BusinessLogic) ActionCreateBackup(InstanceID string, vars map[string]string, context *broker.RequestContext) (interface{}, error) {
 	instance, err := b.GetInstanceById(InstanceID)
 	if err != nil {
@@ -82,6 +241,15 @@ func (b *BusinessLogic) ActionCreateBackup(InstanceID string, vars map[string]st
 		glog.Errorf("Unable to create backup, create backup failed: %s\n", err.Error())
 		return nil, InternalServerError()
 	}
+	byteData, err := json.Marshal(CopyBackupTaskMetadata{Backup: *backup.Id})
+	if err != nil {
+		glog.Errorf("Unable to marshal copy backup metadata: %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	if _, err = b.storage.AddTask(instance.Id, CopyBackupTask, string(byteData)); err != nil {
+		glog.Errorf("Error: Unable to schedule copy of backup! (%s): %s\n", instance.Name, err.Error())
+		return nil, InternalServerError()
+	}
 	return backup, nil
 }
 
@@ -196,6 +364,19 @@ func GetInstanceById(namePrefix string, storage Storage, Id string) (*Instance,
 		return nil, err
 	}
 
+	plan, err = plan.WithParameters(entry.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	plan, err = plan.WithConfig(entry.Config)
+	if err != nil {
+		return nil, err
+	}
+	plan, err = plan.WithEngineVersion(entry.EngineVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	Instance, err := provider.GetInstance(entry.Name, plan)
 	if err != nil {
 		return nil, err
@@ -205,6 +386,8 @@ func GetInstanceById(namePrefix string, storage Storage, Id string) (*Instance,
 	Instance.Username = entry.Username
 	Instance.Password = entry.Password
 	Instance.Plan = plan
+	Instance.Parameters = entry.Parameters
+	Instance.Config = entry.Config
 
 	return Instance, nil
 }
@@ -228,10 +411,17 @@ func (b *BusinessLogic) GetUnclaimedInstance(PlanId string, InstanceId string) (
 	return Instance, nil
 }
 
+func (b *BusinessLogic) Provision(request *osb.ProvisionRequest, c *broker.RequestContext) (*broker.ProvisionResponse, error) {
+	plan, provider := b.operationLabels(request.PlanID, request.InstanceID)
+	response, err := b.provision(request, c)
+	countOperation("provision", plan, provider, err)
+	return response, err
+}
+
 // A peice of advice, never try to make this syncronous by waiting for a to return a response. The problem is
 // that can take up to 10 minutes in my experience (depending on the provider), and aside from the API call timing
 // out the other issue is it can cause the mutex lock to make the entire API unresponsive.
-func (b *BusinessLogic) Provision(request *osb.ProvisionRequest, c *broker.RequestContext) (*broker.ProvisionResponse, error) {
+func (b *BusinessLogic) provision(request *osb.ProvisionRequest, c *broker.RequestContext) (*broker.ProvisionResponse, error) {
 	b.Lock()
 	defer b.Unlock()
 	response := broker.ProvisionResponse{}
@@ -256,6 +446,28 @@ func (b *BusinessLogic) Provision(request *osb.ProvisionRequest, c *broker.Reque
 		return nil, InternalServerError()
 	}
 
+	changes, parameters, err := engineConfigFromParameters(request.Parameters)
+	if err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidParameters", err.Error())
+	}
+	_, engine, err := providerSettings(plan.Provider)
+	if err != nil {
+		glog.Errorf("Unable to provision (providerSettings failed): %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	config, err := MergeEngineConfig(engine, nil, changes)
+	if err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidParameters", err.Error())
+	}
+	schema, err := ParseParametersSchema(plan.parametersSchema)
+	if err != nil {
+		glog.Errorf("Unable to provision (ParseParametersSchema failed): %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	if err = schema.Validate(parameters); err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidParameters", err.Error())
+	}
+
 	Instance, err := b.GetInstanceById(request.InstanceID)
 
 	if err == nil {
@@ -265,20 +477,35 @@ func (b *BusinessLogic) Provision(request *osb.ProvisionRequest, c *broker.Reque
 		response.Exists = true
 	} else if err != nil && err.Error() == "Cannot find resource instance" {
 		response.Exists = false
-		Instance, err = b.GetUnclaimedInstance(request.PlanID, request.InstanceID)
+		// Preprovisioned instances use the plan's defaults, they cannot be claimed with parameters.
+		if len(request.Parameters) == 0 {
+			Instance, err = b.GetUnclaimedInstance(request.PlanID, request.InstanceID)
+		}
 
-		if err != nil && err.Error() == "Cannot find resource instance" {
+		if len(request.Parameters) != 0 || (err != nil && err.Error() == "Cannot find resource instance") {
 			// Create a new one
 			provider, err := GetProviderByPlan(b.namePrefix, plan)
 			if err != nil {
 				glog.Errorf("Unable to provision, cannot find provider (GetProviderByPlan failed): %s\n", err.Error())
 				return nil, InternalServerError()
 			}
+			plan, err = plan.WithParameters(parameters)
+			if err != nil {
+				glog.Errorf("Unable to provision, cannot apply parameters: %s\n", err.Error())
+				return nil, InternalServerError()
+			}
+			plan, err = plan.WithConfig(config)
+			if err != nil {
+				glog.Errorf("Unable to provision, cannot apply config: %s\n", err.Error())
+				return nil, InternalServerError()
+			}
 			Instance, err = provider.Provision(request.InstanceID, plan, request.OrganizationGUID)
 			if err != nil {
 				glog.Errorf("Error provisioning resource: %s\n", err.Error())
 				return nil, InternalServerError()
 			}
+			Instance.Parameters = parameters
+			Instance.Config = config
 
 			if err = b.storage.AddInstance(Instance); err != nil {
 				glog.Errorf("Error inserting record into provisioned table: %s\n", err.Error())
@@ -291,6 +518,13 @@ func (b *BusinessLogic) Provision(request *osb.ProvisionRequest, c *broker.Reque
 				}
 				return nil, InternalServerError()
 			}
+			// The config is applied again once the instance is available, on AWS this moves it
+			// onto its own parameter group.
+			if len(config) > 0 {
+				if _, err = b.storage.AddTask(Instance.Id, ApplyConfigTask, ""); err != nil {
+					glog.Errorf("Error: Unable to schedule applying the config of %s: %s\n", Instance.Name, err.Error())
+				}
+			}
 			if !IsAvailable(Instance.Status) {
 				if _, err = b.storage.AddTask(Instance.Id, PerformPostProvisionTask, ""); err != nil {
 					glog.Errorf("Error: Unable to schedule resync from provider! (%s): %s\n", Instance.Name, err.Error())
@@ -330,6 +564,13 @@ func (b *BusinessLogic) Provision(request *osb.ProvisionRequest, c *broker.Reque
 }
 
 func (b *BusinessLogic) Deprovision(request *osb.DeprovisionRequest, c *broker.RequestContext) (*broker.DeprovisionResponse, error) {
+	plan, provider := b.operationLabels("", request.InstanceID)
+	response, err := b.deprovision(request, c)
+	countOperation("deprovision", plan, provider, err)
+	return response, err
+}
+
+func (b *BusinessLogic) deprovision(request *osb.DeprovisionRequest, c *broker.RequestContext) (*broker.DeprovisionResponse, error) {
 	b.Lock()
 	defer b.Unlock()
 
@@ -368,6 +609,17 @@ func (b *BusinessLogic) Deprovision(request *osb.DeprovisionRequest, c *broker.R
 }
 
 func (b *BusinessLogic) Update(request *osb.UpdateInstanceRequest, c *broker.RequestContext) (*broker.UpdateInstanceResponse, error) {
+	planId := ""
+	if request.PlanID != nil {
+		planId = *request.PlanID
+	}
+	plan, provider := b.operationLabels(planId, request.InstanceID)
+	response, err := b.update(request, c)
+	countOperation("update", plan, provider, err)
+	return response, err
+}
+
+func (b *BusinessLogic) update(request *osb.UpdateInstanceRequest, c *broker.RequestContext) (*broker.UpdateInstanceResponse, error) {
 	response := broker.UpdateInstanceResponse{}
 	if !request.AcceptsIncomplete {
 		return nil, UnprocessableEntity()
@@ -379,7 +631,7 @@ func (b *BusinessLogic) Update(request *osb.UpdateInstanceRequest, c *broker.Req
 		glog.Errorf("Error finding instance id (during deprovision) from provisioned table: %s\n", err.Error())
 		return nil, InternalServerError()
 	}
-	if request.PlanID == nil {
+	if request.PlanID == nil && len(request.Parameters) == 0 {
 		return nil, UnprocessableEntity()
 	}
 
@@ -387,18 +639,66 @@ func (b *BusinessLogic) Update(request *osb.UpdateInstanceRequest, c *broker.Req
 		return nil, UnprocessableEntityWithMessage("ConcurrencyError", "Clients MUST wait until pending requests have completed for the specified resources.")
 	}
 
-	if strings.ToLower(*request.PlanID) == strings.ToLower(Instance.Plan.ID) {
+	changes, requestParameters, err := engineConfigFromParameters(request.Parameters)
+	if err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidParameters", err.Error())
+	}
+	planId := Instance.Plan.ID
+	if request.PlanID != nil {
+		planId = *request.PlanID
+	}
+	samePlan := strings.ToLower(planId) == strings.ToLower(Instance.Plan.ID)
+	if samePlan && len(request.Parameters) == 0 {
 		return nil, UnprocessableEntityWithMessage("UpgradeError", "Cannot upgrade to the same plan.")
 	}
 
-	target_plan, err := b.storage.GetPlanByID(*request.PlanID)
+	config, err := MergeEngineConfig(Instance.Engine, Instance.Config, changes)
+	if err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidParameters", err.Error())
+	}
+
+	target_plan, err := b.storage.GetPlanByID(planId)
 	if err != nil {
 		glog.Errorf("Unable to provision resource (GetPlanByID failed): %s\n", err.Error())
 		return nil, err
 	}
 
+	// Parameters given are merged with the ones the instance already has, those the new plan
+	// does not offer are dropped.
+	schema, err := ParseParametersSchema(target_plan.parametersSchema)
+	if err != nil {
+		glog.Errorf("Unable to update resource (ParseParametersSchema failed): %s\n", err.Error())
+		return nil, InternalServerError()
+	}
+	if err = schema.Validate(requestParameters); err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidParameters", err.Error())
+	}
+	parameters := schema.Supported(Instance.Parameters)
+	for name, value := range requestParameters {
+		parameters[name] = value
+	}
+	if err = schema.Validate(parameters); err != nil {
+		return nil, UnprocessableEntityWithMessage("InvalidParameters", err.Error())
+	}
+
 	if (Instance.Plan.Provider == target_plan.Provider) || (Instance.Plan.Provider != target_plan.Provider && Instance.Engine == "memcached") {
-		byteData, err := json.Marshal(ChangePlansTaskMetadata{Plan: *request.PlanID})
+		// Engine settings are saved with the instance, they are applied on their own or as
+		// part of the plan change.
+		if changes != nil {
+			if err = b.storage.UpdateInstanceConfig(Instance.Id, config); err != nil {
+				glog.Errorf("Unable to save the config of %s: %s\n", Instance.Name, err.Error())
+				return nil, InternalServerError()
+			}
+			if samePlan && len(requestParameters) == 0 {
+				if _, err = b.storage.AddTask(Instance.Id, ApplyConfigTask, ""); err != nil {
+					glog.Errorf("Error: Unable to schedule applying the config of %s: %s\n", Instance.Name, err.Error())
+					return nil, InternalServerError()
+				}
+				response.Async = true
+				return &response, nil
+			}
+		}
+		byteData, err := json.Marshal(ChangePlansTaskMetadata{Plan: planId, Parameters: parameters})
 		if err != nil {
 			glog.Errorf("Unable to marshal change plans task meta data: %s\n", err.Error())
 			return nil, err
@@ -429,6 +729,12 @@ func (b *BusinessLogic) LastOperation(request *osb.LastOperationRequest, c *brok
 		return nil, InternalServerError()
 	}
 
+	rotating, err := b.storage.IsRotatingCredentials(request.InstanceID)
+	if err != nil {
+		glog.Errorf("Unable to get resource (%s) status, IsRotatingCredentials failed: %s\n", request.InstanceID, err.Error())
+		return nil, InternalServerError()
+	}
+
 	if upgrading {
 		desc := "upgrading"
 		Instance, err := b.GetInstanceById(request.InstanceID)
@@ -447,6 +753,11 @@ func (b *BusinessLogic) LastOperation(request *osb.LastOperationRequest, c *brok
 		response.Description = &desc
 		response.State = osb.StateInProgress
 		return &response, nil
+	} else if rotating {
+		desc := "rotating-credentials"
+		response.Description = &desc
+		response.State = osb.StateInProgress
+		return &response, nil
 	}
 
 	Instance, err := b.GetInstanceById(request.InstanceID)
@@ -459,20 +770,31 @@ func (b *BusinessLogic) LastOperation(request *osb.LastOperationRequest, c *brok
 
 	b.storage.UpdateInstance(Instance, Instance.Plan.ID)
 
+	desc := Instance.Status
+	if Instance.StatusDetail != "" {
+		desc = Instance.Status + ": " + Instance.StatusDetail
+	}
 	if Instance.Ready == true {
-		response.Description = &Instance.Status
+		response.Description = &desc
 		response.State = osb.StateSucceeded
 	} else if InProgress(Instance.Status) {
-		response.Description = &Instance.Status
+		response.Description = &desc
 		response.State = osb.StateInProgress
 	} else {
-		response.Description = &Instance.Status
+		response.Description = &desc
 		response.State = osb.StateFailed
 	}
 	return &response, nil
 }
 
 func (b *BusinessLogic) Bind(request *osb.BindRequest, c *broker.RequestContext) (*broker.BindResponse, error) {
+	plan, provider := b.operationLabels("", request.InstanceID)
+	response, err := b.bind(request, c)
+	countOperation("bind", plan, provider, err)
+	return response, err
+}
+
+func (b *BusinessLogic) bind(request *osb.BindRequest, c *broker.RequestContext) (*broker.BindResponse, error) {
 	b.Lock()
 	defer b.Unlock()
 	Instance, err := b.GetInstanceById(request.InstanceID)
@@ -492,13 +814,67 @@ func (b *BusinessLogic) Bind(request *osb.BindRequest, c *broker.RequestContext)
 		return nil, InternalServerError()
 	}
 
+	// Plans that support per-binding users (e.g., redis 6 ACLs) get their own credentials,
+	// everything else falls back to the credentials shared by the instance.
+	var app = ""
+	if request.BindResource != nil && request.BindResource.AppGUID != nil {
+		app = *request.BindResource.AppGUID
+	}
+	existing, err := b.storage.GetBinding(Instance.Id, request.BindingID)
+	if err != nil && err.Error() != "Cannot find binding" {
+		glog.Errorf("Error finding binding %s (during bind): %s\n", request.BindingID, err.Error())
+		return nil, InternalServerError()
+	}
+	if existing != nil && existing.App != app {
+		return nil, ConflictErrorWithMessage("The binding already exists with different parameters.")
+	} else if existing != nil {
+		return &broker.BindResponse{
+			BindResponse: osb.BindResponse{
+				Async:       false,
+				Credentials: provider.GetUrl(BindingInstance(Instance, existing)),
+			},
+			Exists: true,
+		}, nil
+	}
+
+	binding := Binding{Id: request.BindingID, ResourceId: Instance.Id, App: app}
+	username, password, err := provider.CreateBindingUser(Instance, request.BindingID)
+	if err != nil && err.Error() != "This feature is not available on this plan." {
+		glog.Errorf("Error creating binding user for %s: %s\n", request.InstanceID, err.Error())
+		return nil, InternalServerError()
+	} else if err == nil {
+		binding.Username = username
+		binding.Password = password
+	}
+	// A binding that fails part way is undone, so the platform can try again and no user is
+	// left behind that nothing will ever remove.
+	undo := func(stored bool) {
+		if stored {
+			if err := b.storage.DeleteBinding(Instance.Id, binding.Id); err != nil {
+				glog.Errorf("Error removing binding %s after it failed: %s\n", binding.Id, err.Error())
+			}
+		}
+		if binding.Username != "" {
+			if err := provider.DeleteBindingUser(Instance, binding.Username); err != nil {
+				glog.Errorf("Error removing binding user %s after the binding failed: %s\n", binding.Username, err.Error())
+			}
+		}
+	}
+	if err = b.storage.AddBinding(&binding); err != nil {
+		glog.Errorf("Error storing binding %s for %s: %s\n", request.BindingID, request.InstanceID, err.Error())
+		undo(false)
+		return nil, InternalServerError()
+	}
+
 	if request.BindResource != nil && request.BindResource.AppGUID != nil {
 		if err = provider.Tag(Instance, "Binding", request.BindingID); err != nil {
 			glog.Errorf("Error tagging: %s with %s, got %s\n", request.InstanceID, *request.BindResource.AppGUID, err.Error())
+			undo(true)
 			return nil, InternalServerError()
 		}
 		if err = provider.Tag(Instance, "App", *request.BindResource.AppGUID); err != nil {
 			glog.Errorf("Error tagging: %s with %s, got %s\n", request.InstanceID, *request.BindResource.AppGUID, err.Error())
+			undo(true)
 			return nil, InternalServerError()
 		}
 	}
@@ -506,11 +882,28 @@ func (b *BusinessLogic) Bind(request *osb.BindRequest, c *broker.RequestContext)
 	return &broker.BindResponse{
 		BindResponse: osb.BindResponse{
 			Async:       false,
-			Credentials: provider.GetUrl(Instance),
+			Credentials: provider.GetUrl(BindingInstance(Instance, &binding)),
 		},
 	}, nil
 }
 
+// getBinding returns the stored binding, resources bound before bindings were stored get a
+// binding without its own credentials for any id, as the broker did before.
+func (b *BusinessLogic) getBinding(Instance *Instance, Id string) (*Binding, error) {
+	binding, err := b.storage.GetBinding(Instance.Id, Id)
+	if err == nil || err.Error() != "Cannot find binding" {
+		return binding, err
+	}
+	untracked, uerr := b.storage.HasUntrackedBindings(Instance.Id)
+	if uerr != nil {
+		return nil, uerr
+	}
+	if untracked {
+		return &Binding{Id: Id, ResourceId: Instance.Id}, nil
+	}
+	return nil, err
+}
+
 func (b *BusinessLogic) Unbind(request *osb.UnbindRequest, c *broker.RequestContext) (*broker.UnbindResponse, error) {
 	b.Lock()
 	defer b.Unlock()
@@ -532,15 +925,58 @@ func (b *BusinessLogic) Unbind(request *osb.UnbindRequest, c *broker.RequestCont
 		return nil, InternalServerError()
 	}
 
-	if err = provider.Untag(Instance, "Binding"); err != nil {
-		glog.Errorf("Error untagging: %s\n", err.Error())
+	binding, err := b.getBinding(Instance, request.BindingID)
+	if err != nil && err.Error() == "Cannot find binding" {
+		return nil, Gone()
+	} else if err != nil {
+		glog.Errorf("Error finding binding %s (during unbind): %s\n", request.BindingID, err.Error())
 		return nil, InternalServerError()
 	}
-	if err = provider.Untag(Instance, "App"); err != nil {
-		glog.Errorf("Error untagging: got %s\n", err.Error())
+	if binding.Username != "" {
+		if err = provider.DeleteBindingUser(Instance, binding.Username); err != nil {
+			glog.Errorf("Error removing binding user %s: %s\n", binding.Username, err.Error())
+			return nil, InternalServerError()
+		}
+	}
+	if err = b.storage.DeleteBinding(Instance.Id, request.BindingID); err != nil {
+		glog.Errorf("Error removing binding %s: %s\n", request.BindingID, err.Error())
 		return nil, InternalServerError()
 	}
 
+	// Tags only hold a single value, so they point to the most recent binding that remains
+	// and are only removed once the last binding is gone.
+	bindings, err := b.storage.ListBindings(Instance.Id)
+	if err != nil {
+		glog.Errorf("Error listing bindings for %s: %s\n", request.InstanceID, err.Error())
+		return nil, InternalServerError()
+	}
+	var remaining *Binding = nil
+	for i := len(bindings) - 1; i >= 0; i-- {
+		if bindings[i].App != "" {
+			remaining = &bindings[i]
+			break
+		}
+	}
+	if remaining != nil {
+		if err = provider.Tag(Instance, "Binding", remaining.Id); err != nil {
+			glog.Errorf("Error tagging: %s with %s, got %s\n", request.InstanceID, remaining.App, err.Error())
+			return nil, InternalServerError()
+		}
+		if err = provider.Tag(Instance, "App", remaining.App); err != nil {
+			glog.Errorf("Error tagging: %s with %s, got %s\n", request.InstanceID, remaining.App, err.Error())
+			return nil, InternalServerError()
+		}
+	} else {
+		if err = provider.Untag(Instance, "Binding"); err != nil {
+			glog.Errorf("Error untagging: %s\n", err.Error())
+			return nil, InternalServerError()
+		}
+		if err = provider.Untag(Instance, "App"); err != nil {
+			glog.Errorf("Error untagging: got %s\n", err.Error())
+			return nil, InternalServerError()
+		}
+	}
+
 	return &broker.UnbindResponse{
 		UnbindResponse: osb.UnbindResponse{
 			Async: false,
@@ -568,8 +1004,15 @@ func (b *BusinessLogic) GetBinding(request *osb.GetBindingRequest, context *brok
 		glog.Errorf("Unable to provision, cannot find provider (GetProviderByPlan failed): %s\n", err.Error())
 		return nil, InternalServerError()
 	}
+	binding, err := b.getBinding(Instance, request.BindingID)
+	if err != nil && err.Error() == "Cannot find binding" {
+		return nil, NotFound()
+	} else if err != nil {
+		glog.Errorf("Error finding binding %s (during getbinding): %s\n", request.BindingID, err.Error())
+		return nil, InternalServerError()
+	}
 	return &osb.GetBindingResponse{
-		Credentials: provider.GetUrl(Instance),
+		Credentials: provider.GetUrl(BindingInstance(Instance, binding)),
 	}, nil
 }
 
@@ -0,0 +1,159 @@
+package broker
+
+import (
+	"context"
+	"github.com/golang/glog"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+var (
+	operationsTotal = prom.NewCounterVec(prom.CounterOpts{
+		Namespace: "elasticache_broker",
+		Name:      "operations_total",
+		Help:      "Provisions, deprovisions, updates and binds by plan, provider and outcome.",
+	}, []string{"operation", "plan", "provider", "outcome"})
+
+	providerCallDuration = prom.NewHistogramVec(prom.HistogramOpts{
+		Namespace: "elasticache_broker",
+		Name:      "provider_call_duration_seconds",
+		Help:      "How long calls to a provider took by provider, call and outcome.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"provider", "call", "outcome"})
+
+	taskRetriesTotal = prom.NewCounterVec(prom.CounterOpts{
+		Namespace: "elasticache_broker",
+		Name:      "task_retries_total",
+		Help:      "Tasks put back to pending to be tried again by action.",
+	}, []string{"action"})
+
+	taskFailuresTotal = prom.NewCounterVec(prom.CounterOpts{
+		Namespace: "elasticache_broker",
+		Name:      "task_failures_total",
+		Help:      "Tasks that were given up on by action.",
+	}, []string{"action"})
+
+	taskQueueDesc  = prom.NewDesc("elasticache_broker_tasks", "Tasks waiting or running by action and status.", []string{"action", "status"}, nil)
+	instancesDesc  = prom.NewDesc("elasticache_broker_instances", "Instances that have not been deleted by plan and status.", []string{"plan", "status"}, nil)
+	poolSizeDesc   = prom.NewDesc("elasticache_broker_preprovisioned_instances", "Unclaimed instances that are available by plan.", []string{"plan"}, nil)
+	poolTargetDesc = prom.NewDesc("elasticache_broker_preprovisioned_instances_target", "Unclaimed instances the plan should keep by plan.", []string{"plan"}, nil)
+)
+
+// MetricsCollector reports the broker's counters along with the state of its tasks and
+// instances, which is read from the database each time it is scraped.
+type MetricsCollector struct {
+	storage Storage
+}
+
+func NewMetricsCollector(storage Storage) *MetricsCollector {
+	return &MetricsCollector{storage: storage}
+}
+
+func (m *MetricsCollector) Describe(ch chan<- *prom.Desc) {
+	operationsTotal.Describe(ch)
+	providerCallDuration.Describe(ch)
+	taskRetriesTotal.Describe(ch)
+	taskFailuresTotal.Describe(ch)
+	ch <- taskQueueDesc
+	ch <- instancesDesc
+	ch <- poolSizeDesc
+	ch <- poolTargetDesc
+}
+
+func (m *MetricsCollector) Collect(ch chan<- prom.Metric) {
+	operationsTotal.Collect(ch)
+	providerCallDuration.Collect(ch)
+	taskRetriesTotal.Collect(ch)
+	taskFailuresTotal.Collect(ch)
+
+	metrics, err := m.storage.GetMetrics()
+	if err != nil {
+		glog.Errorf("Unable to get metrics from the database: %s\n", err.Error())
+		return
+	}
+	for _, count := range metrics.Tasks {
+		ch <- prom.MustNewConstMetric(taskQueueDesc, prom.GaugeValue, float64(count.Count), count.Action, count.Status)
+	}
+	for _, count := range metrics.Instances {
+		ch <- prom.MustNewConstMetric(instancesDesc, prom.GaugeValue, float64(count.Count), count.Plan, count.Status)
+	}
+	for _, pool := range metrics.Pools {
+		ch <- prom.MustNewConstMetric(poolSizeDesc, prom.GaugeValue, float64(pool.Available), pool.Plan)
+		ch <- prom.MustNewConstMetric(poolTargetDesc, prom.GaugeValue, float64(pool.Target), pool.Plan)
+	}
+}
+
+// RegisterMetrics adds the broker's metrics to the registry served by the API.
+func RegisterMetrics(reg prom.Registerer, b *BusinessLogic) {
+	reg.MustRegister(NewMetricsCollector(b.storage))
+}
+
+// MetricsSettings returns the port the worker serves metrics on from the options or environment.
+func MetricsSettings(o Options) int {
+	if o.MetricsPort == 0 && os.Getenv("METRICS_PORT") != "" {
+		o.MetricsPort, _ = strconv.Atoi(os.Getenv("METRICS_PORT"))
+	}
+	if o.MetricsPort < 1 {
+		o.MetricsPort = 9090
+	}
+	return o.MetricsPort
+}
+
+// RunMetricsServer serves metrics at /metrics for workers, which have no other http server,
+// until the context is cancelled.
+func RunMetricsServer(ctx context.Context, o Options, storage Storage) {
+	reg := prom.NewRegistry()
+	reg.MustRegister(NewMetricsCollector(storage))
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	server := &http.Server{Addr: ":" + strconv.Itoa(MetricsSettings(o)), Handler: mux}
+
+	go (func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	})()
+	glog.Infof("Serving metrics on %s/metrics\n", server.Addr)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		glog.Errorf("Unable to serve metrics: %s\n", err.Error())
+	}
+}
+
+// operationLabels finds the plan name and provider to count an operation under, the plan
+// of the instance is used when the request does not name one.
+func (b *BusinessLogic) operationLabels(planId string, instanceId string) (string, string) {
+	if planId == "" {
+		entry, err := b.storage.GetInstance(instanceId)
+		if err != nil {
+			return "unknown", "unknown"
+		}
+		planId = entry.PlanId
+	}
+	plan, err := b.storage.GetPlanByID(planId)
+	if err != nil {
+		return "unknown", "unknown"
+	}
+	return plan.basePlan.Name, string(plan.Provider)
+}
+
+// operationOutcome is success, rejected when the request was refused with a client
+// error, or error otherwise.
+func operationOutcome(err error) string {
+	if err == nil {
+		return "success"
+	}
+	if httpErr, ok := err.(osb.HTTPStatusCodeError); ok && httpErr.StatusCode < 500 {
+		return "rejected"
+	}
+	return "error"
+}
+
+func countOperation(operation string, plan string, provider string, err error) {
+	operationsTotal.WithLabelValues(operation, plan, provider, operationOutcome(err)).Inc()
+}
@@ -0,0 +1,53 @@
+package broker
+
+import (
+	"errors"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	prom "github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+type restartingProvider struct {
+	Provider
+	err error
+}
+
+func (provider *restartingProvider) Restart(Instance *Instance) error {
+	return provider.err
+}
+
+func providerCallCount(provider Providers, call string, outcome string) uint64 {
+	var metric dto.Metric
+	providerCallDuration.WithLabelValues(string(provider), call, outcome).(prom.Histogram).Write(&metric)
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestMetrics(t *testing.T) {
+	Convey("Given the outcome of an operation.", t, func() {
+		Convey("Ensure refused requests are told apart from failures", func() {
+			So(operationOutcome(nil), ShouldEqual, "success")
+			So(operationOutcome(NotFound()), ShouldEqual, "rejected")
+			So(operationOutcome(UnprocessableEntityWithMessage("AsyncRequired", "")), ShouldEqual, "rejected")
+			So(operationOutcome(InternalServerError()), ShouldEqual, "error")
+			So(operationOutcome(osb.HTTPStatusCodeError{StatusCode: 503}), ShouldEqual, "error")
+			So(operationOutcome(errors.New("failed")), ShouldEqual, "error")
+		})
+	})
+
+	Convey("Given a provider that is instrumented.", t, func() {
+		stub := &restartingProvider{}
+		provider := instrumentProvider(AWSRedisInstance, stub)
+
+		Convey("Ensure calls are timed by their outcome", func() {
+			successes := providerCallCount(AWSRedisInstance, "restart", "success")
+			failures := providerCallCount(AWSRedisInstance, "restart", "error")
+			So(provider.Restart(&Instance{}), ShouldBeNil)
+			stub.err = errors.New("failed")
+			So(provider.Restart(&Instance{}), ShouldEqual, stub.err)
+			So(providerCallCount(AWSRedisInstance, "restart", "success"), ShouldEqual, successes+1)
+			So(providerCallCount(AWSRedisInstance, "restart", "error"), ShouldEqual, failures+1)
+		})
+	})
+}
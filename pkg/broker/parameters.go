@@ -0,0 +1,312 @@
+package broker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Plans declare the parameters clients may give when provisioning or updating with a JSON
+// schema. Only a subset of JSON schema is supported: an object of properties with a type
+// (string, integer, number, boolean or array), enum, pattern, minLength, maxLength, minimum,
+// maximum and items, and the required list. Parameters not in the schema are rejected.
+//
+// Each property overrides the provider setting of the same name, or the one named by its
+// "x-provider-setting", e.g. "PreferredMaintenanceWindow" or "config.maxmemory-policy" for a
+// setting nested in an object.
+const providerSettingKeyword = "x-provider-setting"
+
+type ParametersSchema map[string]interface{}
+
+// ParseParametersSchema reads a plan's schema, an empty schema accepts no parameters.
+func ParseParametersSchema(data string) (ParametersSchema, error) {
+	var schema ParametersSchema
+	if data == "" {
+		return ParametersSchema{}, nil
+	}
+	if err := json.Unmarshal([]byte(data), &schema); err != nil {
+		return nil, errors.New("The parameters schema is not valid JSON: " + err.Error())
+	}
+	if schema == nil {
+		return ParametersSchema{}, nil
+	}
+	if t, ok := schema["type"]; ok && t != "object" {
+		return nil, errors.New("The parameters schema must be of type object.")
+	}
+	for name, property := range schema.Properties() {
+		if name == engineConfigParameter {
+			return nil, errors.New("The parameter " + name + " is reserved for engine settings.")
+		}
+		if err := checkPropertySchema(name, property); err != nil {
+			return nil, err
+		}
+	}
+	if required, ok := schema["required"]; ok {
+		names, ok := required.([]interface{})
+		if !ok {
+			return nil, errors.New("The required parameters must be a list of names.")
+		}
+		for _, name := range names {
+			if _, ok := schema.Properties()[fmt.Sprintf("%v", name)]; !ok {
+				return nil, fmt.Errorf("The required parameter %v is not one of the properties.", name)
+			}
+		}
+	}
+	return schema, nil
+}
+
+func (schema ParametersSchema) Properties() map[string]map[string]interface{} {
+	properties := make(map[string]map[string]interface{})
+	if values, ok := schema["properties"].(map[string]interface{}); ok {
+		for name, value := range values {
+			if property, ok := value.(map[string]interface{}); ok {
+				properties[name] = property
+			} else {
+				properties[name] = nil
+			}
+		}
+	}
+	return properties
+}
+
+// Empty is true for schemas without parameters.
+func (schema ParametersSchema) Empty() bool {
+	return len(schema.Properties()) == 0
+}
+
+// Public is the schema published in the catalog, it does not say which settings are overridden.
+func (schema ParametersSchema) Public() map[string]interface{} {
+	public := make(map[string]interface{})
+	for key, value := range schema {
+		public[key] = value
+	}
+	properties := make(map[string]interface{})
+	for name, property := range schema.Properties() {
+		copied := make(map[string]interface{})
+		for key, value := range property {
+			if key != providerSettingKeyword {
+				copied[key] = value
+			}
+		}
+		properties[name] = copied
+	}
+	public["type"] = "object"
+	public["properties"] = properties
+	public["additionalProperties"] = false
+	return public
+}
+
+// Setting is the provider setting a parameter overrides.
+func (schema ParametersSchema) Setting(name string) string {
+	if setting, ok := schema.Properties()[name][providerSettingKeyword].(string); ok && setting != "" {
+		return setting
+	}
+	return name
+}
+
+func checkPropertySchema(name string, property map[string]interface{}) error {
+	if property == nil {
+		return errors.New("The parameter " + name + " must be described by an object.")
+	}
+	switch property["type"] {
+	case "string", "integer", "number", "boolean":
+	case "array":
+		items, ok := property["items"].(map[string]interface{})
+		if !ok {
+			return errors.New("The parameter " + name + " is an array and must describe its items.")
+		}
+		if items["type"] == "array" {
+			return errors.New("The parameter " + name + " cannot be an array of arrays.")
+		}
+		if err := checkPropertySchema(name, items); err != nil {
+			return err
+		}
+	default:
+		return errors.New("The parameter " + name + " must have a type of string, integer, number, boolean or array.")
+	}
+	if pattern, ok := property["pattern"].(string); ok {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return errors.New("The pattern of parameter " + name + " is not valid: " + err.Error())
+		}
+	}
+	if enum, ok := property["enum"]; ok {
+		if _, ok := enum.([]interface{}); !ok {
+			return errors.New("The enum of parameter " + name + " must be a list.")
+		}
+	}
+	return nil
+}
+
+func checkPropertyValue(name string, property map[string]interface{}, value interface{}) error {
+	switch property["type"] {
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return errors.New("The parameter " + name + " must be a string.")
+		}
+		if min, ok := property["minLength"].(float64); ok && float64(len(str)) < min {
+			return fmt.Errorf("The parameter %s must be at least %v characters.", name, min)
+		}
+		if max, ok := property["maxLength"].(float64); ok && float64(len(str)) > max {
+			return fmt.Errorf("The parameter %s must be at most %v characters.", name, max)
+		}
+		if pattern, ok := property["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(str) {
+			return errors.New("The parameter " + name + " must match " + pattern + ".")
+		}
+	case "integer", "number":
+		number, ok := value.(float64)
+		if !ok {
+			return errors.New("The parameter " + name + " must be a number.")
+		}
+		if property["type"] == "integer" && number != math.Trunc(number) {
+			return errors.New("The parameter " + name + " must be an integer.")
+		}
+		if min, ok := property["minimum"].(float64); ok && number < min {
+			return fmt.Errorf("The parameter %s must be at least %v.", name, min)
+		}
+		if max, ok := property["maximum"].(float64); ok && number > max {
+			return fmt.Errorf("The parameter %s must be at most %v.", name, max)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return errors.New("The parameter " + name + " must be true or false.")
+		}
+	case "array":
+		values, ok := value.([]interface{})
+		if !ok {
+			return errors.New("The parameter " + name + " must be a list.")
+		}
+		items, _ := property["items"].(map[string]interface{})
+		for _, item := range values {
+			if err := checkPropertyValue(name, items, item); err != nil {
+				return err
+			}
+		}
+	}
+	if enum, ok := property["enum"].([]interface{}); ok {
+		for _, allowed := range enum {
+			if fmt.Sprintf("%v", allowed) == fmt.Sprintf("%v", value) {
+				return nil
+			}
+		}
+		return fmt.Errorf("The parameter %s must be one of %v.", name, enum)
+	}
+	return nil
+}
+
+// Validate checks the parameters against the schema.
+func (schema ParametersSchema) Validate(parameters map[string]interface{}) error {
+	properties := schema.Properties()
+	names := make([]string, 0)
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		property, ok := properties[name]
+		if !ok {
+			if schema.Empty() {
+				return errors.New("This plan does not accept any parameters.")
+			}
+			return errors.New("The parameter " + name + " is not supported by this plan.")
+		}
+		if err := checkPropertyValue(name, property, parameters[name]); err != nil {
+			return err
+		}
+	}
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if _, ok := parameters[fmt.Sprintf("%v", name)]; !ok {
+				return fmt.Errorf("The parameter %v is required.", name)
+			}
+		}
+	}
+	return nil
+}
+
+// Supported returns only the parameters in the schema, e.g., when an instance moves to a
+// plan that offers fewer parameters.
+func (schema ParametersSchema) Supported(parameters map[string]interface{}) map[string]interface{} {
+	properties := schema.Properties()
+	supported := make(map[string]interface{})
+	for name, value := range parameters {
+		if _, ok := properties[name]; ok {
+			supported[name] = value
+		}
+	}
+	return supported
+}
+
+// Apply sets the provider settings the parameters override in the plan's private details.
+func (schema ParametersSchema) Apply(details string, parameters map[string]interface{}) (string, error) {
+	if len(parameters) == 0 {
+		return details, nil
+	}
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(details), &settings); err != nil {
+		return "", err
+	}
+	if settings == nil {
+		settings = make(map[string]interface{})
+	}
+	for name, value := range schema.Supported(parameters) {
+		path := strings.Split(schema.Setting(name), ".")
+		target := settings
+		for _, key := range path[:len(path)-1] {
+			next, ok := target[key].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				target[key] = next
+			}
+			target = next
+		}
+		target[path[len(path)-1]] = value
+	}
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// WithParameters returns a copy of the plan with the parameters applied to its provider settings.
+func (plan *ProviderPlan) WithParameters(parameters map[string]interface{}) (*ProviderPlan, error) {
+	if len(parameters) == 0 {
+		return plan, nil
+	}
+	schema, err := ParseParametersSchema(plan.parametersSchema)
+	if err != nil {
+		return nil, err
+	}
+	details, err := schema.Apply(plan.providerPrivateDetails, parameters)
+	if err != nil {
+		return nil, err
+	}
+	copied := *plan
+	copied.providerPrivateDetails = details
+	return &copied, nil
+}
+
+// ValidateParametersSchema checks a plan's schema and that every setting it overrides is one
+// the provider has.
+func ValidateParametersSchema(provider Providers, data string) error {
+	schema, err := ParseParametersSchema(data)
+	if err != nil {
+		return err
+	}
+	for name := range schema.Properties() {
+		settings, _, err := providerSettings(provider)
+		if err != nil {
+			return err
+		}
+		setting := strings.Split(schema.Setting(name), ".")[0]
+		if err := decodeStrict([]byte(`{"`+strings.Replace(setting, `"`, ``, -1)+`":null}`), settings); err != nil {
+			return errors.New("The parameter " + name + " overrides " + setting + " which is not a setting of " + string(provider) + ".")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package broker
+
+import (
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+const testParametersSchema = `{
+	"type": "object",
+	"properties": {
+		"maxmemory_policy": {
+			"type": "string",
+			"enum": ["noeviction", "allkeys-lru", "volatile-lru"],
+			"x-provider-setting": "config.maxmemory-policy"
+		},
+		"timeout": {
+			"type": "integer",
+			"minimum": 0,
+			"maximum": 86400,
+			"x-provider-setting": "config.timeout"
+		},
+		"version": {
+			"type": "string",
+			"pattern": "^[0-9]+\\.[0-9]+\\.[0-9]+$"
+		}
+	}
+}`
+
+func TestParameters(t *testing.T) {
+	Convey("Given a plan with a parameters schema.", t, func() {
+		schema, err := ParseParametersSchema(testParametersSchema)
+		So(err, ShouldBeNil)
+		So(schema.Empty(), ShouldBeFalse)
+
+		Convey("Ensure valid parameters are accepted", func() {
+			So(schema.Validate(map[string]interface{}{"maxmemory_policy": "allkeys-lru", "timeout": float64(300)}), ShouldBeNil)
+			So(schema.Validate(nil), ShouldBeNil)
+		})
+
+		Convey("Ensure invalid or unknown parameters are rejected", func() {
+			So(schema.Validate(map[string]interface{}{"maxmemory_policy": "lru"}), ShouldNotBeNil)
+			So(schema.Validate(map[string]interface{}{"timeout": float64(1.5)}), ShouldNotBeNil)
+			So(schema.Validate(map[string]interface{}{"timeout": "300"}), ShouldNotBeNil)
+			So(schema.Validate(map[string]interface{}{"timeout": float64(-1)}), ShouldNotBeNil)
+			So(schema.Validate(map[string]interface{}{"version": "latest"}), ShouldNotBeNil)
+			So(schema.Validate(map[string]interface{}{"appendonly": "yes"}), ShouldNotBeNil)
+		})
+
+		Convey("Ensure the published schema does not show provider settings", func() {
+			public, err := json.Marshal(schema.Public())
+			So(err, ShouldBeNil)
+			So(string(public), ShouldNotContainSubstring, providerSettingKeyword)
+			So(string(public), ShouldContainSubstring, `"additionalProperties":false`)
+			So(string(public), ShouldContainSubstring, `"maxmemory_policy"`)
+		})
+
+		Convey("Ensure parameters override the provider settings", func() {
+			plan := &ProviderPlan{
+				providerPrivateDetails: `{"size_in_megabytes":"512","version":"6.0.7","config":{"appendonly":"no"}}`,
+				parametersSchema:       testParametersSchema,
+			}
+			modified, err := plan.WithParameters(map[string]interface{}{"maxmemory_policy": "allkeys-lru", "version": "6.2.1"})
+			So(err, ShouldBeNil)
+			So(plan.providerPrivateDetails, ShouldEqual, `{"size_in_megabytes":"512","version":"6.0.7","config":{"appendonly":"no"}}`)
+
+			var settings redisProviderPlan
+			So(json.Unmarshal([]byte(modified.providerPrivateDetails), &settings), ShouldBeNil)
+			So(settings.Version, ShouldEqual, "6.2.1")
+			So(settings.SizeInMegabytes, ShouldEqual, "512")
+			So(settings.Config["maxmemory-policy"], ShouldEqual, "allkeys-lru")
+			So(settings.Config["appendonly"], ShouldEqual, "no")
+			So(redisConfigArgs(&settings), ShouldResemble, []string{"--appendonly", "no", "--maxmemory-policy", "allkeys-lru"})
+		})
+
+		Convey("Ensure parameters a new plan does not offer are dropped", func() {
+			other, err := ParseParametersSchema(`{"properties":{"timeout":{"type":"integer"}}}`)
+			So(err, ShouldBeNil)
+			So(other.Supported(map[string]interface{}{"maxmemory_policy": "allkeys-lru", "timeout": float64(300)}), ShouldResemble, map[string]interface{}{"timeout": float64(300)})
+		})
+	})
+
+	Convey("Given parameters schemas for a provider.", t, func() {
+		Convey("Ensure settings the provider has are accepted", func() {
+			So(ValidateParametersSchema(KubernetesRedisInstance, testParametersSchema), ShouldBeNil)
+			So(ValidateParametersSchema(AWSRedisInstance, `{"properties":{"maintenance":{"type":"string","x-provider-setting":"PreferredMaintenanceWindow"}}}`), ShouldBeNil)
+			So(ValidateParametersSchema(AWSRedisInstance, `{}`), ShouldBeNil)
+		})
+
+		Convey("Ensure settings the provider does not have are rejected", func() {
+			So(ValidateParametersSchema(AWSRedisInstance, testParametersSchema), ShouldNotBeNil)
+			So(ValidateParametersSchema(KubernetesRedisInstance, `{"properties":{"tier":{"type":"string"}}}`), ShouldNotBeNil)
+		})
+
+		Convey("Ensure malformed schemas are rejected", func() {
+			So(ValidateParametersSchema(KubernetesRedisInstance, `{"type":"array"}`), ShouldNotBeNil)
+			So(ValidateParametersSchema(KubernetesRedisInstance, `{"properties":{"timeout":{"type":"duration"}}}`), ShouldNotBeNil)
+			So(ValidateParametersSchema(KubernetesRedisInstance, `{"properties":{"version":{"type":"string","pattern":"("}}}`), ShouldNotBeNil)
+			So(ValidateParametersSchema(KubernetesRedisInstance, `{"properties":{"version":{"type":"string"}},"required":["timeout"]}`), ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,142 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/golang/glog"
+	"sort"
+)
+
+// AWSClusterRedisProvider provisions cluster mode (sharded) redis replication groups,
+// everything but provisioning and resharding is shared with the replication group provider.
+type AWSClusterRedisProvider struct {
+	*AWSReplicationGroupRedisProvider
+}
+
+func NewAWSClusterRedisProvider(ctx context.Context, namePrefix string) (*AWSClusterRedisProvider, error) {
+	replicationGroupProvider, err := NewAWSReplicationGroupRedisProvider(ctx, namePrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &AWSClusterRedisProvider{replicationGroupProvider}, nil
+}
+
+func init() {
+	RegisterProvider(AWSRedisCluster, func(ctx context.Context, namePrefix string) (Provider, error) {
+		return NewAWSClusterRedisProvider(ctx, namePrefix)
+	})
+}
+
+func (provider AWSClusterRedisProvider) GetUrl(instance *Instance) map[string]interface{} {
+	return map[string]interface{}{
+		"REDIS_URL": redisUrl(instance, instance.Endpoint),
+	}
+}
+
+func (provider AWSClusterRedisProvider) Provision(Id string, plan *ProviderPlan, Owner string) (*Instance, error) {
+	var settings elasticache.CreateReplicationGroupInput
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	if settings.NumNodeGroups == nil || *settings.NumNodeGroups < 1 {
+		return nil, errors.New("Cluster mode redis plans must specify the number of node groups (NumNodeGroups).")
+	}
+	return provider.AWSReplicationGroupRedisProvider.Provision(Id, plan, Owner)
+}
+
+// Reshard adds or removes node groups (shards) online, when removing shards the
+// lowest numbered node groups are kept and their slots are redistributed.
+func (provider AWSClusterRedisProvider) Reshard(instance *Instance, group *elasticache.ReplicationGroup, count int64) error {
+	glog.Infof("Instance: %s resharding from %d to %d node groups...\n", instance.Id, len(group.NodeGroups), count)
+	input := elasticache.ModifyReplicationGroupShardConfigurationInput{
+		ApplyImmediately:   aws.Bool(true),
+		NodeGroupCount:     aws.Int64(count),
+		ReplicationGroupId: aws.String(instance.ProviderId),
+	}
+	if count < int64(len(group.NodeGroups)) {
+		ids := make([]string, 0)
+		for _, nodeGroup := range group.NodeGroups {
+			ids = append(ids, *nodeGroup.NodeGroupId)
+		}
+		sort.Strings(ids)
+		for _, id := range ids[:count] {
+			input.NodeGroupsToRetain = append(input.NodeGroupsToRetain, aws.String(id))
+		}
+	}
+	if _, err := provider.awssvc.ModifyReplicationGroupShardConfiguration(&input); err != nil {
+		return err
+	}
+	return provider.awssvc.WaitUntilReplicationGroupAvailable(&elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(instance.ProviderId),
+	})
+}
+
+func (provider AWSClusterRedisProvider) Modify(Instance *Instance, plan *ProviderPlan) (*Instance, error) {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	if !CanBeModified(Instance.Status) {
+		return nil, errors.New("Databases cannot be modifed during backups, upgrades or while maintenance is being performed.")
+	}
+	var settings elasticache.CreateReplicationGroupInput
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	if settings.NumNodeGroups == nil || *settings.NumNodeGroups < 1 {
+		return nil, errors.New("Cluster mode redis plans must specify the number of node groups (NumNodeGroups).")
+	}
+	group, err := provider.describeReplicationGroup(Instance.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(group.NodeGroups)) != *settings.NumNodeGroups {
+		if err = provider.Reshard(Instance, group, *settings.NumNodeGroups); err != nil {
+			return nil, err
+		}
+	}
+	if len(Instance.Config) > 0 {
+		name, err := ensureParameterGroup(provider.awssvc, Instance, aws.StringValue(settings.EngineVersion), true)
+		if err != nil {
+			return nil, err
+		}
+		settings.CacheParameterGroupName = aws.String(name)
+	}
+	return provider.ModifyWithSettings(Instance, plan, &settings)
+}
+
+// Restart fails over each shard in turn, the nodes of a cluster mode group cannot be rebooted.
+// AWS only allows failing over 5 shards a day, so larger clusters cannot be restarted.
+func (provider AWSClusterRedisProvider) Restart(Instance *Instance) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	if !Instance.Ready {
+		return errors.New("Cannot restart a database that is unavailable.")
+	}
+	group, err := provider.describeReplicationGroup(Instance.ProviderId)
+	if err != nil {
+		return err
+	}
+	if len(group.NodeGroups) > 5 {
+		return errors.New("Cannot restart a redis cluster with more than 5 shards.")
+	}
+	for _, nodeGroup := range group.NodeGroups {
+		if len(nodeGroup.NodeGroupMembers) < 2 {
+			return errors.New("Cannot restart a redis cluster without replicas.")
+		}
+	}
+	for _, nodeGroup := range group.NodeGroups {
+		if err = provider.failover(Instance.ProviderId, nodeGroup.NodeGroupId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (provider AWSClusterRedisProvider) UpdateConfig(Instance *Instance) error {
+	return provider.applyConfig(Instance, true)
+}
+
+func (provider AWSClusterRedisProvider) UpgradeEngine(Instance *Instance, version string) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	return upgradeReplicationGroup(provider.awssvc, Instance, version, true)
+}
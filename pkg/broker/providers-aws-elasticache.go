@@ -0,0 +1,1411 @@
+package broker
+
+import (
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/golang/glog"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var fakeElastiCache *FakeElastiCache = nil
+
+// newElastiCacheClient returns the ElastiCache api for AWS_REGION, or a fake shared by every
+// provider when TEST is set so the AWS providers can be used without an AWS account.
+func newElastiCacheClient() (elasticacheiface.ElastiCacheAPI, error) {
+	if os.Getenv("TEST") == "true" {
+		if fakeElastiCache == nil {
+			fakeElastiCache = NewFakeElastiCache()
+		}
+		return fakeElastiCache, nil
+	}
+	if os.Getenv("AWS_REGION") == "" {
+		return nil, errors.New("Unable to find AWS_REGION environment variable.")
+	}
+	return elasticache.New(session.New(&aws.Config{Region: aws.String(os.Getenv("AWS_REGION"))})), nil
+}
+
+// instanceFromCacheCluster returns the instance of a cache cluster without a plan, the
+// plan's scheme is set by whoever knows the plan.
+func instanceFromCacheCluster(cluster *elasticache.CacheCluster) *Instance {
+	var endpoint = ""
+	if len(cluster.CacheNodes) > 0 && cluster.CacheNodes[0].Endpoint != nil && cluster.CacheNodes[0].Endpoint.Port != nil && cluster.CacheNodes[0].Endpoint.Address != nil {
+		endpoint = *cluster.CacheNodes[0].Endpoint.Address + ":" + strconv.FormatInt(*cluster.CacheNodes[0].Endpoint.Port, 10)
+	}
+	return &Instance{
+		Id:            "", // providers should not store this.
+		ProviderId:    *cluster.CacheClusterId,
+		Name:          *cluster.CacheClusterId,
+		Username:      "", // providers should not store this.
+		Password:      "", // providers should not store this.
+		Endpoint:      endpoint,
+		Status:        *cluster.CacheClusterStatus,
+		Ready:         IsReady(*cluster.CacheClusterStatus),
+		Engine:        *cluster.Engine,
+		EngineVersion: *cluster.EngineVersion,
+	}
+}
+
+// describeCacheClusters returns the instances of every cache cluster of an engine whose
+// name starts with the name prefix.
+func describeCacheClusters(svc elasticacheiface.ElastiCacheAPI, namePrefix string, engine string) (map[string]*Instance, error) {
+	instances := make(map[string]*Instance)
+	err := svc.DescribeCacheClustersPages(&elasticache.DescribeCacheClustersInput{
+		MaxRecords:        aws.Int64(100),
+		ShowCacheNodeInfo: aws.Bool(true),
+	}, func(page *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
+		for _, cluster := range page.CacheClusters {
+			if aws.StringValue(cluster.Engine) == engine && strings.HasPrefix(aws.StringValue(cluster.CacheClusterId), strings.ToLower(namePrefix)) {
+				instances[*cluster.CacheClusterId] = instanceFromCacheCluster(cluster)
+			}
+		}
+		return true
+	})
+	return instances, err
+}
+
+// getCacheClusterInstance returns the instance of a cache cluster from the cache. When it is
+// not cached the cache clusters of the engine are described all at once, so tasks going through
+// many instances describe them once a ttl rather than once for each instance, clusters the
+// refresh did not find (e.g., ones just created) are described on their own.
+func getCacheClusterInstance(svc elasticacheiface.ElastiCacheAPI, cache *InstanceCache, namePrefix string, engine string, name string, plan *ProviderPlan) (*Instance, error) {
+	instance, ok := cache.Get(name, plan)
+	if !ok {
+		err := cache.Refresh(func() (map[string]*Instance, error) {
+			return describeCacheClusters(svc, namePrefix, engine)
+		})
+		if err != nil {
+			glog.Warningf("Unable to refresh the %s cache clusters: %s\n", engine, err.Error())
+		}
+		instance, ok = cache.Get(name, plan)
+	}
+	if !ok {
+		resp, err := svc.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
+			CacheClusterId:    aws.String(name),
+			MaxRecords:        aws.Int64(20),
+			ShowCacheNodeInfo: aws.Bool(true),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.CacheClusters) == 0 {
+			return nil, errors.New("Cannot find resource instance")
+		}
+		instance = instanceFromCacheCluster(resp.CacheClusters[0])
+		cache.Set(name, instance)
+		instance.Plan = plan
+	}
+	instance.Name = name
+	instance.Scheme = plan.Scheme
+	return instance, nil
+}
+
+// fakeWaitAttempts is how many times the fake's waiters describe a resource before giving up,
+// each describe moves a resource on to its next status so a few are enough.
+const fakeWaitAttempts = 5
+
+type fakeCacheCluster struct {
+	cluster *elasticache.CacheCluster
+	next    string
+}
+
+type fakeSnapshot struct {
+	snapshot *elasticache.Snapshot
+	next     string
+}
+
+// fakeReplicationGroup is a replication group, its member clusters are kept with the other
+// clusters and are created from the member settings. The auth tokens are the ones the group
+// accepts, two while a token is being rotated.
+type fakeReplicationGroup struct {
+	group      *elasticache.ReplicationGroup
+	next       string
+	member     elasticache.CreateCacheClusterInput
+	authTokens []string
+}
+
+type fakeParameterGroup struct {
+	group      *elasticache.CacheParameterGroup
+	parameters map[string]string
+}
+
+// FakeElastiCache is an in-memory ElastiCache that simulates cache clusters, replication groups
+// (with and without cluster mode), their snapshots, tags and parameter groups. Clusters,
+// replication groups and snapshots go through the statuses AWS reports (creating, modifying,
+// snapshotting, rebooting and deleting), each describe returns the current status then moves
+// the resource on to the next one, e.g., a new cluster is described as creating once and is
+// available from then on, a deleted cluster is described as deleting once and is then gone.
+// Calls the fake does not implement panic.
+type FakeElastiCache struct {
+	elasticacheiface.ElastiCacheAPI
+	mutex             sync.Mutex
+	clusters          map[string]*fakeCacheCluster
+	replicationGroups map[string]*fakeReplicationGroup
+	snapshots         map[string]*fakeSnapshot
+	parameterGroups   map[string]*fakeParameterGroup
+	tags              map[string]map[string]string
+	engineVersions    map[string][]string
+}
+
+func NewFakeElastiCache() *FakeElastiCache {
+	return &FakeElastiCache{
+		clusters:          make(map[string]*fakeCacheCluster),
+		replicationGroups: make(map[string]*fakeReplicationGroup),
+		snapshots:         make(map[string]*fakeSnapshot),
+		parameterGroups:   make(map[string]*fakeParameterGroup),
+		tags:              make(map[string]map[string]string),
+		engineVersions: map[string][]string{
+			"redis":     {"4.0.10", "5.0.0", "5.0.4", "5.0.6", "6.0.5"},
+			"memcached": {"1.5.10", "1.5.16", "1.6.6"},
+		},
+	}
+}
+
+func fakeClusterNotFound(id string) error {
+	return awserr.New(elasticache.ErrCodeCacheClusterNotFoundFault, "CacheCluster not found: "+id, nil)
+}
+
+func fakeClusterArn(id string) string {
+	return "arn:aws:elasticache:fake-region:000000000000:cluster:" + id
+}
+
+func fakeReplicationGroupNotFound(id string) error {
+	return awserr.New(elasticache.ErrCodeReplicationGroupNotFoundFault, "ReplicationGroup not found: "+id, nil)
+}
+
+func fakeReplicationGroupArn(id string) string {
+	return "arn:aws:elasticache:fake-region:000000000000:replicationgroup:" + id
+}
+
+func fakeCacheNodes(cluster *elasticache.CacheCluster) []*elasticache.CacheNode {
+	nodes := make([]*elasticache.CacheNode, 0)
+	for i := int64(1); i <= aws.Int64Value(cluster.NumCacheNodes); i++ {
+		id := fmt.Sprintf("%04d", i)
+		nodes = append(nodes, &elasticache.CacheNode{
+			CacheNodeId:         aws.String(id),
+			CacheNodeStatus:     aws.String("available"),
+			CacheNodeCreateTime: cluster.CacheClusterCreateTime,
+			Endpoint: &elasticache.Endpoint{
+				Address: aws.String(aws.StringValue(cluster.CacheClusterId) + "." + id + ".fake.cache.amazonaws.com"),
+				Port:    cluster.ConfigurationEndpoint.Port,
+			},
+		})
+	}
+	return nodes
+}
+
+// transition sets the status of a cluster, the cluster becomes available again on the
+// describe after next.
+func (c *FakeElastiCache) transition(entry *fakeCacheCluster, status string) {
+	entry.cluster.CacheClusterStatus = aws.String(status)
+	entry.next = "available"
+}
+
+func (c *FakeElastiCache) advanceCluster(entry *fakeCacheCluster) {
+	switch entry.next {
+	case "":
+		return
+	case "deleted":
+		delete(c.clusters, aws.StringValue(entry.cluster.CacheClusterId))
+		delete(c.tags, aws.StringValue(entry.cluster.CacheClusterId))
+	default:
+		entry.cluster.CacheClusterStatus = aws.String(entry.next)
+		if entry.next == "available" && len(entry.cluster.CacheNodes) == 0 {
+			entry.cluster.CacheNodes = fakeCacheNodes(entry.cluster)
+		}
+	}
+	entry.next = ""
+}
+
+func (c *FakeElastiCache) advanceSnapshot(entry *fakeSnapshot) {
+	if entry.next != "" {
+		entry.snapshot.SnapshotStatus = aws.String(entry.next)
+		entry.next = ""
+	}
+}
+
+func (c *FakeElastiCache) cluster(id *string) (*fakeCacheCluster, error) {
+	entry, ok := c.clusters[aws.StringValue(id)]
+	if !ok {
+		return nil, fakeClusterNotFound(aws.StringValue(id))
+	}
+	return entry, nil
+}
+
+func (c *FakeElastiCache) availableCluster(id *string) (*fakeCacheCluster, error) {
+	entry, err := c.cluster(id)
+	if err != nil {
+		return nil, err
+	}
+	if aws.StringValue(entry.cluster.CacheClusterStatus) != "available" {
+		return nil, awserr.New(elasticache.ErrCodeInvalidCacheClusterStateFault, "Cache cluster "+aws.StringValue(id)+" is not in available state", nil)
+	}
+	return entry, nil
+}
+
+// ensureParameterGroupExists returns an error for parameter groups that were never created,
+// the default parameter groups always exist.
+func (c *FakeElastiCache) ensureParameterGroupExists(name *string) error {
+	if name == nil || strings.HasPrefix(*name, "default.") {
+		return nil
+	}
+	if _, ok := c.parameterGroups[*name]; !ok {
+		return awserr.New(elasticache.ErrCodeCacheParameterGroupNotFoundFault, "CacheParameterGroup "+*name+" not found.", nil)
+	}
+	return nil
+}
+
+func (c *FakeElastiCache) addSnapshot(name string, cluster *elasticache.CacheCluster, source string) (*fakeSnapshot, error) {
+	if _, ok := c.snapshots[name]; ok {
+		return nil, awserr.New(elasticache.ErrCodeSnapshotAlreadyExistsFault, "Snapshot "+name+" already exists.", nil)
+	}
+	if aws.StringValue(cluster.Engine) != "redis" {
+		return nil, awserr.New(elasticache.ErrCodeInvalidParameterValueException, "Snapshots are not supported for "+aws.StringValue(cluster.Engine)+".", nil)
+	}
+	nodes := make([]*elasticache.NodeSnapshot, 0)
+	for _, node := range cluster.CacheNodes {
+		nodes = append(nodes, &elasticache.NodeSnapshot{
+			CacheNodeId:        node.CacheNodeId,
+			CacheSize:          aws.String("0 MB"),
+			SnapshotCreateTime: aws.Time(time.Now()),
+		})
+	}
+	entry := &fakeSnapshot{
+		snapshot: &elasticache.Snapshot{
+			CacheClusterId:          cluster.CacheClusterId,
+			CacheNodeType:           cluster.CacheNodeType,
+			CacheParameterGroupName: cluster.CacheParameterGroup.CacheParameterGroupName,
+			Engine:                  cluster.Engine,
+			EngineVersion:           cluster.EngineVersion,
+			NodeSnapshots:           nodes,
+			NumCacheNodes:           cluster.NumCacheNodes,
+			Port:                    cluster.ConfigurationEndpoint.Port,
+			ReplicationGroupId:      cluster.ReplicationGroupId,
+			SnapshotName:            aws.String(name),
+			SnapshotSource:          aws.String(source),
+			SnapshotStatus:          aws.String("creating"),
+		},
+		next: "available",
+	}
+	c.snapshots[name] = entry
+	return entry, nil
+}
+
+// addCluster creates a cache cluster, the clusters of a replication group are created with it.
+func (c *FakeElastiCache) addCluster(input *elasticache.CreateCacheClusterInput) (*fakeCacheCluster, error) {
+	id := strings.ToLower(aws.StringValue(input.CacheClusterId))
+	if id == "" {
+		return nil, awserr.New(elasticache.ErrCodeInvalidParameterValueException, "The CacheClusterId parameter is required.", nil)
+	}
+	if _, ok := c.clusters[id]; ok {
+		return nil, awserr.New(elasticache.ErrCodeCacheClusterAlreadyExistsFault, "Cache cluster "+id+" already exists.", nil)
+	}
+	if err := c.ensureParameterGroupExists(input.CacheParameterGroupName); err != nil {
+		return nil, err
+	}
+	cluster := &elasticache.CacheCluster{
+		ARN:                        aws.String(fakeClusterArn(id)),
+		AuthTokenEnabled:           aws.Bool(input.AuthToken != nil),
+		AutoMinorVersionUpgrade:    input.AutoMinorVersionUpgrade,
+		CacheClusterCreateTime:     aws.Time(time.Now()),
+		CacheClusterId:             aws.String(id),
+		CacheClusterStatus:         aws.String("creating"),
+		CacheNodeType:              input.CacheNodeType,
+		CacheNodes:                 []*elasticache.CacheNode{},
+		CacheSubnetGroupName:       input.CacheSubnetGroupName,
+		Engine:                     input.Engine,
+		EngineVersion:              input.EngineVersion,
+		NumCacheNodes:              input.NumCacheNodes,
+		PreferredAvailabilityZone:  input.PreferredAvailabilityZone,
+		PreferredMaintenanceWindow: input.PreferredMaintenanceWindow,
+		ReplicationGroupId:         input.ReplicationGroupId,
+		SnapshotRetentionLimit:     input.SnapshotRetentionLimit,
+		SnapshotWindow:             input.SnapshotWindow,
+	}
+	if input.SnapshotName != nil {
+		snapshot, ok := c.snapshots[*input.SnapshotName]
+		if !ok {
+			return nil, awserr.New(elasticache.ErrCodeSnapshotNotFoundFault, "Snapshot "+*input.SnapshotName+" not found.", nil)
+		}
+		if aws.StringValue(snapshot.snapshot.SnapshotStatus) != "available" {
+			return nil, awserr.New(elasticache.ErrCodeInvalidSnapshotStateFault, "Snapshot "+*input.SnapshotName+" is not available.", nil)
+		}
+		if cluster.Engine == nil {
+			cluster.Engine = snapshot.snapshot.Engine
+		}
+		if cluster.EngineVersion == nil {
+			cluster.EngineVersion = snapshot.snapshot.EngineVersion
+		}
+		if cluster.CacheNodeType == nil {
+			cluster.CacheNodeType = snapshot.snapshot.CacheNodeType
+		}
+	}
+	if cluster.Engine == nil {
+		cluster.Engine = aws.String("redis")
+	}
+	if cluster.EngineVersion == nil {
+		versions := c.engineVersions[*cluster.Engine]
+		cluster.EngineVersion = aws.String(versions[len(versions)-1])
+	}
+	if aws.Int64Value(cluster.NumCacheNodes) < 1 {
+		cluster.NumCacheNodes = aws.Int64(1)
+	}
+	if cluster.PreferredAvailabilityZone == nil {
+		cluster.PreferredAvailabilityZone = aws.String("fake-region-1a")
+	}
+	port := input.Port
+	if port == nil && *cluster.Engine == "memcached" {
+		port = aws.Int64(11211)
+	} else if port == nil {
+		port = aws.Int64(6379)
+	}
+	cluster.ConfigurationEndpoint = &elasticache.Endpoint{Address: aws.String(id + ".fake.cache.amazonaws.com"), Port: port}
+	parameterGroup := input.CacheParameterGroupName
+	if parameterGroup == nil {
+		parameterGroup = aws.String("default." + parameterGroupFamily(*cluster.Engine, *cluster.EngineVersion))
+	}
+	cluster.CacheParameterGroup = &elasticache.CacheParameterGroupStatus{CacheParameterGroupName: parameterGroup, ParameterApplyStatus: aws.String("in-sync")}
+	for _, name := range input.CacheSecurityGroupNames {
+		cluster.CacheSecurityGroups = append(cluster.CacheSecurityGroups, &elasticache.CacheSecurityGroupMembership{CacheSecurityGroupName: name, Status: aws.String("active")})
+	}
+	for _, id := range input.SecurityGroupIds {
+		cluster.SecurityGroups = append(cluster.SecurityGroups, &elasticache.SecurityGroupMembership{SecurityGroupId: id, Status: aws.String("active")})
+	}
+	if input.NotificationTopicArn != nil {
+		cluster.NotificationConfiguration = &elasticache.NotificationConfiguration{TopicArn: input.NotificationTopicArn, TopicStatus: aws.String("active")}
+	}
+
+	entry := &fakeCacheCluster{cluster: cluster, next: "available"}
+	c.clusters[id] = entry
+	c.tags[id] = make(map[string]string)
+	for _, tag := range input.Tags {
+		c.tags[id][aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return entry, nil
+}
+
+func (c *FakeElastiCache) CreateCacheCluster(input *elasticache.CreateCacheClusterInput) (*elasticache.CreateCacheClusterOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.addCluster(input)
+	if err != nil {
+		return nil, err
+	}
+	return &elasticache.CreateCacheClusterOutput{CacheCluster: awsutil.CopyOf(entry.cluster).(*elasticache.CacheCluster)}, nil
+}
+
+func (c *FakeElastiCache) DescribeCacheClusters(input *elasticache.DescribeCacheClustersInput) (*elasticache.DescribeCacheClustersOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entries := make([]*fakeCacheCluster, 0)
+	if input.CacheClusterId != nil {
+		entry, err := c.cluster(input.CacheClusterId)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	} else {
+		for _, entry := range c.clusters {
+			entries = append(entries, entry)
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return *entries[i].cluster.CacheClusterId < *entries[j].cluster.CacheClusterId
+		})
+	}
+	clusters := make([]*elasticache.CacheCluster, 0)
+	for _, entry := range entries {
+		cluster := awsutil.CopyOf(entry.cluster).(*elasticache.CacheCluster)
+		if !aws.BoolValue(input.ShowCacheNodeInfo) {
+			cluster.CacheNodes = nil
+		}
+		clusters = append(clusters, cluster)
+		c.advanceCluster(entry)
+	}
+	return &elasticache.DescribeCacheClustersOutput{CacheClusters: clusters}, nil
+}
+
+func (c *FakeElastiCache) DescribeCacheClustersPages(input *elasticache.DescribeCacheClustersInput, fn func(*elasticache.DescribeCacheClustersOutput, bool) bool) error {
+	page, err := c.DescribeCacheClusters(input)
+	if err != nil {
+		return err
+	}
+	fn(page, true)
+	return nil
+}
+
+func (c *FakeElastiCache) ModifyCacheCluster(input *elasticache.ModifyCacheClusterInput) (*elasticache.ModifyCacheClusterOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.availableCluster(input.CacheClusterId)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.ensureParameterGroupExists(input.CacheParameterGroupName); err != nil {
+		return nil, err
+	}
+	cluster := entry.cluster
+	if input.AuthToken != nil {
+		cluster.AuthTokenEnabled = aws.Bool(true)
+		cluster.AuthTokenLastModifiedDate = aws.Time(time.Now())
+	}
+	if input.AutoMinorVersionUpgrade != nil {
+		cluster.AutoMinorVersionUpgrade = input.AutoMinorVersionUpgrade
+	}
+	if input.CacheNodeType != nil {
+		cluster.CacheNodeType = input.CacheNodeType
+	}
+	if input.CacheParameterGroupName != nil {
+		cluster.CacheParameterGroup.CacheParameterGroupName = input.CacheParameterGroupName
+	}
+	if input.EngineVersion != nil {
+		cluster.EngineVersion = input.EngineVersion
+	}
+	if input.NumCacheNodes != nil && *input.NumCacheNodes != aws.Int64Value(cluster.NumCacheNodes) {
+		cluster.NumCacheNodes = input.NumCacheNodes
+		cluster.CacheNodes = fakeCacheNodes(cluster)
+	}
+	if input.PreferredMaintenanceWindow != nil {
+		cluster.PreferredMaintenanceWindow = input.PreferredMaintenanceWindow
+	}
+	if input.SnapshotRetentionLimit != nil {
+		cluster.SnapshotRetentionLimit = input.SnapshotRetentionLimit
+	}
+	if input.SnapshotWindow != nil {
+		cluster.SnapshotWindow = input.SnapshotWindow
+	}
+	c.transition(entry, "modifying")
+	return &elasticache.ModifyCacheClusterOutput{CacheCluster: awsutil.CopyOf(cluster).(*elasticache.CacheCluster)}, nil
+}
+
+func (c *FakeElastiCache) RebootCacheCluster(input *elasticache.RebootCacheClusterInput) (*elasticache.RebootCacheClusterOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.availableCluster(input.CacheClusterId)
+	if err != nil {
+		return nil, err
+	}
+	c.transition(entry, "rebooting cluster nodes")
+	return &elasticache.RebootCacheClusterOutput{CacheCluster: awsutil.CopyOf(entry.cluster).(*elasticache.CacheCluster)}, nil
+}
+
+func (c *FakeElastiCache) DeleteCacheCluster(input *elasticache.DeleteCacheClusterInput) (*elasticache.DeleteCacheClusterOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.availableCluster(input.CacheClusterId)
+	if err != nil {
+		return nil, err
+	}
+	if input.FinalSnapshotIdentifier != nil {
+		if _, err = c.addSnapshot(*input.FinalSnapshotIdentifier, entry.cluster, "manual"); err != nil {
+			return nil, err
+		}
+	}
+	entry.cluster.CacheClusterStatus = aws.String("deleting")
+	entry.next = "deleted"
+	return &elasticache.DeleteCacheClusterOutput{CacheCluster: awsutil.CopyOf(entry.cluster).(*elasticache.CacheCluster)}, nil
+}
+
+// waitForCacheCluster describes the cluster until done says it is finished.
+func (c *FakeElastiCache) waitForCacheCluster(input *elasticache.DescribeCacheClustersInput, done func(*elasticache.DescribeCacheClustersOutput, error) (bool, error)) error {
+	for i := 0; i < fakeWaitAttempts; i++ {
+		finished, err := done(c.DescribeCacheClusters(input))
+		if err != nil || finished {
+			return err
+		}
+	}
+	return awserr.New("ResourceNotReady", "exceeded wait attempts", nil)
+}
+
+func (c *FakeElastiCache) WaitUntilCacheClusterAvailable(input *elasticache.DescribeCacheClustersInput) error {
+	return c.waitForCacheCluster(input, func(out *elasticache.DescribeCacheClustersOutput, err error) (bool, error) {
+		if err != nil {
+			return false, err
+		}
+		for _, cluster := range out.CacheClusters {
+			if aws.StringValue(cluster.CacheClusterStatus) != "available" {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+func (c *FakeElastiCache) WaitUntilCacheClusterDeleted(input *elasticache.DescribeCacheClustersInput) error {
+	return c.waitForCacheCluster(input, func(out *elasticache.DescribeCacheClustersOutput, err error) (bool, error) {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == elasticache.ErrCodeCacheClusterNotFoundFault {
+			return true, nil
+		}
+		return false, err
+	})
+}
+
+func (c *FakeElastiCache) CreateSnapshot(input *elasticache.CreateSnapshotInput) (*elasticache.CreateSnapshotOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if input.ReplicationGroupId != nil {
+		group, err := c.availableReplicationGroup(input.ReplicationGroupId)
+		if err != nil {
+			return nil, err
+		}
+		snapshot, err := c.addGroupSnapshot(aws.StringValue(input.SnapshotName), group)
+		if err != nil {
+			return nil, err
+		}
+		c.transitionReplicationGroup(group, "snapshotting")
+		return &elasticache.CreateSnapshotOutput{Snapshot: awsutil.CopyOf(snapshot.snapshot).(*elasticache.Snapshot)}, nil
+	}
+	entry, err := c.availableCluster(input.CacheClusterId)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := c.addSnapshot(aws.StringValue(input.SnapshotName), entry.cluster, "manual")
+	if err != nil {
+		return nil, err
+	}
+	c.transition(entry, "snapshotting")
+	return &elasticache.CreateSnapshotOutput{Snapshot: awsutil.CopyOf(snapshot.snapshot).(*elasticache.Snapshot)}, nil
+}
+
+func (c *FakeElastiCache) DescribeSnapshots(input *elasticache.DescribeSnapshotsInput) (*elasticache.DescribeSnapshotsOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if input.SnapshotName != nil {
+		if _, ok := c.snapshots[*input.SnapshotName]; !ok {
+			return nil, awserr.New(elasticache.ErrCodeSnapshotNotFoundFault, "Snapshot "+*input.SnapshotName+" not found.", nil)
+		}
+	}
+	entries := make([]*fakeSnapshot, 0)
+	for name, entry := range c.snapshots {
+		if input.SnapshotName != nil && *input.SnapshotName != name {
+			continue
+		}
+		if input.CacheClusterId != nil && *input.CacheClusterId != aws.StringValue(entry.snapshot.CacheClusterId) {
+			continue
+		}
+		if input.ReplicationGroupId != nil && *input.ReplicationGroupId != aws.StringValue(entry.snapshot.ReplicationGroupId) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return *entries[i].snapshot.SnapshotName < *entries[j].snapshot.SnapshotName
+	})
+	snapshots := make([]*elasticache.Snapshot, 0)
+	for _, entry := range entries {
+		snapshots = append(snapshots, awsutil.CopyOf(entry.snapshot).(*elasticache.Snapshot))
+		c.advanceSnapshot(entry)
+	}
+	return &elasticache.DescribeSnapshotsOutput{Snapshots: snapshots}, nil
+}
+
+// resourceName is the cluster or replication group a tagging call is for, by its ARN or its id.
+func (c *FakeElastiCache) resourceName(name *string) (string, error) {
+	if id := strings.TrimPrefix(aws.StringValue(name), fakeReplicationGroupArn("")); id != aws.StringValue(name) {
+		if _, ok := c.replicationGroups[id]; ok {
+			return id, nil
+		}
+	} else if id := strings.TrimPrefix(aws.StringValue(name), fakeClusterArn("")); c.clusters[id] != nil || c.replicationGroups[id] != nil {
+		return id, nil
+	}
+	return "", awserr.New(elasticache.ErrCodeInvalidARNFault, "The ARN "+aws.StringValue(name)+" is not valid.", nil)
+}
+
+func (c *FakeElastiCache) tagList(id string) []*elasticache.Tag {
+	keys := make([]string, 0)
+	for key := range c.tags[id] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	tags := make([]*elasticache.Tag, 0)
+	for _, key := range keys {
+		tags = append(tags, &elasticache.Tag{Key: aws.String(key), Value: aws.String(c.tags[id][key])})
+	}
+	return tags
+}
+
+func (c *FakeElastiCache) AddTagsToResource(input *elasticache.AddTagsToResourceInput) (*elasticache.TagListMessage, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	id, err := c.resourceName(input.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range input.Tags {
+		c.tags[id][aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return &elasticache.TagListMessage{TagList: c.tagList(id)}, nil
+}
+
+func (c *FakeElastiCache) RemoveTagsFromResource(input *elasticache.RemoveTagsFromResourceInput) (*elasticache.TagListMessage, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	id, err := c.resourceName(input.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range input.TagKeys {
+		delete(c.tags[id], aws.StringValue(key))
+	}
+	return &elasticache.TagListMessage{TagList: c.tagList(id)}, nil
+}
+
+func (c *FakeElastiCache) ListTagsForResource(input *elasticache.ListTagsForResourceInput) (*elasticache.TagListMessage, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	id, err := c.resourceName(input.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+	return &elasticache.TagListMessage{TagList: c.tagList(id)}, nil
+}
+
+func (c *FakeElastiCache) CreateCacheParameterGroup(input *elasticache.CreateCacheParameterGroupInput) (*elasticache.CreateCacheParameterGroupOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	name := aws.StringValue(input.CacheParameterGroupName)
+	if _, ok := c.parameterGroups[name]; ok {
+		return nil, awserr.New(elasticache.ErrCodeCacheParameterGroupAlreadyExistsFault, "Parameter group "+name+" already exists.", nil)
+	}
+	group := &elasticache.CacheParameterGroup{
+		CacheParameterGroupFamily: input.CacheParameterGroupFamily,
+		CacheParameterGroupName:   input.CacheParameterGroupName,
+		Description:               input.Description,
+	}
+	c.parameterGroups[name] = &fakeParameterGroup{group: group, parameters: make(map[string]string)}
+	return &elasticache.CreateCacheParameterGroupOutput{CacheParameterGroup: awsutil.CopyOf(group).(*elasticache.CacheParameterGroup)}, nil
+}
+
+func (c *FakeElastiCache) parameterGroup(name *string) (*fakeParameterGroup, error) {
+	entry, ok := c.parameterGroups[aws.StringValue(name)]
+	if !ok {
+		return nil, awserr.New(elasticache.ErrCodeCacheParameterGroupNotFoundFault, "CacheParameterGroup "+aws.StringValue(name)+" not found.", nil)
+	}
+	return entry, nil
+}
+
+func (c *FakeElastiCache) ModifyCacheParameterGroup(input *elasticache.ModifyCacheParameterGroupInput) (*elasticache.CacheParameterGroupNameMessage, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.parameterGroup(input.CacheParameterGroupName)
+	if err != nil {
+		return nil, err
+	}
+	for _, parameter := range input.ParameterNameValues {
+		entry.parameters[aws.StringValue(parameter.ParameterName)] = aws.StringValue(parameter.ParameterValue)
+	}
+	return &elasticache.CacheParameterGroupNameMessage{CacheParameterGroupName: input.CacheParameterGroupName}, nil
+}
+
+func (c *FakeElastiCache) ResetCacheParameterGroup(input *elasticache.ResetCacheParameterGroupInput) (*elasticache.CacheParameterGroupNameMessage, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.parameterGroup(input.CacheParameterGroupName)
+	if err != nil {
+		return nil, err
+	}
+	if aws.BoolValue(input.ResetAllParameters) {
+		entry.parameters = make(map[string]string)
+	}
+	for _, parameter := range input.ParameterNameValues {
+		delete(entry.parameters, aws.StringValue(parameter.ParameterName))
+	}
+	return &elasticache.CacheParameterGroupNameMessage{CacheParameterGroupName: input.CacheParameterGroupName}, nil
+}
+
+// DescribeCacheParameters only knows about the parameters that were changed, so every
+// parameter it returns has a source of user.
+func (c *FakeElastiCache) DescribeCacheParameters(input *elasticache.DescribeCacheParametersInput) (*elasticache.DescribeCacheParametersOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.parameterGroup(input.CacheParameterGroupName)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0)
+	for name := range entry.parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parameters := make([]*elasticache.Parameter, 0)
+	for _, name := range names {
+		parameters = append(parameters, &elasticache.Parameter{
+			ParameterName:  aws.String(name),
+			ParameterValue: aws.String(entry.parameters[name]),
+			Source:         aws.String("user"),
+		})
+	}
+	return &elasticache.DescribeCacheParametersOutput{Parameters: parameters}, nil
+}
+
+func (c *FakeElastiCache) DescribeCacheParameterGroupsPages(input *elasticache.DescribeCacheParameterGroupsInput, fn func(*elasticache.DescribeCacheParameterGroupsOutput, bool) bool) error {
+	c.mutex.Lock()
+	names := make([]string, 0)
+	for name := range c.parameterGroups {
+		if input.CacheParameterGroupName == nil || *input.CacheParameterGroupName == name {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	groups := make([]*elasticache.CacheParameterGroup, 0)
+	for _, name := range names {
+		groups = append(groups, awsutil.CopyOf(c.parameterGroups[name].group).(*elasticache.CacheParameterGroup))
+	}
+	c.mutex.Unlock()
+	fn(&elasticache.DescribeCacheParameterGroupsOutput{CacheParameterGroups: groups}, true)
+	return nil
+}
+
+func (c *FakeElastiCache) DeleteCacheParameterGroup(input *elasticache.DeleteCacheParameterGroupInput) (*elasticache.DeleteCacheParameterGroupOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, err := c.parameterGroup(input.CacheParameterGroupName); err != nil {
+		return nil, err
+	}
+	for _, entry := range c.clusters {
+		if aws.StringValue(entry.cluster.CacheParameterGroup.CacheParameterGroupName) == aws.StringValue(input.CacheParameterGroupName) {
+			return nil, awserr.New("InvalidCacheParameterGroupState", "Parameter group "+aws.StringValue(input.CacheParameterGroupName)+" is in use.", nil)
+		}
+	}
+	delete(c.parameterGroups, aws.StringValue(input.CacheParameterGroupName))
+	return &elasticache.DeleteCacheParameterGroupOutput{}, nil
+}
+
+func (c *FakeElastiCache) DescribeCacheEngineVersionsPages(input *elasticache.DescribeCacheEngineVersionsInput, fn func(*elasticache.DescribeCacheEngineVersionsOutput, bool) bool) error {
+	c.mutex.Lock()
+	versions := make([]*elasticache.CacheEngineVersion, 0)
+	for engine, engineVersions := range c.engineVersions {
+		if input.Engine != nil && *input.Engine != engine {
+			continue
+		}
+		for _, version := range engineVersions {
+			versions = append(versions, &elasticache.CacheEngineVersion{
+				CacheParameterGroupFamily: aws.String(parameterGroupFamily(engine, version)),
+				Engine:                    aws.String(engine),
+				EngineVersion:             aws.String(version),
+			})
+		}
+	}
+	c.mutex.Unlock()
+	fn(&elasticache.DescribeCacheEngineVersionsOutput{CacheEngineVersions: versions}, true)
+	return nil
+}
+
+func (c *FakeElastiCache) replicationGroup(id *string) (*fakeReplicationGroup, error) {
+	entry, ok := c.replicationGroups[aws.StringValue(id)]
+	if !ok {
+		return nil, fakeReplicationGroupNotFound(aws.StringValue(id))
+	}
+	return entry, nil
+}
+
+func (c *FakeElastiCache) availableReplicationGroup(id *string) (*fakeReplicationGroup, error) {
+	entry, err := c.replicationGroup(id)
+	if err != nil {
+		return nil, err
+	}
+	if aws.StringValue(entry.group.Status) != "available" {
+		return nil, awserr.New(elasticache.ErrCodeInvalidReplicationGroupStateFault, "Replication group "+aws.StringValue(id)+" is not in available state", nil)
+	}
+	return entry, nil
+}
+
+// transitionReplicationGroup sets the status of a replication group, the group becomes
+// available again on the describe after next.
+func (c *FakeElastiCache) transitionReplicationGroup(entry *fakeReplicationGroup, status string) {
+	entry.group.Status = aws.String(status)
+	entry.next = "available"
+}
+
+// advanceReplicationGroup moves a replication group and its member clusters on to their next
+// status, a deleted group is removed along with its members.
+func (c *FakeElastiCache) advanceReplicationGroup(entry *fakeReplicationGroup) {
+	switch entry.next {
+	case "":
+		return
+	case "deleted":
+		for _, member := range entry.group.MemberClusters {
+			delete(c.clusters, aws.StringValue(member))
+			delete(c.tags, aws.StringValue(member))
+		}
+		delete(c.replicationGroups, aws.StringValue(entry.group.ReplicationGroupId))
+		delete(c.tags, aws.StringValue(entry.group.ReplicationGroupId))
+	default:
+		entry.group.Status = aws.String(entry.next)
+		for _, member := range entry.group.MemberClusters {
+			if cluster, ok := c.clusters[aws.StringValue(member)]; ok {
+				c.advanceCluster(cluster)
+			}
+		}
+	}
+	entry.next = ""
+}
+
+// members calls fn with every member cluster of the replication group.
+func (c *FakeElastiCache) members(entry *fakeReplicationGroup, fn func(*elasticache.CacheCluster)) {
+	for _, member := range entry.group.MemberClusters {
+		if cluster, ok := c.clusters[aws.StringValue(member)]; ok {
+			fn(cluster.cluster)
+		}
+	}
+}
+
+func fakeReplicasPerNodeGroup(group *elasticache.ReplicationGroup) int64 {
+	if len(group.NodeGroups) == 0 {
+		return 0
+	}
+	return int64(len(group.NodeGroups[0].NodeGroupMembers)) - 1
+}
+
+// addMember creates a member cluster in the node group, named the way AWS names them, the
+// first member of a node group is its primary.
+func (c *FakeElastiCache) addMember(entry *fakeReplicationGroup, nodeGroup *elasticache.NodeGroup) error {
+	prefix := aws.StringValue(entry.group.ReplicationGroupId) + "-"
+	if aws.BoolValue(entry.group.ClusterEnabled) {
+		prefix = prefix + aws.StringValue(nodeGroup.NodeGroupId) + "-"
+	}
+	name := ""
+	for i := 1; name == "" || c.clusters[name] != nil; i++ {
+		name = fmt.Sprintf("%s%03d", prefix, i)
+	}
+	input := entry.member
+	input.CacheClusterId = aws.String(name)
+	cluster, err := c.addCluster(&input)
+	if err != nil {
+		return err
+	}
+	member := &elasticache.NodeGroupMember{
+		CacheClusterId:            cluster.cluster.CacheClusterId,
+		CacheNodeId:               aws.String("0001"),
+		PreferredAvailabilityZone: cluster.cluster.PreferredAvailabilityZone,
+		ReadEndpoint:              &elasticache.Endpoint{Address: aws.String(name + ".fake.cache.amazonaws.com"), Port: cluster.cluster.ConfigurationEndpoint.Port},
+	}
+	if !aws.BoolValue(entry.group.ClusterEnabled) {
+		member.CurrentRole = aws.String("replica")
+		if len(nodeGroup.NodeGroupMembers) == 0 {
+			member.CurrentRole = aws.String("primary")
+		}
+	}
+	nodeGroup.NodeGroupMembers = append(nodeGroup.NodeGroupMembers, member)
+	return nil
+}
+
+func (c *FakeElastiCache) removeMember(nodeGroup *elasticache.NodeGroup, index int) {
+	id := aws.StringValue(nodeGroup.NodeGroupMembers[index].CacheClusterId)
+	delete(c.clusters, id)
+	delete(c.tags, id)
+	nodeGroup.NodeGroupMembers = append(nodeGroup.NodeGroupMembers[:index], nodeGroup.NodeGroupMembers[index+1:]...)
+}
+
+// addNodeGroup adds a node group with the next free id, a primary and its replicas.
+func (c *FakeElastiCache) addNodeGroup(entry *fakeReplicationGroup, replicas int64) error {
+	id := ""
+	for i := 1; id == "" || fakeNodeGroup(entry.group, id) != nil; i++ {
+		id = fmt.Sprintf("%04d", i)
+	}
+	nodeGroup := &elasticache.NodeGroup{NodeGroupId: aws.String(id), Status: aws.String("available")}
+	entry.group.NodeGroups = append(entry.group.NodeGroups, nodeGroup)
+	for i := int64(0); i <= replicas; i++ {
+		if err := c.addMember(entry, nodeGroup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fakeNodeGroup(group *elasticache.ReplicationGroup, id string) *elasticache.NodeGroup {
+	for _, nodeGroup := range group.NodeGroups {
+		if aws.StringValue(nodeGroup.NodeGroupId) == id {
+			return nodeGroup
+		}
+	}
+	return nil
+}
+
+// refreshReplicationGroup lists the member clusters of the node groups and spreads the
+// slots of a cluster mode group evenly over its node groups.
+func (c *FakeElastiCache) refreshReplicationGroup(entry *fakeReplicationGroup) {
+	group := entry.group
+	group.MemberClusters = make([]*string, 0)
+	for i, nodeGroup := range group.NodeGroups {
+		for _, member := range nodeGroup.NodeGroupMembers {
+			group.MemberClusters = append(group.MemberClusters, member.CacheClusterId)
+		}
+		if aws.BoolValue(group.ClusterEnabled) {
+			nodeGroup.Slots = aws.String(fmt.Sprintf("%d-%d", 16384*i/len(group.NodeGroups), 16384*(i+1)/len(group.NodeGroups)-1))
+		}
+	}
+}
+
+func (c *FakeElastiCache) addGroupSnapshot(name string, entry *fakeReplicationGroup) (*fakeSnapshot, error) {
+	if _, ok := c.snapshots[name]; ok {
+		return nil, awserr.New(elasticache.ErrCodeSnapshotAlreadyExistsFault, "Snapshot "+name+" already exists.", nil)
+	}
+	nodes := make([]*elasticache.NodeSnapshot, 0)
+	for _, nodeGroup := range entry.group.NodeGroups {
+		nodes = append(nodes, &elasticache.NodeSnapshot{
+			CacheClusterId:     nodeGroup.NodeGroupMembers[0].CacheClusterId,
+			CacheNodeId:        nodeGroup.NodeGroupMembers[0].CacheNodeId,
+			CacheSize:          aws.String("0 MB"),
+			NodeGroupId:        nodeGroup.NodeGroupId,
+			SnapshotCreateTime: aws.Time(time.Now()),
+		})
+	}
+	member := entry.member
+	snapshot := &fakeSnapshot{
+		snapshot: &elasticache.Snapshot{
+			AutomaticFailover:           entry.group.AutomaticFailover,
+			CacheNodeType:               member.CacheNodeType,
+			CacheParameterGroupName:     member.CacheParameterGroupName,
+			Engine:                      member.Engine,
+			EngineVersion:               member.EngineVersion,
+			NodeSnapshots:               nodes,
+			NumNodeGroups:               aws.Int64(int64(len(entry.group.NodeGroups))),
+			Port:                        member.Port,
+			ReplicationGroupDescription: entry.group.Description,
+			ReplicationGroupId:          entry.group.ReplicationGroupId,
+			SnapshotName:                aws.String(name),
+			SnapshotSource:              aws.String("manual"),
+			SnapshotStatus:              aws.String("creating"),
+		},
+		next: "available",
+	}
+	c.snapshots[name] = snapshot
+	return snapshot, nil
+}
+
+func fakeEnabled(enabled bool) *string {
+	if enabled {
+		return aws.String("enabled")
+	}
+	return aws.String("disabled")
+}
+
+// CreateReplicationGroup creates a group in cluster mode when it has node groups or a cluster
+// mode parameter group, otherwise a single node group with a primary and reader endpoint.
+func (c *FakeElastiCache) CreateReplicationGroup(input *elasticache.CreateReplicationGroupInput) (*elasticache.CreateReplicationGroupOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	id := strings.ToLower(aws.StringValue(input.ReplicationGroupId))
+	if id == "" {
+		return nil, awserr.New(elasticache.ErrCodeInvalidParameterValueException, "The ReplicationGroupId parameter is required.", nil)
+	}
+	if _, ok := c.replicationGroups[id]; ok {
+		return nil, awserr.New(elasticache.ErrCodeReplicationGroupAlreadyExistsFault, "Replication group "+id+" already exists.", nil)
+	}
+	if input.AuthToken != nil && !aws.BoolValue(input.TransitEncryptionEnabled) {
+		return nil, awserr.New(elasticache.ErrCodeInvalidParameterValueException, "The AuthToken parameter requires TransitEncryptionEnabled.", nil)
+	}
+	if err := c.ensureParameterGroupExists(input.CacheParameterGroupName); err != nil {
+		return nil, err
+	}
+	member := elasticache.CreateCacheClusterInput{
+		AuthToken:                  input.AuthToken,
+		AutoMinorVersionUpgrade:    input.AutoMinorVersionUpgrade,
+		CacheNodeType:              input.CacheNodeType,
+		CacheParameterGroupName:    input.CacheParameterGroupName,
+		CacheSecurityGroupNames:    input.CacheSecurityGroupNames,
+		CacheSubnetGroupName:       input.CacheSubnetGroupName,
+		Engine:                     input.Engine,
+		EngineVersion:              input.EngineVersion,
+		NotificationTopicArn:       input.NotificationTopicArn,
+		NumCacheNodes:              aws.Int64(1),
+		Port:                       input.Port,
+		PreferredMaintenanceWindow: input.PreferredMaintenanceWindow,
+		ReplicationGroupId:         aws.String(id),
+		SecurityGroupIds:           input.SecurityGroupIds,
+		SnapshotRetentionLimit:     input.SnapshotRetentionLimit,
+		SnapshotWindow:             input.SnapshotWindow,
+		Tags:                       input.Tags,
+	}
+	if input.SnapshotName != nil {
+		snapshot, ok := c.snapshots[*input.SnapshotName]
+		if !ok {
+			return nil, awserr.New(elasticache.ErrCodeSnapshotNotFoundFault, "Snapshot "+*input.SnapshotName+" not found.", nil)
+		}
+		if aws.StringValue(snapshot.snapshot.SnapshotStatus) != "available" {
+			return nil, awserr.New(elasticache.ErrCodeInvalidSnapshotStateFault, "Snapshot "+*input.SnapshotName+" is not available.", nil)
+		}
+		if member.EngineVersion == nil {
+			member.EngineVersion = snapshot.snapshot.EngineVersion
+		}
+		if member.CacheNodeType == nil {
+			member.CacheNodeType = snapshot.snapshot.CacheNodeType
+		}
+	}
+	if member.Engine == nil {
+		member.Engine = aws.String("redis")
+	}
+	if member.EngineVersion == nil {
+		versions := c.engineVersions[*member.Engine]
+		member.EngineVersion = aws.String(versions[len(versions)-1])
+	}
+	if member.Port == nil {
+		member.Port = aws.Int64(6379)
+	}
+
+	clusterEnabled := input.NumNodeGroups != nil || strings.HasSuffix(aws.StringValue(input.CacheParameterGroupName), ".cluster.on")
+	nodeGroups := int64(1)
+	replicas := aws.Int64Value(input.NumCacheClusters) - 1
+	if clusterEnabled {
+		nodeGroups = aws.Int64Value(input.NumNodeGroups)
+		replicas = aws.Int64Value(input.ReplicasPerNodeGroup)
+	}
+	if nodeGroups < 1 {
+		nodeGroups = 1
+	}
+	if replicas < 0 {
+		replicas = 0
+	}
+	if aws.BoolValue(input.AutomaticFailoverEnabled) && replicas < 1 {
+		return nil, awserr.New(elasticache.ErrCodeInvalidParameterCombinationException, "Automatic failover needs at least one replica.", nil)
+	}
+
+	group := &elasticache.ReplicationGroup{
+		ARN:                      aws.String(fakeReplicationGroupArn(id)),
+		AtRestEncryptionEnabled:  aws.Bool(aws.BoolValue(input.AtRestEncryptionEnabled)),
+		AuthTokenEnabled:         aws.Bool(input.AuthToken != nil),
+		AutomaticFailover:        fakeEnabled(aws.BoolValue(input.AutomaticFailoverEnabled)),
+		CacheNodeType:            member.CacheNodeType,
+		ClusterEnabled:           aws.Bool(clusterEnabled),
+		Description:              input.ReplicationGroupDescription,
+		MultiAZ:                  fakeEnabled(aws.BoolValue(input.MultiAZEnabled)),
+		NodeGroups:               []*elasticache.NodeGroup{},
+		ReplicationGroupId:       aws.String(id),
+		SnapshotRetentionLimit:   input.SnapshotRetentionLimit,
+		SnapshotWindow:           input.SnapshotWindow,
+		Status:                   aws.String("creating"),
+		TransitEncryptionEnabled: aws.Bool(aws.BoolValue(input.TransitEncryptionEnabled)),
+	}
+	entry := &fakeReplicationGroup{group: group, next: "available", member: member}
+	if input.AuthToken != nil {
+		entry.authTokens = []string{*input.AuthToken}
+	}
+	for i := int64(0); i < nodeGroups; i++ {
+		if err := c.addNodeGroup(entry, replicas); err != nil {
+			return nil, err
+		}
+	}
+	if clusterEnabled {
+		group.ConfigurationEndpoint = &elasticache.Endpoint{Address: aws.String("clustercfg." + id + ".fake.cache.amazonaws.com"), Port: member.Port}
+	} else {
+		group.NodeGroups[0].PrimaryEndpoint = &elasticache.Endpoint{Address: aws.String("master." + id + ".fake.cache.amazonaws.com"), Port: member.Port}
+		group.NodeGroups[0].ReaderEndpoint = &elasticache.Endpoint{Address: aws.String("replica." + id + ".fake.cache.amazonaws.com"), Port: member.Port}
+	}
+	c.refreshReplicationGroup(entry)
+
+	c.replicationGroups[id] = entry
+	c.tags[id] = make(map[string]string)
+	for _, tag := range input.Tags {
+		c.tags[id][aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return &elasticache.CreateReplicationGroupOutput{ReplicationGroup: awsutil.CopyOf(group).(*elasticache.ReplicationGroup)}, nil
+}
+
+func (c *FakeElastiCache) DescribeReplicationGroups(input *elasticache.DescribeReplicationGroupsInput) (*elasticache.DescribeReplicationGroupsOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entries := make([]*fakeReplicationGroup, 0)
+	if input.ReplicationGroupId != nil {
+		entry, err := c.replicationGroup(input.ReplicationGroupId)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	} else {
+		for _, entry := range c.replicationGroups {
+			entries = append(entries, entry)
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return *entries[i].group.ReplicationGroupId < *entries[j].group.ReplicationGroupId
+		})
+	}
+	groups := make([]*elasticache.ReplicationGroup, 0)
+	for _, entry := range entries {
+		groups = append(groups, awsutil.CopyOf(entry.group).(*elasticache.ReplicationGroup))
+		c.advanceReplicationGroup(entry)
+	}
+	return &elasticache.DescribeReplicationGroupsOutput{ReplicationGroups: groups}, nil
+}
+
+func (c *FakeElastiCache) DescribeReplicationGroupsPages(input *elasticache.DescribeReplicationGroupsInput, fn func(*elasticache.DescribeReplicationGroupsOutput, bool) bool) error {
+	page, err := c.DescribeReplicationGroups(input)
+	if err != nil {
+		return err
+	}
+	fn(page, true)
+	return nil
+}
+
+// ModifyReplicationGroup changes the group and its member clusters, a ROTATE of the auth token
+// keeps the current token along with the new one, anything else replaces it.
+func (c *FakeElastiCache) ModifyReplicationGroup(input *elasticache.ModifyReplicationGroupInput) (*elasticache.ModifyReplicationGroupOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.availableReplicationGroup(input.ReplicationGroupId)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.ensureParameterGroupExists(input.CacheParameterGroupName); err != nil {
+		return nil, err
+	}
+	group := entry.group
+	if input.AuthToken != nil {
+		if !aws.BoolValue(group.TransitEncryptionEnabled) {
+			return nil, awserr.New(elasticache.ErrCodeInvalidParameterValueException, "The AuthToken parameter requires TransitEncryptionEnabled.", nil)
+		}
+		if aws.StringValue(input.AuthTokenUpdateStrategy) == elasticache.AuthTokenUpdateStrategyTypeRotate && len(entry.authTokens) > 0 {
+			entry.authTokens = []string{entry.authTokens[len(entry.authTokens)-1], *input.AuthToken}
+		} else {
+			entry.authTokens = []string{*input.AuthToken}
+		}
+		group.AuthTokenEnabled = aws.Bool(true)
+		group.AuthTokenLastModifiedDate = aws.Time(time.Now())
+	}
+	if input.AutomaticFailoverEnabled != nil {
+		if *input.AutomaticFailoverEnabled && fakeReplicasPerNodeGroup(group) < 1 {
+			return nil, awserr.New(elasticache.ErrCodeInvalidParameterCombinationException, "Automatic failover needs at least one replica.", nil)
+		}
+		group.AutomaticFailover = fakeEnabled(*input.AutomaticFailoverEnabled)
+	}
+	if input.MultiAZEnabled != nil {
+		group.MultiAZ = fakeEnabled(*input.MultiAZEnabled)
+	}
+	if input.AutoMinorVersionUpgrade != nil {
+		entry.member.AutoMinorVersionUpgrade = input.AutoMinorVersionUpgrade
+	}
+	if input.CacheNodeType != nil {
+		group.CacheNodeType = input.CacheNodeType
+		entry.member.CacheNodeType = input.CacheNodeType
+	}
+	if input.CacheParameterGroupName != nil {
+		entry.member.CacheParameterGroupName = input.CacheParameterGroupName
+	}
+	if input.EngineVersion != nil {
+		entry.member.EngineVersion = input.EngineVersion
+	}
+	if input.PreferredMaintenanceWindow != nil {
+		entry.member.PreferredMaintenanceWindow = input.PreferredMaintenanceWindow
+	}
+	if input.SnapshotRetentionLimit != nil {
+		group.SnapshotRetentionLimit = input.SnapshotRetentionLimit
+		entry.member.SnapshotRetentionLimit = input.SnapshotRetentionLimit
+	}
+	if input.SnapshotWindow != nil {
+		group.SnapshotWindow = input.SnapshotWindow
+		entry.member.SnapshotWindow = input.SnapshotWindow
+	}
+	if input.SnapshottingClusterId != nil {
+		group.SnapshottingClusterId = input.SnapshottingClusterId
+	}
+	c.members(entry, func(cluster *elasticache.CacheCluster) {
+		cluster.AutoMinorVersionUpgrade = entry.member.AutoMinorVersionUpgrade
+		cluster.AuthTokenEnabled = group.AuthTokenEnabled
+		cluster.CacheNodeType = entry.member.CacheNodeType
+		cluster.EngineVersion = entry.member.EngineVersion
+		cluster.PreferredMaintenanceWindow = entry.member.PreferredMaintenanceWindow
+		cluster.SnapshotRetentionLimit = entry.member.SnapshotRetentionLimit
+		cluster.SnapshotWindow = entry.member.SnapshotWindow
+		if entry.member.CacheParameterGroupName != nil {
+			cluster.CacheParameterGroup.CacheParameterGroupName = entry.member.CacheParameterGroupName
+		}
+	})
+	c.transitionReplicationGroup(entry, "modifying")
+	return &elasticache.ModifyReplicationGroupOutput{ReplicationGroup: awsutil.CopyOf(group).(*elasticache.ReplicationGroup)}, nil
+}
+
+// ModifyReplicationGroupShardConfiguration adds node groups with as many replicas as the
+// others have, or removes the node groups that are not retained.
+func (c *FakeElastiCache) ModifyReplicationGroupShardConfiguration(input *elasticache.ModifyReplicationGroupShardConfigurationInput) (*elasticache.ModifyReplicationGroupShardConfigurationOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.availableReplicationGroup(input.ReplicationGroupId)
+	if err != nil {
+		return nil, err
+	}
+	group := entry.group
+	if !aws.BoolValue(group.ClusterEnabled) {
+		return nil, awserr.New(elasticache.ErrCodeInvalidReplicationGroupStateFault, "Replication group "+aws.StringValue(group.ReplicationGroupId)+" is not in cluster mode.", nil)
+	}
+	count := aws.Int64Value(input.NodeGroupCount)
+	if count < 1 || count == int64(len(group.NodeGroups)) {
+		return nil, awserr.New(elasticache.ErrCodeInvalidParameterValueException, "The NodeGroupCount parameter must change the number of node groups.", nil)
+	}
+	if count < int64(len(group.NodeGroups)) {
+		remove := make(map[string]bool)
+		if len(input.NodeGroupsToRetain) > 0 {
+			for _, nodeGroup := range group.NodeGroups {
+				remove[aws.StringValue(nodeGroup.NodeGroupId)] = true
+			}
+			for _, id := range input.NodeGroupsToRetain {
+				delete(remove, aws.StringValue(id))
+			}
+		} else {
+			for _, id := range input.NodeGroupsToRemove {
+				remove[aws.StringValue(id)] = true
+			}
+		}
+		if int64(len(group.NodeGroups)-len(remove)) != count {
+			return nil, awserr.New(elasticache.ErrCodeInvalidParameterValueException, "The node groups to retain or remove do not match the NodeGroupCount parameter.", nil)
+		}
+		nodeGroups := make([]*elasticache.NodeGroup, 0)
+		for _, nodeGroup := range group.NodeGroups {
+			if !remove[aws.StringValue(nodeGroup.NodeGroupId)] {
+				nodeGroups = append(nodeGroups, nodeGroup)
+				continue
+			}
+			for len(nodeGroup.NodeGroupMembers) > 0 {
+				c.removeMember(nodeGroup, 0)
+			}
+		}
+		group.NodeGroups = nodeGroups
+	}
+	replicas := fakeReplicasPerNodeGroup(group)
+	for int64(len(group.NodeGroups)) < count {
+		if err = c.addNodeGroup(entry, replicas); err != nil {
+			return nil, err
+		}
+	}
+	c.refreshReplicationGroup(entry)
+	c.transitionReplicationGroup(entry, "modifying")
+	return &elasticache.ModifyReplicationGroupShardConfigurationOutput{ReplicationGroup: awsutil.CopyOf(group).(*elasticache.ReplicationGroup)}, nil
+}
+
+// changeReplicaCount adds or removes replicas in every node group, replicas are removed
+// newest first.
+func (c *FakeElastiCache) changeReplicaCount(id *string, count *int64, increase bool) (*elasticache.ReplicationGroup, error) {
+	entry, err := c.availableReplicationGroup(id)
+	if err != nil {
+		return nil, err
+	}
+	group := entry.group
+	current := fakeReplicasPerNodeGroup(group)
+	if count == nil || (increase && *count <= current) || (!increase && (*count >= current || *count < 0)) {
+		return nil, awserr.New(elasticache.ErrCodeInvalidParameterValueException, "The NewReplicaCount parameter is not valid for replication group "+aws.StringValue(id)+".", nil)
+	}
+	if !increase && *count < 1 && aws.StringValue(group.AutomaticFailover) == elasticache.AutomaticFailoverStatusEnabled {
+		return nil, awserr.New(elasticache.ErrCodeInvalidReplicationGroupStateFault, "Automatic failover needs at least one replica.", nil)
+	}
+	for _, nodeGroup := range group.NodeGroups {
+		for int64(len(nodeGroup.NodeGroupMembers))-1 < *count {
+			if err = c.addMember(entry, nodeGroup); err != nil {
+				return nil, err
+			}
+		}
+		for int64(len(nodeGroup.NodeGroupMembers))-1 > *count {
+			c.removeMember(nodeGroup, len(nodeGroup.NodeGroupMembers)-1)
+		}
+	}
+	c.refreshReplicationGroup(entry)
+	c.transitionReplicationGroup(entry, "modifying")
+	return awsutil.CopyOf(group).(*elasticache.ReplicationGroup), nil
+}
+
+func (c *FakeElastiCache) IncreaseReplicaCount(input *elasticache.IncreaseReplicaCountInput) (*elasticache.IncreaseReplicaCountOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	group, err := c.changeReplicaCount(input.ReplicationGroupId, input.NewReplicaCount, true)
+	if err != nil {
+		return nil, err
+	}
+	return &elasticache.IncreaseReplicaCountOutput{ReplicationGroup: group}, nil
+}
+
+func (c *FakeElastiCache) DecreaseReplicaCount(input *elasticache.DecreaseReplicaCountInput) (*elasticache.DecreaseReplicaCountOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	group, err := c.changeReplicaCount(input.ReplicationGroupId, input.NewReplicaCount, false)
+	if err != nil {
+		return nil, err
+	}
+	return &elasticache.DecreaseReplicaCountOutput{ReplicationGroup: group}, nil
+}
+
+// TestFailover promotes the first replica of the node group, the old primary becomes a replica.
+func (c *FakeElastiCache) TestFailover(input *elasticache.TestFailoverInput) (*elasticache.TestFailoverOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.availableReplicationGroup(input.ReplicationGroupId)
+	if err != nil {
+		return nil, err
+	}
+	nodeGroup := fakeNodeGroup(entry.group, aws.StringValue(input.NodeGroupId))
+	if nodeGroup == nil {
+		return nil, awserr.New(elasticache.ErrCodeNodeGroupNotFoundFault, "Node group "+aws.StringValue(input.NodeGroupId)+" not found.", nil)
+	}
+	if aws.StringValue(entry.group.AutomaticFailover) != elasticache.AutomaticFailoverStatusEnabled || len(nodeGroup.NodeGroupMembers) < 2 {
+		return nil, awserr.New(elasticache.ErrCodeInvalidReplicationGroupStateFault, "Automatic failover is not enabled on replication group "+aws.StringValue(input.ReplicationGroupId)+".", nil)
+	}
+	members := nodeGroup.NodeGroupMembers
+	members[0], members[1] = members[1], members[0]
+	if !aws.BoolValue(entry.group.ClusterEnabled) {
+		members[0].CurrentRole = aws.String("primary")
+		members[1].CurrentRole = aws.String("replica")
+	}
+	c.refreshReplicationGroup(entry)
+	c.transitionReplicationGroup(entry, "modifying")
+	return &elasticache.TestFailoverOutput{ReplicationGroup: awsutil.CopyOf(entry.group).(*elasticache.ReplicationGroup)}, nil
+}
+
+func (c *FakeElastiCache) DeleteReplicationGroup(input *elasticache.DeleteReplicationGroupInput) (*elasticache.DeleteReplicationGroupOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, err := c.availableReplicationGroup(input.ReplicationGroupId)
+	if err != nil {
+		return nil, err
+	}
+	if input.FinalSnapshotIdentifier != nil {
+		if _, err = c.addGroupSnapshot(*input.FinalSnapshotIdentifier, entry); err != nil {
+			return nil, err
+		}
+	}
+	entry.group.Status = aws.String("deleting")
+	entry.next = "deleted"
+	c.members(entry, func(cluster *elasticache.CacheCluster) {
+		cluster.CacheClusterStatus = aws.String("deleting")
+	})
+	return &elasticache.DeleteReplicationGroupOutput{ReplicationGroup: awsutil.CopyOf(entry.group).(*elasticache.ReplicationGroup)}, nil
+}
+
+// waitForReplicationGroup describes the replication group until done says it is finished.
+func (c *FakeElastiCache) waitForReplicationGroup(input *elasticache.DescribeReplicationGroupsInput, done func(*elasticache.DescribeReplicationGroupsOutput, error) (bool, error)) error {
+	for i := 0; i < fakeWaitAttempts; i++ {
+		finished, err := done(c.DescribeReplicationGroups(input))
+		if err != nil || finished {
+			return err
+		}
+	}
+	return awserr.New("ResourceNotReady", "exceeded wait attempts", nil)
+}
+
+func (c *FakeElastiCache) WaitUntilReplicationGroupAvailable(input *elasticache.DescribeReplicationGroupsInput) error {
+	return c.waitForReplicationGroup(input, func(out *elasticache.DescribeReplicationGroupsOutput, err error) (bool, error) {
+		if err != nil {
+			return false, err
+		}
+		for _, group := range out.ReplicationGroups {
+			if aws.StringValue(group.Status) != "available" {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+func (c *FakeElastiCache) WaitUntilReplicationGroupDeleted(input *elasticache.DescribeReplicationGroupsInput) error {
+	return c.waitForReplicationGroup(input, func(out *elasticache.DescribeReplicationGroupsOutput, err error) (bool, error) {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == elasticache.ErrCodeReplicationGroupNotFoundFault {
+			return true, nil
+		}
+		return false, err
+	})
+}
@@ -0,0 +1,68 @@
+package broker
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+)
+
+// awsEngineVersions lists the versions of an engine ElastiCache offers in the region.
+func awsEngineVersions(svc elasticacheiface.ElastiCacheAPI, engine string) ([]string, error) {
+	versions := make([]string, 0)
+	err := svc.DescribeCacheEngineVersionsPages(&elasticache.DescribeCacheEngineVersionsInput{
+		Engine:     aws.String(engine),
+		MaxRecords: aws.Int64(100),
+	}, func(page *elasticache.DescribeCacheEngineVersionsOutput, lastPage bool) bool {
+		for _, version := range page.CacheEngineVersions {
+			versions = append(versions, aws.StringValue(version.EngineVersion))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// upgradeParameterGroup is the parameter group an instance with its own settings moves to
+// with the new version, instances on the default parameter groups are moved by AWS.
+func upgradeParameterGroup(svc elasticacheiface.ElastiCacheAPI, instance *Instance, version string, clusterMode bool) (*string, error) {
+	if len(instance.Config) == 0 {
+		return nil, nil
+	}
+	name, err := ensureParameterGroup(svc, instance, version, clusterMode)
+	if err != nil {
+		return nil, err
+	}
+	return aws.String(name), nil
+}
+
+// upgradeCacheCluster moves a single cache cluster to a newer engine version.
+func upgradeCacheCluster(svc elasticacheiface.ElastiCacheAPI, instance *Instance, version string) error {
+	group, err := upgradeParameterGroup(svc, instance, version, false)
+	if err != nil {
+		return err
+	}
+	_, err = svc.ModifyCacheCluster(&elasticache.ModifyCacheClusterInput{
+		ApplyImmediately:        aws.Bool(true),
+		CacheClusterId:          aws.String(instance.ProviderId),
+		CacheParameterGroupName: group,
+		EngineVersion:           aws.String(version),
+	})
+	return err
+}
+
+// upgradeReplicationGroup moves every node of a replication group to a newer engine version.
+func upgradeReplicationGroup(svc elasticacheiface.ElastiCacheAPI, instance *Instance, version string, clusterMode bool) error {
+	group, err := upgradeParameterGroup(svc, instance, version, clusterMode)
+	if err != nil {
+		return err
+	}
+	_, err = svc.ModifyReplicationGroup(&elasticache.ModifyReplicationGroupInput{
+		ApplyImmediately:        aws.Bool(true),
+		CacheParameterGroupName: group,
+		EngineVersion:           aws.String(version),
+		ReplicationGroupId:      aws.String(instance.ProviderId),
+	})
+	return err
+}
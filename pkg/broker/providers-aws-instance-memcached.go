@@ -1,77 +1,45 @@
 package broker
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"io/ioutil"
 	"net"
-	"os"
 	"strconv"
 	"strings"
-	"time"
 )
 
 type AWSInstanceMemcachedProvider struct {
 	Provider
-	awssvc        *elasticache.ElastiCache
+	awssvc        elasticacheiface.ElastiCacheAPI
 	namePrefix    string
-	instanceCache map[string]*Instance
+	instanceCache *InstanceCache
 }
 
-func NewAWSInstanceMemcachedProvider(namePrefix string) (*AWSInstanceMemcachedProvider, error) {
-	if os.Getenv("AWS_REGION") == "" {
-		return nil, errors.New("Unable to find AWS_REGION environment variable.")
+func NewAWSInstanceMemcachedProvider(ctx context.Context, namePrefix string) (*AWSInstanceMemcachedProvider, error) {
+	awssvc, err := newElastiCacheClient()
+	if err != nil {
+		return nil, err
 	}
-	t := time.NewTicker(time.Second * 5)
-	AWSInstanceMemcachedProvider := &AWSInstanceMemcachedProvider{
+	return &AWSInstanceMemcachedProvider{
 		namePrefix:    namePrefix,
-		instanceCache: make(map[string]*Instance),
-		awssvc:        elasticache.New(session.New(&aws.Config{Region: aws.String(os.Getenv("AWS_REGION"))})),
-	}
-	go (func() {
-		for {
-			AWSInstanceMemcachedProvider.instanceCache = make(map[string]*Instance)
-			<-t.C
-		}
-	})()
-	return AWSInstanceMemcachedProvider, nil
+		instanceCache: NewInstanceCache(instanceCacheTTL),
+		awssvc:        awssvc,
+	}, nil
 }
 
-func (provider AWSInstanceMemcachedProvider) GetInstance(name string, plan *ProviderPlan) (*Instance, error) {
-	if provider.instanceCache[name+plan.ID] != nil {
-		return provider.instanceCache[name+plan.ID], nil
-	}
-	resp, err := provider.awssvc.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
-		CacheClusterId:    aws.String(name),
-		MaxRecords:        aws.Int64(20),
-		ShowCacheNodeInfo: aws.Bool(true),
+func init() {
+	RegisterProvider(AWSMemcachedInstance, func(ctx context.Context, namePrefix string) (Provider, error) {
+		return NewAWSInstanceMemcachedProvider(ctx, namePrefix)
 	})
-	if err != nil {
-		return nil, err
-	}
-	var endpoint = ""
-	if len(resp.CacheClusters) > 0 && len(resp.CacheClusters[0].CacheNodes) > 0 && resp.CacheClusters[0].CacheNodes[0].Endpoint != nil && resp.CacheClusters[0].CacheNodes[0].Endpoint.Port != nil && resp.CacheClusters[0].CacheNodes[0].Endpoint.Address != nil {
-		endpoint = *resp.CacheClusters[0].CacheNodes[0].Endpoint.Address + ":" + strconv.FormatInt(*resp.CacheClusters[0].CacheNodes[0].Endpoint.Port, 10)
-	}
-	provider.instanceCache[name+plan.ID] = &Instance{
-		Id:            "", // providers should not store this.
-		ProviderId:    *resp.CacheClusters[0].CacheClusterId,
-		Name:          name,
-		Plan:          plan,
-		Username:      "", // providers should not store this.
-		Password:      "", // providers should not store this.
-		Endpoint:      endpoint,
-		Status:        *resp.CacheClusters[0].CacheClusterStatus,
-		Ready:         IsReady(*resp.CacheClusters[0].CacheClusterStatus),
-		Engine:        *resp.CacheClusters[0].Engine,
-		EngineVersion: *resp.CacheClusters[0].EngineVersion,
-		Scheme:        plan.Scheme,
-	}
+}
 
-	return provider.instanceCache[name+plan.ID], nil
+func (provider AWSInstanceMemcachedProvider) GetInstance(name string, plan *ProviderPlan) (*Instance, error) {
+	return getCacheClusterInstance(provider.awssvc, provider.instanceCache, provider.namePrefix, "memcached", name, plan)
 }
 
 func (provider AWSInstanceMemcachedProvider) PerformPostProvision(db *Instance) (*Instance, error) {
@@ -122,6 +90,7 @@ func (provider AWSInstanceMemcachedProvider) Provision(Id string, plan *Provider
 }
 
 func (provider AWSInstanceMemcachedProvider) Deprovision(Instance *Instance, takeSnapshot bool) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
 	// memcached does not support snapshots.
 	_, err := provider.awssvc.DeleteCacheCluster(&elasticache.DeleteCacheClusterInput{
 		CacheClusterId: aws.String(Instance.ProviderId),
@@ -130,6 +99,7 @@ func (provider AWSInstanceMemcachedProvider) Deprovision(Instance *Instance, tak
 }
 
 func (provider AWSInstanceMemcachedProvider) Modify(Instance *Instance, plan *ProviderPlan) (*Instance, error) {
+	defer provider.instanceCache.Invalidate(Instance.Name)
 	// Memcached cannot be upgraded really, only a few trivial parameters can be
 	// changed, so we'll nuke the old instance, wait for it to die, then create a
 	// new instance with the same identifier.
@@ -140,6 +110,13 @@ func (provider AWSInstanceMemcachedProvider) Modify(Instance *Instance, plan *Pr
 	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
 		return nil, err
 	}
+	if len(Instance.Config) > 0 {
+		name, err := ensureParameterGroup(provider.awssvc, Instance, aws.StringValue(settings.EngineVersion), false)
+		if err != nil {
+			return nil, err
+		}
+		settings.CacheParameterGroupName = aws.String(name)
+	}
 	if err := provider.Deprovision(Instance, false); err != nil {
 		return nil, err
 	}
@@ -178,6 +155,7 @@ func (provider AWSInstanceMemcachedProvider) Untag(Instance *Instance, Name stri
 }
 
 func (provider AWSInstanceMemcachedProvider) Restart(Instance *Instance) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
 	if !Instance.Ready {
 		return errors.New("Cannot restart a database that is unavailable.")
 	}
@@ -264,6 +242,40 @@ func (provider AWSInstanceMemcachedProvider) CreateBackup(*Instance) (*BackupSpe
 	return nil, errors.New("Backups are unavailable on a memcached")
 }
 
+func (provider AWSInstanceMemcachedProvider) CopyBackup(*Instance, string) error {
+	return errors.New("Backups are unavailable on a memcached")
+}
+
 func (provider AWSInstanceMemcachedProvider) RestoreBackup(*Instance, string) error {
 	return errors.New("Backups are unavailable on a memcached")
 }
+
+func (provider AWSInstanceMemcachedProvider) CreateBindingUser(*Instance, string) (string, string, error) {
+	return "", "", errors.New("This feature is not available on this plan.")
+}
+
+func (provider AWSInstanceMemcachedProvider) DeleteBindingUser(*Instance, string) error {
+	return errors.New("This feature is not available on this plan.")
+}
+
+func (provider AWSInstanceMemcachedProvider) UpdateAuthToken(*Instance, string, string) error {
+	return errors.New("This feature is not available on this plan.")
+}
+
+func (provider AWSInstanceMemcachedProvider) UpdateConfig(Instance *Instance) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	return applyCacheClusterConfig(provider.awssvc, Instance)
+}
+
+func (provider AWSInstanceMemcachedProvider) EngineVersions(Instance *Instance) ([]string, error) {
+	versions, err := awsEngineVersions(provider.awssvc, Instance.Engine)
+	if err != nil {
+		return nil, err
+	}
+	return upgradableEngineVersions(Instance.EngineVersion, versions), nil
+}
+
+func (provider AWSInstanceMemcachedProvider) UpgradeEngine(Instance *Instance, version string) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	return upgradeCacheCluster(provider.awssvc, Instance, version)
+}
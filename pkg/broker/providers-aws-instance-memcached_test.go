@@ -0,0 +1,71 @@
+package broker
+
+import (
+	"context"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	"github.com/pmorie/osb-broker-lib/pkg/broker"
+	. "github.com/smartystreets/goconvey/convey"
+	"os"
+	"testing"
+)
+
+func TestAWSMemcachedProvision(t *testing.T) {
+	var namePrefix = "test"
+	var logic *BusinessLogic
+	var instanceId string = RandomString(12)
+	var plan = "19ed3cb3-f767-4b56-9a29-bc5254980eed"
+	var err error
+
+	os.Setenv("TEST", "true")
+
+	Convey("Given a provisioner on aws with a fake elasticache.", t, func() {
+		logic, err = NewBusinessLogic(context.TODO(), Options{DatabaseUrl: testDatabaseUrl(), NamePrefix: namePrefix})
+		So(err, ShouldBeNil)
+		So(logic, ShouldNotBeNil)
+		restartProviders()
+
+		Convey("Ensure aws provisioner can provision a memcached instance", func() {
+			request := osb.ProvisionRequest{InstanceID: instanceId, PlanID: plan, OrganizationGUID: "billing", AcceptsIncomplete: true}
+			res, err := logic.Provision(&request, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			So(res.Async, ShouldBeTrue)
+
+			state, desc := lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateInProgress)
+			So(desc, ShouldEqual, "creating")
+			state, _ = lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateSucceeded)
+
+			var guid = "123e4567-e89b-12d3-a456-426655440000"
+			bres, err := logic.Bind(&osb.BindRequest{InstanceID: instanceId, BindingID: "foo", BindResource: &osb.BindResource{AppGUID: &guid}}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			So(bres.Credentials["MEMCACHED_URL"].(string), ShouldEndWith, ".0001.fake.cache.amazonaws.com:6379")
+		})
+
+		Convey("Ensure modifying memcached replaces the cache cluster", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+			modified, err := provider.Modify(instance, instance.Plan)
+			So(err, ShouldBeNil)
+			So(modified.Name, ShouldEqual, instance.Name)
+			So(modified.Status, ShouldEqual, "creating")
+
+			_, err = provider.ListBackups(instance)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Ensure aws memcached can be deprovisioned", func() {
+			state, _ := lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateInProgress)
+			state, _ = lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateSucceeded)
+			res, err := logic.Deprovision(&osb.DeprovisionRequest{InstanceID: instanceId, AcceptsIncomplete: true}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			So(res.Async, ShouldBeFalse)
+			_, err = logic.GetInstanceById(instanceId)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -1,77 +1,50 @@
 package broker
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"github.com/go-redis/redis"
 	"github.com/golang/glog"
-	"os"
 	"strconv"
 	"strings"
 	"time"
 )
 
+// awsModifyWait is how long a cache cluster is given to start modifying before it is
+// described again.
+var awsModifyWait = time.Second * 30
+
 type AWSInstanceRedisProvider struct {
 	Provider
-	awssvc        *elasticache.ElastiCache
+	awssvc        elasticacheiface.ElastiCacheAPI
 	namePrefix    string
-	instanceCache map[string]*Instance
+	instanceCache *InstanceCache
 }
 
-func NewAWSInstanceRedisProvider(namePrefix string) (*AWSInstanceRedisProvider, error) {
-	if os.Getenv("AWS_REGION") == "" {
-		return nil, errors.New("Unable to find AWS_REGION environment variable.")
+func NewAWSInstanceRedisProvider(ctx context.Context, namePrefix string) (*AWSInstanceRedisProvider, error) {
+	awssvc, err := newElastiCacheClient()
+	if err != nil {
+		return nil, err
 	}
-	t := time.NewTicker(time.Second * 5)
-	AWSInstanceRedisProvider := &AWSInstanceRedisProvider{
+	return &AWSInstanceRedisProvider{
 		namePrefix:    namePrefix,
-		instanceCache: make(map[string]*Instance),
-		awssvc:        elasticache.New(session.New(&aws.Config{Region: aws.String(os.Getenv("AWS_REGION"))})),
-	}
-	go (func() {
-		for {
-			AWSInstanceRedisProvider.instanceCache = make(map[string]*Instance)
-			<-t.C
-		}
-	})()
-	return AWSInstanceRedisProvider, nil
+		instanceCache: NewInstanceCache(instanceCacheTTL),
+		awssvc:        awssvc,
+	}, nil
 }
 
-func (provider AWSInstanceRedisProvider) GetInstance(name string, plan *ProviderPlan) (*Instance, error) {
-	if provider.instanceCache[name+plan.ID] != nil {
-		return provider.instanceCache[name+plan.ID], nil
-	}
-	resp, err := provider.awssvc.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
-		CacheClusterId:    aws.String(name),
-		MaxRecords:        aws.Int64(20),
-		ShowCacheNodeInfo: aws.Bool(true),
+func init() {
+	RegisterProvider(AWSRedisInstance, func(ctx context.Context, namePrefix string) (Provider, error) {
+		return NewAWSInstanceRedisProvider(ctx, namePrefix)
 	})
-	if err != nil {
-		return nil, err
-	}
-	var endpoint = ""
-	if len(resp.CacheClusters) > 0 && len(resp.CacheClusters[0].CacheNodes) > 0 && resp.CacheClusters[0].CacheNodes[0].Endpoint != nil && resp.CacheClusters[0].CacheNodes[0].Endpoint.Port != nil && resp.CacheClusters[0].CacheNodes[0].Endpoint.Address != nil {
-		endpoint = *resp.CacheClusters[0].CacheNodes[0].Endpoint.Address + ":" + strconv.FormatInt(*resp.CacheClusters[0].CacheNodes[0].Endpoint.Port, 10)
-	}
-	provider.instanceCache[name+plan.ID] = &Instance{
-		Id:            "", // providers should not store this.
-		ProviderId:    *resp.CacheClusters[0].CacheClusterId,
-		Name:          name,
-		Plan:          plan,
-		Username:      "", // providers should not store this.
-		Password:      "", // providers should not store this.
-		Endpoint:      endpoint,
-		Status:        *resp.CacheClusters[0].CacheClusterStatus,
-		Ready:         IsReady(*resp.CacheClusters[0].CacheClusterStatus),
-		Engine:        *resp.CacheClusters[0].Engine,
-		EngineVersion: *resp.CacheClusters[0].EngineVersion,
-		Scheme:        plan.Scheme,
-	}
+}
 
-	return provider.instanceCache[name+plan.ID], nil
+func (provider AWSInstanceRedisProvider) GetInstance(name string, plan *ProviderPlan) (*Instance, error) {
+	return getCacheClusterInstance(provider.awssvc, provider.instanceCache, provider.namePrefix, "redis", name, plan)
 }
 
 func (provider AWSInstanceRedisProvider) PerformPostProvision(db *Instance) (*Instance, error) {
@@ -80,7 +53,7 @@ func (provider AWSInstanceRedisProvider) PerformPostProvision(db *Instance) (*In
 
 func (provider AWSInstanceRedisProvider) GetUrl(instance *Instance) map[string]interface{} {
 	return map[string]interface{}{
-		"REDIS_URL": instance.Scheme + "://" + instance.Endpoint,
+		"REDIS_URL": redisUrl(instance, instance.Endpoint),
 	}
 }
 
@@ -123,6 +96,7 @@ func (provider AWSInstanceRedisProvider) Provision(Id string, plan *ProviderPlan
 }
 
 func (provider AWSInstanceRedisProvider) Deprovision(Instance *Instance, takeSnapshot bool) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
 	var snapshot *string = nil
 	if takeSnapshot {
 		snapshot = aws.String(Instance.ProviderId + "-final")
@@ -135,6 +109,7 @@ func (provider AWSInstanceRedisProvider) Deprovision(Instance *Instance, takeSna
 }
 
 func (provider AWSInstanceRedisProvider) ModifyWithSettings(instance *Instance, plan *ProviderPlan, settings *elasticache.CreateCacheClusterInput) (*Instance, error) {
+	defer provider.instanceCache.Invalidate(instance.Name)
 	glog.Infof("Instance: %s modifying settings...\n", instance.Id)
 	// TODO: Support ModifyReplicationGroup rather than a single cache cluster.
 	resp, err := provider.awssvc.ModifyCacheCluster(&elasticache.ModifyCacheClusterInput{
@@ -157,8 +132,7 @@ func (provider AWSInstanceRedisProvider) ModifyWithSettings(instance *Instance,
 		return nil, err
 	}
 
-	tick := time.NewTicker(time.Second * 30)
-	<-tick.C
+	time.Sleep(awsModifyWait)
 
 	var endpoint = ""
 	if resp.CacheCluster.CacheNodes[0].Endpoint != nil && resp.CacheCluster.CacheNodes[0].Endpoint.Port != nil && resp.CacheCluster.CacheNodes[0].Endpoint.Address != nil {
@@ -201,6 +175,13 @@ func (provider AWSInstanceRedisProvider) Modify(Instance *Instance, plan *Provid
 	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
 		return nil, err
 	}
+	if len(Instance.Config) > 0 {
+		name, err := ensureParameterGroup(provider.awssvc, Instance, aws.StringValue(settings.EngineVersion), false)
+		if err != nil {
+			return nil, err
+		}
+		settings.CacheParameterGroupName = aws.String(name)
+	}
 	return provider.ModifyWithSettings(Instance, plan, &settings)
 }
 
@@ -228,6 +209,7 @@ func (provider AWSInstanceRedisProvider) Untag(Instance *Instance, Name string)
 }
 
 func (provider AWSInstanceRedisProvider) Restart(Instance *Instance) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
 	if !Instance.Ready {
 		return errors.New("Cannot restart a database that is unavailable.")
 	}
@@ -258,11 +240,7 @@ func (provider AWSInstanceRedisProvider) Flush(Instance *Instance) error {
 }
 
 func (provider AWSInstanceRedisProvider) Stats(Instance *Instance) ([]Stat, error) {
-	client := redis.NewClient(&redis.Options{
-		Addr:     Instance.Endpoint,
-		Password: Instance.Password,
-		DB:       0,
-	})
+	client := redis.NewClient(redisOptions(Instance))
 	defer client.Close()
 	info, err := client.Info().Result()
 	if err != nil {
@@ -353,6 +331,7 @@ func (provider AWSInstanceRedisProvider) ListBackups(instance *Instance) ([]Back
 }
 
 func (provider AWSInstanceRedisProvider) CreateBackup(instance *Instance) (*BackupSpec, error) {
+	defer provider.instanceCache.Invalidate(instance.Name)
 	if !instance.Ready {
 		return nil, errors.New("Cannot create read only user on database that is unavailable.")
 	}
@@ -389,7 +368,13 @@ func (provider AWSInstanceRedisProvider) CreateBackup(instance *Instance) (*Back
 	}, nil
 }
 
+// CopyBackup has nothing to do, snapshots are kept by elasticache.
+func (provider AWSInstanceRedisProvider) CopyBackup(*Instance, string) error {
+	return nil
+}
+
 func (provider AWSInstanceRedisProvider) RestoreBackup(instance *Instance, Id string) error {
+	defer provider.instanceCache.Invalidate(instance.Name)
 	var settings elasticache.CreateCacheClusterInput
 	if err := json.Unmarshal([]byte(instance.Plan.providerPrivateDetails), &settings); err != nil {
 		return err
@@ -521,3 +506,50 @@ func (provider AWSInstanceRedisProvider) RestoreBackup(instance *Instance, Id st
 
 	return err
 }
+
+func (provider AWSInstanceRedisProvider) CreateBindingUser(*Instance, string) (string, string, error) {
+	return "", "", errors.New("This feature is not available on this plan.")
+}
+
+func (provider AWSInstanceRedisProvider) DeleteBindingUser(*Instance, string) error {
+	return errors.New("This feature is not available on this plan.")
+}
+
+// UpdateAuthToken changes the auth token, the ROTATE strategy allows both the old and new
+// token while apps pick up the new one, SET then removes the old token.
+func (provider AWSInstanceRedisProvider) UpdateAuthToken(Instance *Instance, token string, strategy string) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	if Instance.Password == "" {
+		return errors.New("This feature is not available on this plan.")
+	}
+	_, err := provider.awssvc.ModifyCacheCluster(&elasticache.ModifyCacheClusterInput{
+		ApplyImmediately:        aws.Bool(true),
+		AuthToken:               aws.String(token),
+		AuthTokenUpdateStrategy: aws.String(strategy),
+		CacheClusterId:          aws.String(Instance.ProviderId),
+	})
+	if err != nil {
+		return err
+	}
+	return provider.awssvc.WaitUntilCacheClusterAvailable(&elasticache.DescribeCacheClustersInput{
+		CacheClusterId: aws.String(Instance.ProviderId),
+	})
+}
+
+func (provider AWSInstanceRedisProvider) UpdateConfig(Instance *Instance) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	return applyCacheClusterConfig(provider.awssvc, Instance)
+}
+
+func (provider AWSInstanceRedisProvider) EngineVersions(Instance *Instance) ([]string, error) {
+	versions, err := awsEngineVersions(provider.awssvc, Instance.Engine)
+	if err != nil {
+		return nil, err
+	}
+	return upgradableEngineVersions(Instance.EngineVersion, versions), nil
+}
+
+func (provider AWSInstanceRedisProvider) UpgradeEngine(Instance *Instance, version string) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	return upgradeCacheCluster(provider.awssvc, Instance, version)
+}
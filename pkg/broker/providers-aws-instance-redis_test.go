@@ -0,0 +1,219 @@
+package broker
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	"github.com/pmorie/osb-broker-lib/pkg/broker"
+	. "github.com/smartystreets/goconvey/convey"
+	"os"
+	"testing"
+)
+
+func describeFakeCluster(name string) *elasticache.CacheCluster {
+	resp, err := fakeElastiCache.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{CacheClusterId: aws.String(name)})
+	So(err, ShouldBeNil)
+	So(len(resp.CacheClusters), ShouldEqual, 1)
+	return resp.CacheClusters[0]
+}
+
+func lastOperationState(logic *BusinessLogic, instanceId string) (osb.LastOperationState, string) {
+	restartProviders()
+	res, err := logic.LastOperation(&osb.LastOperationRequest{InstanceID: instanceId}, &broker.RequestContext{})
+	So(err, ShouldBeNil)
+	return res.State, *res.Description
+}
+
+func TestAWSRedisProvision(t *testing.T) {
+	var namePrefix = "test"
+	var logic *BusinessLogic
+	var instanceId string = RandomString(12)
+	var hobbyPlan = "c7b8e0b0-429a-4fa8-92a0-e60d9e781cac"
+	var standardPlan = "e9f28df0-552e-4fa6-be84-92fc88998f05"
+	var err error
+
+	os.Setenv("TEST", "true")
+	awsModifyWait = 0
+
+	Convey("Given a provisioner on aws with a fake elasticache.", t, func() {
+		logic, err = NewBusinessLogic(context.TODO(), Options{DatabaseUrl: testDatabaseUrl(), NamePrefix: namePrefix})
+		So(err, ShouldBeNil)
+		So(logic, ShouldNotBeNil)
+		restartProviders()
+
+		Convey("Ensure aws provisioner can provision a redis instance", func() {
+			var c broker.RequestContext
+			request := osb.ProvisionRequest{InstanceID: instanceId, PlanID: hobbyPlan, OrganizationGUID: "billing", AcceptsIncomplete: true}
+			res, err := logic.Provision(&request, &c)
+			So(err, ShouldBeNil)
+			So(res.Async, ShouldBeTrue)
+
+			state, desc := lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateInProgress)
+			So(desc, ShouldEqual, "creating")
+			state, _ = lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateSucceeded)
+
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			So(entry.Name, ShouldStartWith, namePrefix)
+			tags, err := fakeElastiCache.ListTagsForResource(&elasticache.ListTagsForResourceInput{ResourceName: aws.String(entry.Name)})
+			So(err, ShouldBeNil)
+			So(len(tags.TagList), ShouldEqual, 1)
+			So(*tags.TagList[0].Key, ShouldEqual, "BillingCode")
+			So(*tags.TagList[0].Value, ShouldEqual, "billing")
+		})
+
+		Convey("Ensure a binding gets the url of the cache cluster", func() {
+			var guid = "123e4567-e89b-12d3-a456-426655440000"
+			res, err := logic.Bind(&osb.BindRequest{InstanceID: instanceId, BindingID: "foo", BindResource: &osb.BindResource{AppGUID: &guid}}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			So(res.Credentials["REDIS_URL"].(string), ShouldStartWith, "redis://")
+			So(res.Credentials["REDIS_URL"].(string), ShouldEndWith, ".0001.fake.cache.amazonaws.com:6379")
+
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			tags, err := fakeElastiCache.ListTagsForResource(&elasticache.ListTagsForResourceInput{ResourceName: aws.String(entry.Name)})
+			So(err, ShouldBeNil)
+			So(len(tags.TagList), ShouldEqual, 3)
+			So(*tags.TagList[0].Key, ShouldEqual, "App")
+			So(*tags.TagList[0].Value, ShouldEqual, guid)
+			So(*tags.TagList[2].Key, ShouldEqual, "Binding")
+			So(*tags.TagList[2].Value, ShouldEqual, "foo")
+		})
+
+		Convey("Ensure tags can be added and removed", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+			So(provider.Tag(instance, "Team", "cache"), ShouldBeNil)
+			tags, err := fakeElastiCache.ListTagsForResource(&elasticache.ListTagsForResourceInput{ResourceName: aws.String(fakeClusterArn(instance.Name))})
+			So(err, ShouldBeNil)
+			So(len(tags.TagList), ShouldEqual, 4)
+			So(provider.Untag(instance, "Team"), ShouldBeNil)
+			tags, err = fakeElastiCache.ListTagsForResource(&elasticache.ListTagsForResourceInput{ResourceName: aws.String(instance.Name)})
+			So(err, ShouldBeNil)
+			So(len(tags.TagList), ShouldEqual, 3)
+		})
+
+		Convey("Ensure backups can be created and restored", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+
+			backup, err := provider.CreateBackup(instance)
+			So(err, ShouldBeNil)
+			So(*backup.Status, ShouldEqual, "creating")
+			So(*backup.Progress, ShouldEqual, 50)
+			So(provider.RestoreBackup(instance, *backup.Id).Error(), ShouldEqual, "Cannot restore a backup that is not available to be used.")
+
+			backup, err = provider.GetBackup(instance, *backup.Id)
+			So(err, ShouldBeNil)
+			So(*backup.Status, ShouldEqual, "available")
+			backups, err := provider.ListBackups(instance)
+			So(err, ShouldBeNil)
+			So(len(backups), ShouldEqual, 1)
+			So(*backups[0].Id, ShouldEqual, *backup.Id)
+
+			restartProviders()
+			instance, err = logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			So(instance.Status, ShouldEqual, "snapshotting")
+			So(RestoreBackup(logic.storage, instance, namePrefix, *backup.Id), ShouldBeNil)
+			So(*describeFakeCluster(instance.Name).CacheClusterStatus, ShouldEqual, "available")
+
+			// The cluster that was replaced is kept as a snapshot.
+			backups, err = provider.ListBackups(instance)
+			So(err, ShouldBeNil)
+			So(len(backups), ShouldEqual, 2)
+
+			So(provider.RestoreBackup(instance, "does-not-exist").Error(), ShouldEqual, "Unable to restore backup, as the backup could not be found.")
+		})
+
+		Convey("Ensure the instance can be restarted", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+			So(provider.Restart(instance), ShouldBeNil)
+			state, desc := lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateInProgress)
+			So(desc, ShouldEqual, "rebooting cluster nodes")
+			state, _ = lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateSucceeded)
+		})
+
+		Convey("Ensure the instance can be moved to a larger plan", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			_, err = UpgradeWithinProviders(logic.storage, instance, standardPlan, nil, namePrefix)
+			So(err, ShouldBeNil)
+
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			So(entry.PlanId, ShouldEqual, standardPlan)
+			So(entry.Status, ShouldEqual, "modifying")
+			cluster := describeFakeCluster(instance.Name)
+			So(*cluster.CacheNodeType, ShouldEqual, "cache.t2.small")
+
+			restartProviders()
+			instance, err = logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			So(instance.Status, ShouldEqual, "available")
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+			versions, err := provider.EngineVersions(instance)
+			So(err, ShouldBeNil)
+			So(versions, ShouldResemble, []string{"5.0.6", "6.0.5"})
+		})
+
+		Convey("Ensure bindings from before bindings were stored can be read and unbound", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			_, err = logic.Unbind(&osb.UnbindRequest{InstanceID: instanceId, BindingID: "foo"}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			_, err = logic.GetBinding(&osb.GetBindingRequest{InstanceID: instanceId, BindingID: "old"}, &broker.RequestContext{})
+			So(err.(osb.HTTPStatusCodeError).StatusCode, ShouldEqual, 404)
+
+			// The broker used to only tag the instance when binding it.
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+			So(provider.Tag(instance, "Binding", "old"), ShouldBeNil)
+			So(provider.Tag(instance, "App", "123e4567-e89b-12d3-a456-426655440000"), ShouldBeNil)
+			logic.storage.(*MemoryStorage).resources[instanceId].untrackedBindings = true
+
+			res, err := logic.GetBinding(&osb.GetBindingRequest{InstanceID: instanceId, BindingID: "old"}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			So(res.Credentials["REDIS_URL"].(string), ShouldEndWith, ".0001.fake.cache.amazonaws.com:6379")
+			_, err = logic.Unbind(&osb.UnbindRequest{InstanceID: instanceId, BindingID: "old"}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			tags, err := fakeElastiCache.ListTagsForResource(&elasticache.ListTagsForResourceInput{ResourceName: aws.String(instance.Name)})
+			So(err, ShouldBeNil)
+			for _, tag := range tags.TagList {
+				So(*tag.Key, ShouldNotBeIn, "Binding", "App")
+			}
+		})
+
+		Convey("Ensure aws redis can be deprovisioned with a final snapshot", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			res, err := logic.Deprovision(&osb.DeprovisionRequest{InstanceID: instanceId, AcceptsIncomplete: true}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			So(res.Async, ShouldBeFalse)
+
+			So(*describeFakeCluster(instance.Name).CacheClusterStatus, ShouldEqual, "deleting")
+			_, err = fakeElastiCache.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{CacheClusterId: aws.String(instance.Name)})
+			So(err, ShouldNotBeNil)
+			snapshots, err := fakeElastiCache.DescribeSnapshots(&elasticache.DescribeSnapshotsInput{SnapshotName: aws.String(instance.Name + "-final")})
+			So(err, ShouldBeNil)
+			So(len(snapshots.Snapshots), ShouldEqual, 1)
+
+			_, err = logic.LastOperation(&osb.LastOperationRequest{InstanceID: instanceId}, &broker.RequestContext{})
+			So(err, ShouldNotBeNil)
+			So(err.(osb.HTTPStatusCodeError).StatusCode, ShouldEqual, 404)
+		})
+	})
+}
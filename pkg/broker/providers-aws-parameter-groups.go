@@ -0,0 +1,123 @@
+package broker
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/golang/glog"
+	"strconv"
+	"strings"
+)
+
+// parameterGroupFamily is the parameter group family of an engine version, e.g., redis5.0,
+// redis6.x or memcached1.5.
+func parameterGroupFamily(engine string, version string) string {
+	parts := strings.Split(version, ".")
+	major, _ := strconv.Atoi(parts[0])
+	if engine == "redis" && major == 6 {
+		return "redis6.x"
+	} else if engine == "redis" && major > 6 {
+		return "redis" + parts[0]
+	} else if len(parts) > 1 {
+		return engine + parts[0] + "." + parts[1]
+	}
+	return engine + version
+}
+
+// parameterGroupName is the name of the instance's own parameter group, the family of a
+// parameter group cannot change so an instance has one for each engine version it has used.
+func parameterGroupName(instance *Instance, family string) string {
+	return instance.Name + "-" + strings.Replace(family, ".", "-", -1)
+}
+
+// ensureParameterGroup creates the instance's parameter group for the engine version if it
+// does not exist and sets the allowed settings to the instance's config, any allowed setting
+// that was changed before but is not in the config is reset to its default. Without a version
+// the instance's current engine version is used.
+func ensureParameterGroup(svc elasticacheiface.ElastiCacheAPI, instance *Instance, version string, clusterMode bool) (string, error) {
+	if version == "" {
+		version = instance.EngineVersion
+	}
+	family := parameterGroupFamily(instance.Engine, version)
+	name := parameterGroupName(instance, family)
+	_, err := svc.CreateCacheParameterGroup(&elasticache.CreateCacheParameterGroupInput{
+		CacheParameterGroupFamily: aws.String(family),
+		CacheParameterGroupName:   aws.String(name),
+		Description:               aws.String("Settings for " + instance.Name),
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == elasticache.ErrCodeCacheParameterGroupAlreadyExistsFault {
+		err = nil
+	} else if err == nil {
+		glog.Infof("Created parameter group %s for instance %s\n", name, instance.Id)
+		if clusterMode {
+			_, err = svc.ModifyCacheParameterGroup(&elasticache.ModifyCacheParameterGroupInput{
+				CacheParameterGroupName: aws.String(name),
+				ParameterNameValues:     []*elasticache.ParameterNameValue{{ParameterName: aws.String("cluster-enabled"), ParameterValue: aws.String("yes")}},
+			})
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	allowed := engineConfigAllowList(instance.Engine)
+	changed, err := svc.DescribeCacheParameters(&elasticache.DescribeCacheParametersInput{
+		CacheParameterGroupName: aws.String(name),
+		Source:                  aws.String("user"),
+		MaxRecords:              aws.Int64(100),
+	})
+	if err != nil {
+		return "", err
+	}
+	reset := make([]*elasticache.ParameterNameValue, 0)
+	for _, parameter := range changed.Parameters {
+		if _, ok := allowed[aws.StringValue(parameter.ParameterName)]; !ok {
+			continue
+		}
+		if _, ok := instance.Config[aws.StringValue(parameter.ParameterName)]; !ok {
+			reset = append(reset, &elasticache.ParameterNameValue{ParameterName: parameter.ParameterName})
+		}
+	}
+	if len(reset) > 0 {
+		_, err = svc.ResetCacheParameterGroup(&elasticache.ResetCacheParameterGroupInput{
+			CacheParameterGroupName: aws.String(name),
+			ParameterNameValues:     reset,
+			ResetAllParameters:      aws.Bool(false),
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	values := make([]*elasticache.ParameterNameValue, 0)
+	for _, key := range EngineConfigNames(instance.Engine) {
+		if value, ok := instance.Config[key]; ok {
+			values = append(values, &elasticache.ParameterNameValue{ParameterName: aws.String(key), ParameterValue: aws.String(value)})
+		}
+	}
+	if len(values) > 0 {
+		_, err = svc.ModifyCacheParameterGroup(&elasticache.ModifyCacheParameterGroupInput{
+			CacheParameterGroupName: aws.String(name),
+			ParameterNameValues:     values,
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	return name, nil
+}
+
+// applyCacheClusterConfig moves a single cache cluster onto its own parameter group.
+func applyCacheClusterConfig(svc elasticacheiface.ElastiCacheAPI, instance *Instance) error {
+	name, err := ensureParameterGroup(svc, instance, "", false)
+	if err != nil {
+		return err
+	}
+	_, err = svc.ModifyCacheCluster(&elasticache.ModifyCacheClusterInput{
+		ApplyImmediately:        aws.Bool(true),
+		CacheClusterId:          aws.String(instance.ProviderId),
+		CacheParameterGroupName: aws.String(name),
+	})
+	return err
+}
@@ -0,0 +1,708 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/go-redis/redis"
+	"github.com/golang/glog"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type AWSReplicationGroupRedisProvider struct {
+	Provider
+	awssvc        elasticacheiface.ElastiCacheAPI
+	namePrefix    string
+	instanceCache *InstanceCache
+}
+
+func NewAWSReplicationGroupRedisProvider(ctx context.Context, namePrefix string) (*AWSReplicationGroupRedisProvider, error) {
+	awssvc, err := newElastiCacheClient()
+	if err != nil {
+		return nil, err
+	}
+	return &AWSReplicationGroupRedisProvider{
+		namePrefix:    namePrefix,
+		instanceCache: NewInstanceCache(instanceCacheTTL),
+		awssvc:        awssvc,
+	}, nil
+}
+
+func init() {
+	RegisterProvider(AWSRedisReplicationGroup, func(ctx context.Context, namePrefix string) (Provider, error) {
+		return NewAWSReplicationGroupRedisProvider(ctx, namePrefix)
+	})
+}
+
+func endpointToString(endpoint *elasticache.Endpoint) string {
+	if endpoint == nil || endpoint.Address == nil || endpoint.Port == nil {
+		return ""
+	}
+	return *endpoint.Address + ":" + strconv.FormatInt(*endpoint.Port, 10)
+}
+
+func (provider AWSReplicationGroupRedisProvider) describeReplicationGroup(name string) (*elasticache.ReplicationGroup, error) {
+	resp, err := provider.awssvc.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.ReplicationGroups) != 1 {
+		return nil, errors.New("Unable to find replication group as none or multiple were returned")
+	}
+	return resp.ReplicationGroups[0], nil
+}
+
+// The replication group does not report its engine or version, so this is
+// pulled from one of its member clusters, falling back to the plan.
+func (provider AWSReplicationGroupRedisProvider) engineVersion(group *elasticache.ReplicationGroup, plan *ProviderPlan) string {
+	if len(group.MemberClusters) > 0 {
+		resp, err := provider.awssvc.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
+			CacheClusterId: group.MemberClusters[0],
+		})
+		if err == nil && len(resp.CacheClusters) > 0 && resp.CacheClusters[0].EngineVersion != nil {
+			return *resp.CacheClusters[0].EngineVersion
+		}
+	}
+	var settings elasticache.CreateReplicationGroupInput
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err == nil && settings.EngineVersion != nil {
+		return *settings.EngineVersion
+	}
+	return ""
+}
+
+func (provider AWSReplicationGroupRedisProvider) instanceFromReplicationGroup(Id string, name string, group *elasticache.ReplicationGroup, plan *ProviderPlan) *Instance {
+	var endpoint = ""
+	var readerEndpoint = ""
+	var scheme = plan.Scheme
+	if group.TransitEncryptionEnabled != nil && *group.TransitEncryptionEnabled == true {
+		scheme = "rediss"
+	}
+	if group.ClusterEnabled != nil && *group.ClusterEnabled == true {
+		endpoint = endpointToString(group.ConfigurationEndpoint)
+	} else if len(group.NodeGroups) > 0 {
+		endpoint = endpointToString(group.NodeGroups[0].PrimaryEndpoint)
+		readerEndpoint = endpointToString(group.NodeGroups[0].ReaderEndpoint)
+	}
+	return &Instance{
+		Id:             Id,
+		ProviderId:     *group.ReplicationGroupId,
+		Name:           name,
+		Plan:           plan,
+		Username:       "",
+		Password:       "",
+		Endpoint:       endpoint,
+		ReaderEndpoint: readerEndpoint,
+		Status:         *group.Status,
+		Ready:          IsReady(*group.Status),
+		Engine:         "redis",
+		EngineVersion:  provider.engineVersion(group, plan),
+		Scheme:         scheme,
+	}
+}
+
+// Tags can only be applied through an ARN, the replication group and each of its
+// member clusters are tagged so the tags show up regardless of where you look.
+func (provider AWSReplicationGroupRedisProvider) resourceNames(name string) ([]string, error) {
+	group, err := provider.describeReplicationGroup(name)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0)
+	if group.ARN == nil {
+		names = append(names, name)
+		for _, member := range group.MemberClusters {
+			names = append(names, *member)
+		}
+		return names, nil
+	}
+	names = append(names, *group.ARN)
+	for _, member := range group.MemberClusters {
+		names = append(names, strings.Replace(*group.ARN, ":replicationgroup:"+name, ":cluster:"+*member, 1))
+	}
+	return names, nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) GetInstance(name string, plan *ProviderPlan) (*Instance, error) {
+	if instance, ok := provider.instanceCache.Get(name, plan); ok {
+		return instance, nil
+	}
+	group, err := provider.describeReplicationGroup(name)
+	if err != nil {
+		return nil, err
+	}
+	instance := provider.instanceFromReplicationGroup("", name, group, plan) // providers should not store the id, username or password.
+	provider.instanceCache.Set(name, instance)
+	return instance, nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) PerformPostProvision(db *Instance) (*Instance, error) {
+	return db, nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) GetUrl(instance *Instance) map[string]interface{} {
+	urls := map[string]interface{}{
+		"REDIS_URL": redisUrl(instance, instance.Endpoint),
+	}
+	if instance.ReaderEndpoint != "" {
+		urls["REDIS_READER_URL"] = redisUrl(instance, instance.ReaderEndpoint)
+	}
+	return urls
+}
+
+func (provider AWSReplicationGroupRedisProvider) ProvisionWithSettings(Id string, plan *ProviderPlan, settings *elasticache.CreateReplicationGroupInput) (*Instance, error) {
+	resp, err := provider.awssvc.CreateReplicationGroup(settings)
+	if err != nil {
+		return nil, err
+	}
+	return provider.instanceFromReplicationGroup(Id, *resp.ReplicationGroup.ReplicationGroupId, resp.ReplicationGroup, plan), nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) Provision(Id string, plan *ProviderPlan, Owner string) (*Instance, error) {
+	var settings elasticache.CreateReplicationGroupInput
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	settings.ReplicationGroupId = aws.String(strings.ToLower(provider.namePrefix + RandomString(8)))
+	settings.ReplicationGroupDescription = aws.String("Redis replication group " + *settings.ReplicationGroupId)
+	settings.Tags = []*elasticache.Tag{{Key: aws.String("BillingCode"), Value: aws.String(Owner)}}
+	// Auth tokens are only accepted with in-transit encryption, each instance gets its own
+	// so a token in the plan (which would be shared by every instance) is never used.
+	settings.AuthToken = nil
+	if settings.TransitEncryptionEnabled != nil && *settings.TransitEncryptionEnabled == true {
+		token, err := RandomSecureString(64)
+		if err != nil {
+			return nil, err
+		}
+		settings.AuthToken = aws.String(token)
+	}
+	instance, err := provider.ProvisionWithSettings(Id, plan, &settings)
+	if err != nil {
+		return nil, err
+	}
+	if settings.AuthToken != nil {
+		instance.Password = *settings.AuthToken
+	}
+	return instance, nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) Deprovision(Instance *Instance, takeSnapshot bool) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	var snapshot *string = nil
+	if takeSnapshot {
+		snapshot = aws.String(Instance.ProviderId + "-final")
+	}
+	_, err := provider.awssvc.DeleteReplicationGroup(&elasticache.DeleteReplicationGroupInput{
+		ReplicationGroupId:      aws.String(Instance.ProviderId),
+		FinalSnapshotIdentifier: snapshot,
+	})
+	return err
+}
+
+func replicasPerNodeGroup(group *elasticache.ReplicationGroup) int64 {
+	if len(group.NodeGroups) > 0 && len(group.NodeGroups[0].NodeGroupMembers) > 0 {
+		return int64(len(group.NodeGroups[0].NodeGroupMembers)) - 1
+	}
+	return int64(len(group.MemberClusters)) - 1
+}
+
+func (provider AWSReplicationGroupRedisProvider) changeReplicaCount(name string, from int64, to int64) error {
+	if from == to {
+		return nil
+	}
+	glog.Infof("Replication group: %s changing replicas from %d to %d...\n", name, from, to)
+	var err error
+	if to > from {
+		_, err = provider.awssvc.IncreaseReplicaCount(&elasticache.IncreaseReplicaCountInput{
+			ApplyImmediately:   aws.Bool(true),
+			NewReplicaCount:    aws.Int64(to),
+			ReplicationGroupId: aws.String(name),
+		})
+	} else {
+		_, err = provider.awssvc.DecreaseReplicaCount(&elasticache.DecreaseReplicaCountInput{
+			ApplyImmediately:   aws.Bool(true),
+			NewReplicaCount:    aws.Int64(to),
+			ReplicationGroupId: aws.String(name),
+		})
+	}
+	if err != nil {
+		return err
+	}
+	return provider.awssvc.WaitUntilReplicationGroupAvailable(&elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(name),
+	})
+}
+
+func (provider AWSReplicationGroupRedisProvider) ModifyWithSettings(instance *Instance, plan *ProviderPlan, settings *elasticache.CreateReplicationGroupInput) (*Instance, error) {
+	defer provider.instanceCache.Invalidate(instance.Name)
+	glog.Infof("Instance: %s modifying settings...\n", instance.Id)
+	group, err := provider.describeReplicationGroup(instance.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	if aws.BoolValue(group.TransitEncryptionEnabled) != aws.BoolValue(settings.TransitEncryptionEnabled) {
+		return nil, errors.New("In-transit encryption cannot be changed on an existing redis, a new instance must be created.")
+	}
+
+	// Replicas must be added before automatic failover can be turned on, and
+	// automatic failover must be turned off before the last replica is removed.
+	currentReplicas := replicasPerNodeGroup(group)
+	targetReplicas := currentReplicas
+	if settings.NumCacheClusters != nil {
+		targetReplicas = *settings.NumCacheClusters - 1
+	} else if settings.ReplicasPerNodeGroup != nil {
+		targetReplicas = *settings.ReplicasPerNodeGroup
+	}
+	if targetReplicas > currentReplicas {
+		if err = provider.changeReplicaCount(instance.ProviderId, currentReplicas, targetReplicas); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = provider.awssvc.ModifyReplicationGroup(&elasticache.ModifyReplicationGroupInput{
+		ApplyImmediately:           aws.Bool(true),
+		AutoMinorVersionUpgrade:    settings.AutoMinorVersionUpgrade,
+		AutomaticFailoverEnabled:   settings.AutomaticFailoverEnabled,
+		CacheNodeType:              settings.CacheNodeType,
+		CacheParameterGroupName:    settings.CacheParameterGroupName,
+		CacheSecurityGroupNames:    settings.CacheSecurityGroupNames,
+		EngineVersion:              settings.EngineVersion,
+		MultiAZEnabled:             settings.MultiAZEnabled,
+		NotificationTopicArn:       settings.NotificationTopicArn,
+		PreferredMaintenanceWindow: settings.PreferredMaintenanceWindow,
+		ReplicationGroupId:         aws.String(instance.ProviderId),
+		SecurityGroupIds:           settings.SecurityGroupIds,
+		SnapshotRetentionLimit:     settings.SnapshotRetentionLimit,
+		SnapshotWindow:             settings.SnapshotWindow,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	time.Sleep(awsModifyWait)
+
+	if targetReplicas < currentReplicas {
+		err = provider.awssvc.WaitUntilReplicationGroupAvailable(&elasticache.DescribeReplicationGroupsInput{
+			ReplicationGroupId: aws.String(instance.ProviderId),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err = provider.changeReplicaCount(instance.ProviderId, currentReplicas, targetReplicas); err != nil {
+			return nil, err
+		}
+	}
+
+	group, err = provider.describeReplicationGroup(instance.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	glog.Infof("Instance: %s modifications finished.\n", instance.Id)
+	modified := provider.instanceFromReplicationGroup(instance.Id, instance.Name, group, plan)
+	modified.Username = instance.Username
+	modified.Password = instance.Password
+	return modified, nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) Modify(Instance *Instance, plan *ProviderPlan) (*Instance, error) {
+	if !CanBeModified(Instance.Status) {
+		return nil, errors.New("Databases cannot be modifed during backups, upgrades or while maintenance is being performed.")
+	}
+	var settings elasticache.CreateReplicationGroupInput
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	if len(Instance.Config) > 0 {
+		name, err := ensureParameterGroup(provider.awssvc, Instance, aws.StringValue(settings.EngineVersion), false)
+		if err != nil {
+			return nil, err
+		}
+		settings.CacheParameterGroupName = aws.String(name)
+	}
+	return provider.ModifyWithSettings(Instance, plan, &settings)
+}
+
+func (provider AWSReplicationGroupRedisProvider) Tag(Instance *Instance, Name string, Value string) error {
+	names, err := provider.resourceNames(Instance.ProviderId)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		_, err = provider.awssvc.AddTagsToResource(&elasticache.AddTagsToResourceInput{
+			ResourceName: aws.String(name),
+			Tags: []*elasticache.Tag{
+				{
+					Key:   aws.String(Name),
+					Value: aws.String(Value),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) Untag(Instance *Instance, Name string) error {
+	names, err := provider.resourceNames(Instance.ProviderId)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		_, err = provider.awssvc.RemoveTagsFromResource(&elasticache.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(name),
+			TagKeys: []*string{
+				aws.String(Name),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restart reboots the replicas one at a time, then fails the primary over to a replica so the
+// group stays available while the old primary restarts, a group without automatic failover has
+// its primary rebooted.
+func (provider AWSReplicationGroupRedisProvider) Restart(Instance *Instance) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	if !Instance.Ready {
+		return errors.New("Cannot restart a database that is unavailable.")
+	}
+	group, err := provider.describeReplicationGroup(Instance.ProviderId)
+	if err != nil {
+		return err
+	}
+	if len(group.NodeGroups) < 1 || len(group.NodeGroups[0].NodeGroupMembers) < 1 {
+		return errors.New("No clusters were found in the replication group to reboot!")
+	}
+	var primary *elasticache.NodeGroupMember = nil
+	for _, member := range group.NodeGroups[0].NodeGroupMembers {
+		if aws.StringValue(member.CurrentRole) == "primary" {
+			primary = member
+			continue
+		}
+		if err = provider.rebootMember(member); err != nil {
+			return err
+		}
+	}
+	if primary == nil {
+		return errors.New("No primary was found in the replication group to reboot!")
+	}
+	if aws.StringValue(group.AutomaticFailover) == elasticache.AutomaticFailoverStatusEnabled {
+		return provider.failover(Instance.ProviderId, group.NodeGroups[0].NodeGroupId)
+	}
+	return provider.rebootMember(primary)
+}
+
+func (provider AWSReplicationGroupRedisProvider) rebootMember(member *elasticache.NodeGroupMember) error {
+	_, err := provider.awssvc.RebootCacheCluster(&elasticache.RebootCacheClusterInput{
+		CacheClusterId:       member.CacheClusterId,
+		CacheNodeIdsToReboot: []*string{member.CacheNodeId},
+	})
+	if err != nil {
+		return err
+	}
+	return provider.awssvc.WaitUntilCacheClusterAvailable(&elasticache.DescribeCacheClustersInput{
+		CacheClusterId: member.CacheClusterId,
+	})
+}
+
+// failover promotes a replica of the node group to primary and waits for the group to be
+// available again, the old primary restarts and rejoins as a replica.
+func (provider AWSReplicationGroupRedisProvider) failover(name string, nodeGroupId *string) error {
+	_, err := provider.awssvc.TestFailover(&elasticache.TestFailoverInput{
+		ReplicationGroupId: aws.String(name),
+		NodeGroupId:        nodeGroupId,
+	})
+	if err != nil {
+		return err
+	}
+	time.Sleep(awsModifyWait)
+	return provider.awssvc.WaitUntilReplicationGroupAvailable(&elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(name),
+	})
+}
+
+func (provider AWSReplicationGroupRedisProvider) Flush(Instance *Instance) error {
+	return errors.New("Flush is not available on redis instances.")
+}
+
+func (provider AWSReplicationGroupRedisProvider) Stats(Instance *Instance) ([]Stat, error) {
+	client := redis.NewClient(redisOptions(Instance))
+	defer client.Close()
+	info, err := client.Info().Result()
+	if err != nil {
+		return nil, err
+	}
+	infos := strings.Split(info, "\n")
+	stats := make([]Stat, 0)
+
+	for _, keyValLine := range infos {
+		if strings.TrimSpace(keyValLine) != "" && len(keyValLine) > 0 && keyValLine[0] != '#' {
+			sep := strings.Split(keyValLine, ":")
+			if len(sep) == 2 {
+				stats = append(stats, Stat{
+					Key:   sep[0],
+					Value: strings.Trim(strings.TrimSpace(sep[1]), "\r"),
+				})
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+func snapshotToBackupSpec(instance *Instance, snapshot *elasticache.Snapshot) BackupSpec {
+	created := time.Now().UTC().Format(time.RFC3339)
+	if len(snapshot.NodeSnapshots) > 0 && snapshot.NodeSnapshots[0].SnapshotCreateTime != nil {
+		created = snapshot.NodeSnapshots[0].SnapshotCreateTime.UTC().Format(time.RFC3339)
+	}
+	var progress int64 = 100
+	if *snapshot.SnapshotStatus == "creating" {
+		progress = 50
+	}
+	return BackupSpec{
+		Resource: ResourceSpec{
+			Name: instance.Name,
+		},
+		Id:       snapshot.SnapshotName,
+		Progress: aws.Int64(progress),
+		Status:   snapshot.SnapshotStatus,
+		Created:  created,
+	}
+}
+
+func (provider AWSReplicationGroupRedisProvider) GetBackup(instance *Instance, Id string) (*BackupSpec, error) {
+	snapshots, err := provider.awssvc.DescribeSnapshots(&elasticache.DescribeSnapshotsInput{
+		ReplicationGroupId: aws.String(instance.Name),
+		SnapshotName:       aws.String(Id),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshots.Snapshots) != 1 {
+		return nil, errors.New("No backups were found.")
+	}
+	if len(snapshots.Snapshots[0].NodeSnapshots) == 0 {
+		return nil, errors.New("No data for any nodes was found in the backups.")
+	}
+	backup := snapshotToBackupSpec(instance, snapshots.Snapshots[0])
+	return &backup, nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) ListBackups(instance *Instance) ([]BackupSpec, error) {
+	snapshots, err := provider.awssvc.DescribeSnapshots(&elasticache.DescribeSnapshotsInput{ReplicationGroupId: aws.String(instance.Name)})
+	if err != nil {
+		return []BackupSpec{}, err
+	}
+	out := make([]BackupSpec, 0)
+	for _, snapshot := range snapshots.Snapshots {
+		if len(snapshot.NodeSnapshots) > 0 {
+			out = append(out, snapshotToBackupSpec(instance, snapshot))
+		}
+	}
+	return out, nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) CreateBackup(instance *Instance) (*BackupSpec, error) {
+	defer provider.instanceCache.Invalidate(instance.Name)
+	if !instance.Ready {
+		return nil, errors.New("Cannot create a backup on a database that is unavailable.")
+	}
+	group, err := provider.describeReplicationGroup(instance.Name)
+	if err != nil {
+		return nil, err
+	}
+	input := elasticache.CreateSnapshotInput{
+		SnapshotName: aws.String(instance.Name + "-manual-" + RandomString(10)),
+	}
+	// Cluster mode groups are snapshotted as a whole, otherwise a single node is
+	// used, preferably a replica so the primary is not slowed down.
+	if group.ClusterEnabled != nil && *group.ClusterEnabled == true {
+		input.ReplicationGroupId = aws.String(instance.Name)
+	} else if group.SnapshottingClusterId != nil {
+		input.CacheClusterId = group.SnapshottingClusterId
+	} else if len(group.MemberClusters) > 0 {
+		input.CacheClusterId = group.MemberClusters[len(group.MemberClusters)-1]
+	} else {
+		return nil, errors.New("No clusters were found in the replication group to backup.")
+	}
+	snapshotOut, err := provider.awssvc.CreateSnapshot(&input)
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshotOut.Snapshot.NodeSnapshots) == 0 {
+		return nil, errors.New("No data for any nodes was found in the backup.")
+	}
+	backup := snapshotToBackupSpec(instance, snapshotOut.Snapshot)
+	return &backup, nil
+}
+
+// CopyBackup has nothing to do, snapshots are kept by elasticache.
+func (provider AWSReplicationGroupRedisProvider) CopyBackup(*Instance, string) error {
+	return nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) RestoreBackup(instance *Instance, Id string) error {
+	defer provider.instanceCache.Invalidate(instance.Name)
+	var settings elasticache.CreateReplicationGroupInput
+	if err := json.Unmarshal([]byte(instance.Plan.providerPrivateDetails), &settings); err != nil {
+		return err
+	}
+
+	// Validate restore backup
+	backup, err := provider.GetBackup(instance, Id)
+	if err != nil {
+		return errors.New("Unable to restore backup, as the backup could not be found.")
+	}
+
+	if !instance.Ready {
+		return errors.New("Cannot restore a backup on this redis because redis is unavailable.")
+	}
+
+	if *backup.Status != "available" {
+		return errors.New("Cannot restore a backup that is not available to be used.")
+	}
+
+	// Replication groups cannot be seeded in place, so the group is removed (with a final
+	// snapshot as a safety net) then recreated from the backup with the same identifier.
+	group, err := provider.describeReplicationGroup(instance.Name)
+	if err != nil {
+		return err
+	}
+	if len(group.MemberClusters) == 0 {
+		return errors.New("Unable to find any clusters in the replication group to rebuild")
+	}
+	clusters, err := provider.awssvc.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
+		CacheClusterId: group.MemberClusters[0],
+	})
+	if err != nil {
+		return err
+	}
+	if len(clusters.CacheClusters) != 1 {
+		return errors.New("Unable to find the primary cluster to rebuild as none or multiple were returned")
+	}
+	renamedId := instance.Name + "-restore-" + RandomString(5)
+
+	_, err = provider.awssvc.DeleteReplicationGroup(&elasticache.DeleteReplicationGroupInput{
+		ReplicationGroupId:      aws.String(instance.Name),
+		FinalSnapshotIdentifier: aws.String(renamedId),
+	})
+	if err != nil {
+		glog.Errorf("ERROR: Removing the existing replication group failed!: %s %s\n", renamedId, err.Error())
+		return err
+	}
+
+	err = provider.awssvc.WaitUntilReplicationGroupDeleted(&elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(instance.Name),
+	})
+	if err != nil {
+		glog.Errorf("ERROR: Timeout or error waiting for resource to be deleted: %s %s\n", instance.Id, err.Error())
+		return err
+	}
+
+	settings.ReplicationGroupId = aws.String(instance.Name)
+	settings.ReplicationGroupDescription = aws.String("Redis replication group " + instance.Name)
+	settings.SnapshotName = aws.String(Id)
+	settings.SnapshotArns = nil
+	// Keep the existing auth token so bindings continue to work after the restore.
+	settings.TransitEncryptionEnabled = group.TransitEncryptionEnabled
+	settings.AuthToken = nil
+	if aws.BoolValue(group.AuthTokenEnabled) && instance.Password != "" {
+		settings.AuthToken = aws.String(instance.Password)
+	}
+	if clusters.CacheClusters[0].PreferredMaintenanceWindow != nil {
+		settings.PreferredMaintenanceWindow = clusters.CacheClusters[0].PreferredMaintenanceWindow
+	}
+	if clusters.CacheClusters[0].EngineVersion != nil {
+		settings.EngineVersion = clusters.CacheClusters[0].EngineVersion
+	}
+	// Keep the instance's own parameter group if it has one.
+	if clusters.CacheClusters[0].CacheParameterGroup != nil {
+		settings.CacheParameterGroupName = clusters.CacheClusters[0].CacheParameterGroup.CacheParameterGroupName
+	}
+
+	if _, err = provider.awssvc.CreateReplicationGroup(&settings); err != nil {
+		glog.Errorf("ERROR: Unable to restore redis with %s, old snapshot at %s for resource: %s: %s\n", Id, renamedId, instance.Id, err.Error())
+		return err
+	}
+
+	err = provider.awssvc.WaitUntilReplicationGroupAvailable(&elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(instance.Name),
+	})
+	if err != nil {
+		glog.Errorf("ERROR: Waiting for the restored replication group: %s %s\n", instance.Name, err.Error())
+		return err
+	}
+	return nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) CreateBindingUser(*Instance, string) (string, string, error) {
+	return "", "", errors.New("This feature is not available on this plan.")
+}
+
+func (provider AWSReplicationGroupRedisProvider) DeleteBindingUser(*Instance, string) error {
+	return errors.New("This feature is not available on this plan.")
+}
+
+// UpdateAuthToken changes the auth token, the ROTATE strategy allows both the old and new
+// token while apps pick up the new one, SET then removes the old token.
+func (provider AWSReplicationGroupRedisProvider) UpdateAuthToken(Instance *Instance, token string, strategy string) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	if Instance.Password == "" {
+		return errors.New("This feature is not available on this plan.")
+	}
+	_, err := provider.awssvc.ModifyReplicationGroup(&elasticache.ModifyReplicationGroupInput{
+		ApplyImmediately:        aws.Bool(true),
+		AuthToken:               aws.String(token),
+		AuthTokenUpdateStrategy: aws.String(strategy),
+		ReplicationGroupId:      aws.String(Instance.ProviderId),
+	})
+	if err != nil {
+		return err
+	}
+	return provider.awssvc.WaitUntilReplicationGroupAvailable(&elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(Instance.ProviderId),
+	})
+}
+
+// applyConfig moves the replication group onto its own parameter group.
+func (provider AWSReplicationGroupRedisProvider) applyConfig(Instance *Instance, clusterMode bool) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	name, err := ensureParameterGroup(provider.awssvc, Instance, "", clusterMode)
+	if err != nil {
+		return err
+	}
+	_, err = provider.awssvc.ModifyReplicationGroup(&elasticache.ModifyReplicationGroupInput{
+		ApplyImmediately:        aws.Bool(true),
+		CacheParameterGroupName: aws.String(name),
+		ReplicationGroupId:      aws.String(Instance.ProviderId),
+	})
+	return err
+}
+
+func (provider AWSReplicationGroupRedisProvider) UpdateConfig(Instance *Instance) error {
+	return provider.applyConfig(Instance, false)
+}
+
+func (provider AWSReplicationGroupRedisProvider) EngineVersions(Instance *Instance) ([]string, error) {
+	versions, err := awsEngineVersions(provider.awssvc, Instance.Engine)
+	if err != nil {
+		return nil, err
+	}
+	return upgradableEngineVersions(Instance.EngineVersion, versions), nil
+}
+
+func (provider AWSReplicationGroupRedisProvider) UpgradeEngine(Instance *Instance, version string) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	return upgradeReplicationGroup(provider.awssvc, Instance, version, false)
+}
@@ -0,0 +1,504 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	"github.com/pmorie/osb-broker-lib/pkg/broker"
+	. "github.com/smartystreets/goconvey/convey"
+	"os"
+	"testing"
+	"time"
+)
+
+// replicationGroupCalls is an ElastiCache that returns one replication group and records the
+// calls made to restart it.
+type replicationGroupCalls struct {
+	elasticacheiface.ElastiCacheAPI
+	group *elasticache.ReplicationGroup
+	calls []string
+}
+
+func (c *replicationGroupCalls) DescribeReplicationGroups(input *elasticache.DescribeReplicationGroupsInput) (*elasticache.DescribeReplicationGroupsOutput, error) {
+	return &elasticache.DescribeReplicationGroupsOutput{ReplicationGroups: []*elasticache.ReplicationGroup{c.group}}, nil
+}
+
+func (c *replicationGroupCalls) RebootCacheCluster(input *elasticache.RebootCacheClusterInput) (*elasticache.RebootCacheClusterOutput, error) {
+	c.calls = append(c.calls, "reboot "+aws.StringValue(input.CacheClusterId))
+	return &elasticache.RebootCacheClusterOutput{}, nil
+}
+
+func (c *replicationGroupCalls) WaitUntilCacheClusterAvailable(input *elasticache.DescribeCacheClustersInput) error {
+	c.calls = append(c.calls, "wait "+aws.StringValue(input.CacheClusterId))
+	return nil
+}
+
+func (c *replicationGroupCalls) TestFailover(input *elasticache.TestFailoverInput) (*elasticache.TestFailoverOutput, error) {
+	c.calls = append(c.calls, "failover "+aws.StringValue(input.NodeGroupId))
+	return &elasticache.TestFailoverOutput{}, nil
+}
+
+func (c *replicationGroupCalls) WaitUntilReplicationGroupAvailable(input *elasticache.DescribeReplicationGroupsInput) error {
+	c.calls = append(c.calls, "wait "+aws.StringValue(input.ReplicationGroupId))
+	return nil
+}
+
+func nodeGroupMember(cluster string, role string) *elasticache.NodeGroupMember {
+	return &elasticache.NodeGroupMember{CacheClusterId: aws.String(cluster), CacheNodeId: aws.String("0001"), CurrentRole: aws.String(role)}
+}
+
+func TestAWSReplicationGroupRestart(t *testing.T) {
+	awsModifyWait = 0
+
+	Convey("Given a replication group with a primary and two replicas.", t, func() {
+		svc := &replicationGroupCalls{group: &elasticache.ReplicationGroup{
+			ReplicationGroupId: aws.String("testgroup"),
+			AutomaticFailover:  aws.String(elasticache.AutomaticFailoverStatusEnabled),
+			NodeGroups: []*elasticache.NodeGroup{{
+				NodeGroupId: aws.String("0001"),
+				NodeGroupMembers: []*elasticache.NodeGroupMember{
+					nodeGroupMember("testgroup-001", "primary"),
+					nodeGroupMember("testgroup-002", "replica"),
+					nodeGroupMember("testgroup-003", "replica"),
+				},
+			}},
+		}}
+		provider := &AWSReplicationGroupRedisProvider{awssvc: svc, namePrefix: "test", instanceCache: NewInstanceCache(time.Minute)}
+		instance := &Instance{Name: "testgroup", ProviderId: "testgroup", Ready: true}
+
+		Convey("Ensure the replicas are rebooted one at a time before the primary fails over", func() {
+			So(provider.Restart(instance), ShouldBeNil)
+			So(svc.calls, ShouldResemble, []string{
+				"reboot testgroup-002", "wait testgroup-002",
+				"reboot testgroup-003", "wait testgroup-003",
+				"failover 0001", "wait testgroup",
+			})
+		})
+
+		Convey("Ensure the primary is rebooted last without automatic failover", func() {
+			svc.group.AutomaticFailover = aws.String(elasticache.AutomaticFailoverStatusDisabled)
+			So(provider.Restart(instance), ShouldBeNil)
+			So(svc.calls, ShouldResemble, []string{
+				"reboot testgroup-002", "wait testgroup-002",
+				"reboot testgroup-003", "wait testgroup-003",
+				"reboot testgroup-001", "wait testgroup-001",
+			})
+		})
+
+		Convey("Ensure unavailable groups are not restarted", func() {
+			instance.Ready = false
+			So(provider.Restart(instance).Error(), ShouldEqual, "Cannot restart a database that is unavailable.")
+			So(len(svc.calls), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestAWSClusterRedisRestart(t *testing.T) {
+	awsModifyWait = 0
+
+	Convey("Given a cluster mode replication group with two shards.", t, func() {
+		svc := &replicationGroupCalls{group: &elasticache.ReplicationGroup{
+			ReplicationGroupId: aws.String("testcluster"),
+			ClusterEnabled:     aws.Bool(true),
+			AutomaticFailover:  aws.String(elasticache.AutomaticFailoverStatusEnabled),
+			NodeGroups: []*elasticache.NodeGroup{
+				{NodeGroupId: aws.String("0001"), NodeGroupMembers: []*elasticache.NodeGroupMember{nodeGroupMember("testcluster-0001-001", ""), nodeGroupMember("testcluster-0001-002", "")}},
+				{NodeGroupId: aws.String("0002"), NodeGroupMembers: []*elasticache.NodeGroupMember{nodeGroupMember("testcluster-0002-001", ""), nodeGroupMember("testcluster-0002-002", "")}},
+			},
+		}}
+		provider := &AWSClusterRedisProvider{&AWSReplicationGroupRedisProvider{awssvc: svc, namePrefix: "test", instanceCache: NewInstanceCache(time.Minute)}}
+		instance := &Instance{Name: "testcluster", ProviderId: "testcluster", Ready: true}
+
+		Convey("Ensure each shard is failed over in turn rather than rebooted", func() {
+			So(provider.Restart(instance), ShouldBeNil)
+			So(svc.calls, ShouldResemble, []string{"failover 0001", "wait testcluster", "failover 0002", "wait testcluster"})
+		})
+
+		Convey("Ensure clusters without replicas are not restarted", func() {
+			svc.group.NodeGroups[1].NodeGroupMembers = svc.group.NodeGroups[1].NodeGroupMembers[0:1]
+			So(provider.Restart(instance).Error(), ShouldEqual, "Cannot restart a redis cluster without replicas.")
+			So(len(svc.calls), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestAWSReplicationGroupElastiCacheClient(t *testing.T) {
+	os.Setenv("TEST", "true")
+
+	Convey("Ensure the replication group and cluster providers use the fake elasticache in tests", t, func() {
+		replicationGroup, err := NewAWSReplicationGroupRedisProvider(context.TODO(), "test")
+		So(err, ShouldBeNil)
+		So(replicationGroup.awssvc, ShouldEqual, fakeElastiCache)
+		cluster, err := NewAWSClusterRedisProvider(context.TODO(), "test")
+		So(err, ShouldBeNil)
+		So(cluster.awssvc, ShouldEqual, fakeElastiCache)
+	})
+}
+
+func describeFakeReplicationGroup(name string) *elasticache.ReplicationGroup {
+	resp, err := fakeElastiCache.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{ReplicationGroupId: aws.String(name)})
+	So(err, ShouldBeNil)
+	So(len(resp.ReplicationGroups), ShouldEqual, 1)
+	return resp.ReplicationGroups[0]
+}
+
+// planWithSettings returns a copy of the plan with its replication group settings changed.
+func planWithSettings(plan *ProviderPlan, change func(*elasticache.CreateReplicationGroupInput)) *ProviderPlan {
+	var settings elasticache.CreateReplicationGroupInput
+	So(json.Unmarshal([]byte(plan.providerPrivateDetails), &settings), ShouldBeNil)
+	change(&settings)
+	details, err := json.Marshal(settings)
+	So(err, ShouldBeNil)
+	copied := *plan
+	copied.providerPrivateDetails = string(details)
+	return &copied
+}
+
+func TestAWSReplicationGroupProvision(t *testing.T) {
+	var namePrefix = "test"
+	var logic *BusinessLogic
+	var instanceId string = RandomString(12)
+	var standardHaPlan = "b1e6a2c4-6d0b-4c3e-9a57-5f0e2d1c8a01"
+	var err error
+
+	os.Setenv("TEST", "true")
+	awsModifyWait = 0
+
+	Convey("Given a replication group provisioner on aws with a fake elasticache.", t, func() {
+		logic, err = NewBusinessLogic(context.TODO(), Options{DatabaseUrl: testDatabaseUrl(), NamePrefix: namePrefix})
+		So(err, ShouldBeNil)
+		So(logic, ShouldNotBeNil)
+		restartProviders()
+
+		Convey("Ensure aws provisioner can provision a redis replication group", func() {
+			request := osb.ProvisionRequest{InstanceID: instanceId, PlanID: standardHaPlan, OrganizationGUID: "billing", AcceptsIncomplete: true}
+			res, err := logic.Provision(&request, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			So(res.Async, ShouldBeTrue)
+
+			state, desc := lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateInProgress)
+			So(desc, ShouldEqual, "creating")
+			state, _ = lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateSucceeded)
+
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			So(entry.Name, ShouldStartWith, namePrefix)
+			group := describeFakeReplicationGroup(entry.Name)
+			So(aws.StringValue(group.AutomaticFailover), ShouldEqual, elasticache.AutomaticFailoverStatusEnabled)
+			So(len(group.MemberClusters), ShouldEqual, 2)
+			So(aws.BoolValue(group.AuthTokenEnabled), ShouldBeFalse)
+			So(entry.Password, ShouldEqual, "")
+			tags, err := fakeElastiCache.ListTagsForResource(&elasticache.ListTagsForResourceInput{ResourceName: group.ARN})
+			So(err, ShouldBeNil)
+			So(len(tags.TagList), ShouldEqual, 1)
+			So(*tags.TagList[0].Key, ShouldEqual, "BillingCode")
+			So(*tags.TagList[0].Value, ShouldEqual, "billing")
+		})
+
+		Convey("Ensure a binding gets the primary and reader endpoints of the replication group", func() {
+			var guid = "123e4567-e89b-12d3-a456-426655440000"
+			res, err := logic.Bind(&osb.BindRequest{InstanceID: instanceId, BindingID: "foo", BindResource: &osb.BindResource{AppGUID: &guid}}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			So(res.Credentials["REDIS_URL"].(string), ShouldEqual, "redis://master."+entry.Name+".fake.cache.amazonaws.com:6379")
+			So(res.Credentials["REDIS_READER_URL"].(string), ShouldEqual, "redis://replica."+entry.Name+".fake.cache.amazonaws.com:6379")
+
+			// The replication group and each of its members are tagged.
+			group := describeFakeReplicationGroup(entry.Name)
+			for _, name := range append([]*string{aws.String(entry.Name)}, group.MemberClusters...) {
+				tags, err := fakeElastiCache.ListTagsForResource(&elasticache.ListTagsForResourceInput{ResourceName: name})
+				So(err, ShouldBeNil)
+				So(len(tags.TagList), ShouldEqual, 3)
+				So(*tags.TagList[0].Key, ShouldEqual, "App")
+				So(*tags.TagList[0].Value, ShouldEqual, guid)
+				So(*tags.TagList[2].Key, ShouldEqual, "Binding")
+				So(*tags.TagList[2].Value, ShouldEqual, "foo")
+			}
+		})
+
+		Convey("Ensure replicas can be added and removed", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+
+			modified, err := provider.Modify(instance, planWithSettings(instance.Plan, func(settings *elasticache.CreateReplicationGroupInput) {
+				settings.NumCacheClusters = aws.Int64(3)
+			}))
+			So(err, ShouldBeNil)
+			So(modified.Status, ShouldEqual, "modifying")
+			So(len(describeFakeReplicationGroup(instance.Name).MemberClusters), ShouldEqual, 3)
+
+			// Automatic failover is turned off before the last replica is removed.
+			restartProviders()
+			instance, err = logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			modified, err = provider.Modify(instance, planWithSettings(instance.Plan, func(settings *elasticache.CreateReplicationGroupInput) {
+				settings.NumCacheClusters = aws.Int64(1)
+				settings.AutomaticFailoverEnabled = aws.Bool(false)
+				settings.MultiAZEnabled = aws.Bool(false)
+			}))
+			So(err, ShouldBeNil)
+			So(modified.ReaderEndpoint, ShouldNotEqual, "")
+			group := describeFakeReplicationGroup(instance.Name)
+			So(aws.StringValue(group.AutomaticFailover), ShouldEqual, elasticache.AutomaticFailoverStatusDisabled)
+			So(group.MemberClusters, ShouldResemble, []*string{aws.String(instance.Name + "-001")})
+
+			restartProviders()
+			instance, err = logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			_, err = provider.Modify(instance, instance.Plan)
+			So(err, ShouldBeNil)
+			group = describeFakeReplicationGroup(instance.Name)
+			So(aws.StringValue(group.AutomaticFailover), ShouldEqual, elasticache.AutomaticFailoverStatusEnabled)
+			So(len(group.MemberClusters), ShouldEqual, 2)
+		})
+
+		Convey("Ensure the replication group can be restarted", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+			So(provider.Restart(instance), ShouldBeNil)
+			group := describeFakeReplicationGroup(instance.Name)
+			So(aws.StringValue(group.NodeGroups[0].NodeGroupMembers[0].CacheClusterId), ShouldEqual, instance.Name+"-002")
+			So(aws.StringValue(group.NodeGroups[0].NodeGroupMembers[0].CurrentRole), ShouldEqual, "primary")
+		})
+
+		Convey("Ensure backups can be created and restored", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+
+			backup, err := provider.CreateBackup(instance)
+			So(err, ShouldBeNil)
+			So(*backup.Status, ShouldEqual, "creating")
+			So(provider.RestoreBackup(instance, *backup.Id).Error(), ShouldEqual, "Cannot restore a backup that is not available to be used.")
+			backup, err = provider.GetBackup(instance, *backup.Id)
+			So(err, ShouldBeNil)
+			So(*backup.Status, ShouldEqual, "available")
+			backups, err := provider.ListBackups(instance)
+			So(err, ShouldBeNil)
+			So(len(backups), ShouldEqual, 1)
+
+			So(provider.RestoreBackup(instance, *backup.Id), ShouldBeNil)
+			group := describeFakeReplicationGroup(instance.Name)
+			So(*group.Status, ShouldEqual, "available")
+			So(len(group.MemberClusters), ShouldEqual, 2)
+
+			// The replication group that was replaced is kept as a snapshot.
+			backups, err = provider.ListBackups(instance)
+			So(err, ShouldBeNil)
+			So(len(backups), ShouldEqual, 2)
+
+			So(provider.RestoreBackup(instance, "does-not-exist").Error(), ShouldEqual, "Unable to restore backup, as the backup could not be found.")
+		})
+
+		Convey("Ensure aws redis replication groups can be deprovisioned with a final snapshot", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			members := describeFakeReplicationGroup(instance.Name).MemberClusters
+			res, err := logic.Deprovision(&osb.DeprovisionRequest{InstanceID: instanceId, AcceptsIncomplete: true}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			So(res.Async, ShouldBeFalse)
+
+			So(*describeFakeReplicationGroup(instance.Name).Status, ShouldEqual, "deleting")
+			_, err = fakeElastiCache.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{ReplicationGroupId: aws.String(instance.Name)})
+			So(err, ShouldNotBeNil)
+			_, err = fakeElastiCache.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{CacheClusterId: members[0]})
+			So(err, ShouldNotBeNil)
+			snapshots, err := fakeElastiCache.DescribeSnapshots(&elasticache.DescribeSnapshotsInput{SnapshotName: aws.String(instance.Name + "-final")})
+			So(err, ShouldBeNil)
+			So(len(snapshots.Snapshots), ShouldEqual, 1)
+		})
+	})
+}
+
+func nodeGroupIds(group *elasticache.ReplicationGroup) []string {
+	ids := make([]string, 0)
+	for _, nodeGroup := range group.NodeGroups {
+		ids = append(ids, aws.StringValue(nodeGroup.NodeGroupId))
+	}
+	return ids
+}
+
+// changePlans moves the instance to another plan through the change plans task.
+func changePlans(logic *BusinessLogic, instanceId string, planId string) *Task {
+	restartProviders()
+	res, err := logic.Update(&osb.UpdateInstanceRequest{InstanceID: instanceId, PlanID: &planId, AcceptsIncomplete: true}, &broker.RequestContext{})
+	So(err, ShouldBeNil)
+	So(res.Async, ShouldBeTrue)
+	tasks, err := logic.storage.ListTasks(TaskFilter{ResourceId: instanceId, Action: string(ChangePlansTask), Status: "pending"})
+	So(err, ShouldBeNil)
+	So(len(tasks), ShouldEqual, 1)
+	RunTask(context.TODO(), logic.storage, logic.namePrefix, &tasks[0])
+	task, err := logic.storage.GetTask(tasks[0].Id)
+	So(err, ShouldBeNil)
+	return task
+}
+
+func TestAWSClusterRedisProvision(t *testing.T) {
+	var namePrefix = "test"
+	var logic *BusinessLogic
+	var instanceId string = RandomString(12)
+	var threeShardPlan = "c2f7b3d5-7e1c-4d4f-8b68-6a1f3e2d9b01"
+	var sixShardPlan = "c2f7b3d5-7e1c-4d4f-8b68-6a1f3e2d9b02"
+	var err error
+
+	os.Setenv("TEST", "true")
+	awsModifyWait = 0
+
+	Convey("Given a cluster mode redis provisioner on aws with a fake elasticache.", t, func() {
+		logic, err = NewBusinessLogic(context.TODO(), Options{DatabaseUrl: testDatabaseUrl(), NamePrefix: namePrefix})
+		So(err, ShouldBeNil)
+		So(logic, ShouldNotBeNil)
+		restartProviders()
+
+		Convey("Ensure aws provisioner can provision a redis cluster", func() {
+			request := osb.ProvisionRequest{InstanceID: instanceId, PlanID: sixShardPlan, OrganizationGUID: "billing", AcceptsIncomplete: true}
+			res, err := logic.Provision(&request, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			So(res.Async, ShouldBeTrue)
+			state, _ := lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateInProgress)
+			state, _ = lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateSucceeded)
+
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			group := describeFakeReplicationGroup(entry.Name)
+			So(aws.BoolValue(group.ClusterEnabled), ShouldBeTrue)
+			So(nodeGroupIds(group), ShouldResemble, []string{"0001", "0002", "0003", "0004", "0005", "0006"})
+			So(len(group.MemberClusters), ShouldEqual, 12)
+		})
+
+		Convey("Ensure a binding gets the configuration endpoint of the cluster", func() {
+			var guid = "123e4567-e89b-12d3-a456-426655440000"
+			res, err := logic.Bind(&osb.BindRequest{InstanceID: instanceId, BindingID: "foo", BindResource: &osb.BindResource{AppGUID: &guid}}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			So(res.Credentials["REDIS_URL"].(string), ShouldEqual, "redis://clustercfg."+entry.Name+".fake.cache.amazonaws.com:6379")
+			So(res.Credentials, ShouldNotContainKey, "REDIS_READER_URL")
+		})
+
+		Convey("Ensure shrinking the cluster keeps the lowest sorted node groups", func() {
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			// AWS does not promise the order of node groups, so they are listed in reverse.
+			nodeGroups := fakeElastiCache.replicationGroups[entry.Name].group.NodeGroups
+			for i, j := 0, len(nodeGroups)-1; i < j; i, j = i+1, j-1 {
+				nodeGroups[i], nodeGroups[j] = nodeGroups[j], nodeGroups[i]
+			}
+
+			task := changePlans(logic, instanceId, threeShardPlan)
+			So(task.Status, ShouldEqual, "finished")
+			group := describeFakeReplicationGroup(entry.Name)
+			So(nodeGroupIds(group), ShouldResemble, []string{"0003", "0002", "0001"})
+			So(len(group.MemberClusters), ShouldEqual, 6)
+			entry, err = logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			So(entry.PlanId, ShouldEqual, threeShardPlan)
+		})
+
+		Convey("Ensure growing the cluster adds node groups with replicas", func() {
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			task := changePlans(logic, instanceId, sixShardPlan)
+			So(task.Status, ShouldEqual, "finished")
+			group := describeFakeReplicationGroup(entry.Name)
+			So(nodeGroupIds(group), ShouldResemble, []string{"0003", "0002", "0001", "0004", "0005", "0006"})
+			So(len(group.MemberClusters), ShouldEqual, 12)
+			So(aws.StringValue(group.NodeGroups[5].Slots), ShouldEqual, "13653-16383")
+		})
+
+		Convey("Ensure aws redis clusters can be deprovisioned", func() {
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			_, err = logic.Deprovision(&osb.DeprovisionRequest{InstanceID: instanceId, AcceptsIncomplete: true}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			So(*describeFakeReplicationGroup(entry.Name).Status, ShouldEqual, "deleting")
+			_, err = fakeElastiCache.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{ReplicationGroupId: aws.String(entry.Name)})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestAWSReplicationGroupEncryption(t *testing.T) {
+	var namePrefix = "test"
+	var logic *BusinessLogic
+	var instanceId string = RandomString(12)
+	var securePlan = "d3a8c4e6-8f2d-4e5a-9c79-7b2a4f3e0c01"
+	var err error
+
+	os.Setenv("TEST", "true")
+	awsModifyWait = 0
+
+	Convey("Given a replication group provisioner on aws with a fake elasticache.", t, func() {
+		logic, err = NewBusinessLogic(context.TODO(), Options{DatabaseUrl: testDatabaseUrl(), NamePrefix: namePrefix})
+		So(err, ShouldBeNil)
+		So(logic, ShouldNotBeNil)
+		restartProviders()
+
+		Convey("Ensure an encrypted plan gets its own auth token", func() {
+			request := osb.ProvisionRequest{InstanceID: instanceId, PlanID: securePlan, OrganizationGUID: "billing", AcceptsIncomplete: true}
+			_, err := logic.Provision(&request, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			state, _ := lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateInProgress)
+			state, _ = lastOperationState(logic, instanceId)
+			So(state, ShouldEqual, osb.StateSucceeded)
+
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			So(len(entry.Password), ShouldEqual, 64)
+			group := describeFakeReplicationGroup(entry.Name)
+			So(aws.BoolValue(group.TransitEncryptionEnabled), ShouldBeTrue)
+			So(aws.BoolValue(group.AuthTokenEnabled), ShouldBeTrue)
+			So(fakeElastiCache.replicationGroups[entry.Name].authTokens, ShouldResemble, []string{entry.Password})
+		})
+
+		Convey("Ensure a binding gets a rediss url with the auth token", func() {
+			var guid = "123e4567-e89b-12d3-a456-426655440000"
+			res, err := logic.Bind(&osb.BindRequest{InstanceID: instanceId, BindingID: "foo", BindResource: &osb.BindResource{AppGUID: &guid}}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			So(res.Credentials["REDIS_URL"].(string), ShouldEqual, "rediss://:"+entry.Password+"@master."+entry.Name+".fake.cache.amazonaws.com:6379")
+			So(res.Credentials["REDIS_READER_URL"].(string), ShouldEqual, "rediss://:"+entry.Password+"@replica."+entry.Name+".fake.cache.amazonaws.com:6379")
+		})
+
+		Convey("Ensure the auth token survives a restore", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			password := instance.Password
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+			backup, err := provider.CreateBackup(instance)
+			So(err, ShouldBeNil)
+			_, err = provider.GetBackup(instance, *backup.Id)
+			So(err, ShouldBeNil)
+			So(provider.RestoreBackup(instance, *backup.Id), ShouldBeNil)
+
+			group := describeFakeReplicationGroup(instance.Name)
+			So(aws.BoolValue(group.TransitEncryptionEnabled), ShouldBeTrue)
+			So(aws.BoolValue(group.AuthTokenEnabled), ShouldBeTrue)
+			So(fakeElastiCache.replicationGroups[instance.Name].authTokens, ShouldResemble, []string{password})
+			entry, err := logic.storage.GetInstance(instanceId)
+			So(err, ShouldBeNil)
+			So(entry.Password, ShouldEqual, password)
+		})
+
+		Convey("Ensure encrypted replication groups can be deprovisioned", func() {
+			_, err := logic.Deprovision(&osb.DeprovisionRequest{InstanceID: instanceId, AcceptsIncomplete: true}, &broker.RequestContext{})
+			So(err, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,132 @@
+package broker
+
+import (
+	"time"
+)
+
+// instrumentedProvider records how long each call to a provider takes and whether it failed.
+type instrumentedProvider struct {
+	provider Provider
+	name     Providers
+}
+
+func instrumentProvider(name Providers, provider Provider) Provider {
+	return &instrumentedProvider{provider: provider, name: name}
+}
+
+func (p *instrumentedProvider) observe(call string, started time.Time, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	providerCallDuration.WithLabelValues(string(p.name), call, outcome).Observe(time.Since(started).Seconds())
+}
+
+func (p *instrumentedProvider) GetInstance(name string, plan *ProviderPlan) (instance *Instance, err error) {
+	defer func(started time.Time) { p.observe("get_instance", started, err) }(time.Now())
+	return p.provider.GetInstance(name, plan)
+}
+
+func (p *instrumentedProvider) Provision(Id string, plan *ProviderPlan, Owner string) (instance *Instance, err error) {
+	defer func(started time.Time) { p.observe("provision", started, err) }(time.Now())
+	return p.provider.Provision(Id, plan, Owner)
+}
+
+func (p *instrumentedProvider) Deprovision(Instance *Instance, takeSnapshot bool) (err error) {
+	defer func(started time.Time) { p.observe("deprovision", started, err) }(time.Now())
+	return p.provider.Deprovision(Instance, takeSnapshot)
+}
+
+func (p *instrumentedProvider) Modify(Instance *Instance, plan *ProviderPlan) (instance *Instance, err error) {
+	defer func(started time.Time) { p.observe("modify", started, err) }(time.Now())
+	return p.provider.Modify(Instance, plan)
+}
+
+func (p *instrumentedProvider) Tag(Instance *Instance, Name string, Value string) (err error) {
+	defer func(started time.Time) { p.observe("tag", started, err) }(time.Now())
+	return p.provider.Tag(Instance, Name, Value)
+}
+
+func (p *instrumentedProvider) Untag(Instance *Instance, Name string) (err error) {
+	defer func(started time.Time) { p.observe("untag", started, err) }(time.Now())
+	return p.provider.Untag(Instance, Name)
+}
+
+func (p *instrumentedProvider) Restart(Instance *Instance) (err error) {
+	defer func(started time.Time) { p.observe("restart", started, err) }(time.Now())
+	return p.provider.Restart(Instance)
+}
+
+func (p *instrumentedProvider) PerformPostProvision(Instance *Instance) (instance *Instance, err error) {
+	defer func(started time.Time) { p.observe("perform_post_provision", started, err) }(time.Now())
+	return p.provider.PerformPostProvision(Instance)
+}
+
+func (p *instrumentedProvider) GetUrl(Instance *Instance) map[string]interface{} {
+	return p.provider.GetUrl(Instance)
+}
+
+func (p *instrumentedProvider) Flush(Instance *Instance) (err error) {
+	defer func(started time.Time) { p.observe("flush", started, err) }(time.Now())
+	return p.provider.Flush(Instance)
+}
+
+func (p *instrumentedProvider) Stats(Instance *Instance) (stats []Stat, err error) {
+	defer func(started time.Time) { p.observe("stats", started, err) }(time.Now())
+	return p.provider.Stats(Instance)
+}
+
+func (p *instrumentedProvider) GetBackup(Instance *Instance, Id string) (backup *BackupSpec, err error) {
+	defer func(started time.Time) { p.observe("get_backup", started, err) }(time.Now())
+	return p.provider.GetBackup(Instance, Id)
+}
+
+func (p *instrumentedProvider) ListBackups(Instance *Instance) (backups []BackupSpec, err error) {
+	defer func(started time.Time) { p.observe("list_backups", started, err) }(time.Now())
+	return p.provider.ListBackups(Instance)
+}
+
+func (p *instrumentedProvider) CreateBackup(Instance *Instance) (backup *BackupSpec, err error) {
+	defer func(started time.Time) { p.observe("create_backup", started, err) }(time.Now())
+	return p.provider.CreateBackup(Instance)
+}
+
+func (p *instrumentedProvider) CopyBackup(Instance *Instance, Id string) (err error) {
+	defer func(started time.Time) { p.observe("copy_backup", started, err) }(time.Now())
+	return p.provider.CopyBackup(Instance, Id)
+}
+
+func (p *instrumentedProvider) RestoreBackup(Instance *Instance, Id string) (err error) {
+	defer func(started time.Time) { p.observe("restore_backup", started, err) }(time.Now())
+	return p.provider.RestoreBackup(Instance, Id)
+}
+
+func (p *instrumentedProvider) CreateBindingUser(Instance *Instance, bindingId string) (username string, password string, err error) {
+	defer func(started time.Time) { p.observe("create_binding_user", started, err) }(time.Now())
+	return p.provider.CreateBindingUser(Instance, bindingId)
+}
+
+func (p *instrumentedProvider) DeleteBindingUser(Instance *Instance, username string) (err error) {
+	defer func(started time.Time) { p.observe("delete_binding_user", started, err) }(time.Now())
+	return p.provider.DeleteBindingUser(Instance, username)
+}
+
+func (p *instrumentedProvider) UpdateAuthToken(Instance *Instance, token string, strategy string) (err error) {
+	defer func(started time.Time) { p.observe("update_auth_token", started, err) }(time.Now())
+	return p.provider.UpdateAuthToken(Instance, token, strategy)
+}
+
+func (p *instrumentedProvider) UpdateConfig(Instance *Instance) (err error) {
+	defer func(started time.Time) { p.observe("update_config", started, err) }(time.Now())
+	return p.provider.UpdateConfig(Instance)
+}
+
+func (p *instrumentedProvider) EngineVersions(Instance *Instance) (versions []string, err error) {
+	defer func(started time.Time) { p.observe("engine_versions", started, err) }(time.Now())
+	return p.provider.EngineVersions(Instance)
+}
+
+func (p *instrumentedProvider) UpgradeEngine(Instance *Instance, version string) (err error) {
+	defer func(started time.Time) { p.observe("upgrade_engine", started, err) }(time.Now())
+	return p.provider.UpgradeEngine(Instance, version)
+}
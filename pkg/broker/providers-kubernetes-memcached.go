@@ -1,8 +1,10 @@
 package broker
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	v1apps "k8s.io/api/apps/v1"
 	v1core "k8s.io/api/core/v1"
@@ -17,7 +19,6 @@ import (
 	"path/filepath"
 	"strings"
 	"strconv"
-	"time"
 
 	"k8s.io/client-go/kubernetes/fake"
 )
@@ -26,7 +27,7 @@ type KubernetesInstanceMemcachedProvider struct {
 	Provider
 	kubernetes    kubernetes.Interface
 	namePrefix    string
-	instanceCache map[string]*Instance
+	instanceCache *InstanceCache
 }
 
 type MemcachedProviderPlan struct {
@@ -34,11 +35,50 @@ type MemcachedProviderPlan struct {
 	Version         string `json:"version"`
 	DockerImage		*string `json:"docker_image"`
 	Port 			*int `json:"port"`
+	// Memcached settings, e.g., {"max_item_size":"1048576"}
+	Config map[string]interface{} `json:"config,omitempty"`
+}
+
+// memcachedArgs renders the plan's settings as memcached arguments.
+func memcachedArgs(settings *MemcachedProviderPlan) []string {
+	maxItemSize := "50M"
+	if value, ok := settings.Config["max_item_size"]; ok {
+		maxItemSize = fmt.Sprintf("%v", value)
+	}
+	args := []string{
+		"-m " + settings.SizeInMegabytes,
+		"-I " + maxItemSize,
+		"-p " + strconv.Itoa(*settings.Port),
+	}
+	if value, ok := settings.Config["chunk_size"]; ok {
+		args = append(args, fmt.Sprintf("-n %v", value))
+	}
+	if value, ok := settings.Config["chunk_size_growth_factor"]; ok {
+		args = append(args, fmt.Sprintf("-f %v", value))
+	}
+	if value, ok := settings.Config["idle_timeout"]; ok {
+		args = append(args, fmt.Sprintf("-o idle_timeout=%v", value))
+	}
+	return args
+}
+
+// memcachedSettings reads the plan's settings with the default image and port.
+func memcachedSettings(plan *ProviderPlan) (*MemcachedProviderPlan, error) {
+	var settings MemcachedProviderPlan
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	if settings.Port == nil || *settings.Port == 0 {
+		settings.Port = &defaultPort
+	}
+	if settings.DockerImage == nil || *settings.DockerImage == "" {
+		settings.DockerImage = &defaultImage
+	}
+	return &settings, nil
 }
 
 var defaultPort = 11211
 var defaultImage = "memcached"
-var namespace = "memcached-system"
 var fakeClient kubernetes.Interface = nil
 
 func IsReadyKubernetes(dep *v1apps.Deployment) bool {
@@ -52,10 +92,10 @@ func homeDir() string {
 	return os.Getenv("USERPROFILE") // windows
 }
 
-func NewKubernetesInstanceMemcachedProvider(namePrefix string) (*KubernetesInstanceMemcachedProvider, error) {
+func NewKubernetesInstanceMemcachedProvider(ctx context.Context, namePrefix string) (*KubernetesInstanceMemcachedProvider, error) {
 	var provider KubernetesInstanceMemcachedProvider = KubernetesInstanceMemcachedProvider{
 		namePrefix:    namePrefix,
-		instanceCache: make(map[string]*Instance),
+		instanceCache: NewInstanceCache(instanceCacheTTL),
 		kubernetes:    nil,
 	}
 	if os.Getenv("TEST") == "true" {
@@ -84,19 +124,27 @@ func NewKubernetesInstanceMemcachedProvider(namePrefix string) (*KubernetesInsta
 		provider.kubernetes = clientset
 	}
 
-	t := time.NewTicker(time.Second * 5)
-	go (func() {
-		for {
-			provider.instanceCache = make(map[string]*Instance)
-			<-t.C
-		}
-	})()
-
 	return &provider, nil
 }
 
+func init() {
+	RegisterProvider(KubernetesMemcachedInstance, func(ctx context.Context, namePrefix string) (Provider, error) {
+		if os.Getenv("USE_KUBERNETES") != "true" {
+			return nil, errors.New("Unable to find provider for plan.")
+		}
+		return NewKubernetesInstanceMemcachedProvider(ctx, namePrefix)
+	})
+}
+
 func (provider KubernetesInstanceMemcachedProvider) GetInstance(name string, plan *ProviderPlan) (*Instance, error) {
-	result, err := provider.kubernetes.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+	if instance, ok := provider.instanceCache.Get(name, plan); ok {
+		return instance, nil
+	}
+	kube, err := GetKubernetesSettings(plan, "memcached")
+	if err != nil {
+		return nil, err
+	}
+	result, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -110,34 +158,28 @@ func (provider KubernetesInstanceMemcachedProvider) GetInstance(name string, pla
 	if settings.DockerImage == nil || *settings.DockerImage == "" {
 		settings.DockerImage = &defaultImage
 	}
-	status := "unknown"
-	if result.Status.ReadyReplicas > 0 {
-		status = "available"
-	} else if result.Status.Replicas > 0 {
-		status = "creating"
-	}
-	if len(result.Status.Conditions) > 0 && result.Status.Conditions[0].Message == "Terminating" {
-		status = "deleting"
-	}
-	if len(result.Status.Conditions) > 0 && result.Status.Conditions[0].Message == "Pending" {
-		status = "creating"
+	status, err := GetKubernetesStatus(provider.kubernetes, deploymentWorkload(result))
+	if err != nil {
+		return nil, err
 	}
-	provider.instanceCache[name+plan.ID] = &Instance{
+	instance := &Instance{
 		Id:            "", // providers should not store this.
 		ProviderId:    name,
 		Name:          name,
 		Plan:          plan,
 		Username:      "", // providers should not store this.
 		Password:      "", // providers should not store this.
-		Endpoint:      name + "." + namespace + ".svc.cluster.local:" + strconv.Itoa(*settings.Port),
-		Status:        status,
-		Ready:         IsReadyKubernetes(result),
+		Endpoint:      kube.Endpoint(name, *settings.Port),
+		Status:        status.Status,
+		StatusDetail:  status.Description,
+		Ready:         status.Ready,
 		Engine:        "memcached",
 		EngineVersion: settings.Version,
 		Scheme:        plan.Scheme,
 	}
 
-	return provider.instanceCache[name+plan.ID], nil
+	provider.instanceCache.Set(name, instance)
+	return instance, nil
 }
 
 func (provider KubernetesInstanceMemcachedProvider) PerformPostProvision(db *Instance) (*Instance, error) {
@@ -151,15 +193,13 @@ func (provider KubernetesInstanceMemcachedProvider) GetUrl(instance *Instance) m
 }
 
 func (provider KubernetesInstanceMemcachedProvider) Provision(Id string, plan *ProviderPlan, Owner string) (*Instance, error) {
-	var settings MemcachedProviderPlan
-	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+	settings, err := memcachedSettings(plan)
+	if err != nil {
 		return nil, err
 	}
-	if settings.Port == nil || *settings.Port == 0 {
-		settings.Port = &defaultPort
-	}
-	if settings.DockerImage == nil || *settings.DockerImage == "" {
-		settings.DockerImage = &defaultImage
+	kube, err := GetKubernetesSettings(plan, "memcached")
+	if err != nil {
+		return nil, err
 	}
 	limits := v1core.ResourceList{}
 	qty, err := resource.ParseQuantity(settings.SizeInMegabytes + "Mi")
@@ -173,15 +213,11 @@ func (provider KubernetesInstanceMemcachedProvider) Provision(Id string, plan *P
 			Containers: []v1core.Container{
 				v1core.Container{
 					Name:  "memcached",
-					Image: *settings.DockerImage + ":" + settings.Version,
+					Image: kube.Image(*settings.DockerImage + ":" + settings.Version),
 					Resources: v1core.ResourceRequirements{
 						Limits: limits,
 					},
-					Args: []string{
-						"-m " + settings.SizeInMegabytes,
-						"-I 50M",
-						"-p " + strconv.Itoa(*settings.Port),
-					},
+					Args: memcachedArgs(settings),
 					Ports: []v1core.ContainerPort{
 						v1core.ContainerPort{
 							ContainerPort: int32(*settings.Port),
@@ -193,9 +229,10 @@ func (provider KubernetesInstanceMemcachedProvider) Provision(Id string, plan *P
 		},
 	}
 	pod.SetName(name)
-	pod.SetNamespace(namespace)
-	pod.SetLabels(map[string]string{"app": name})
+	pod.SetNamespace(kube.Namespace)
+	pod.SetLabels(kube.ObjectLabels(name))
 	pod.SetAnnotations(map[string]string{"owner": Owner})
+	kube.ApplyToPod(&pod)
 
 	var replicas int32 = 1
 	selector := metav1.LabelSelector{
@@ -212,11 +249,11 @@ func (provider KubernetesInstanceMemcachedProvider) Provision(Id string, plan *P
 		},
 	}
 	deployment.SetName(name)
-	deployment.SetNamespace(namespace)
-	deployment.SetLabels(map[string]string{"app": name})
+	deployment.SetNamespace(kube.Namespace)
+	deployment.SetLabels(kube.ObjectLabels(name))
 	deployment.SetAnnotations(map[string]string{"owner": Owner})
 
-	result, err := provider.kubernetes.AppsV1().Deployments(namespace).Create(&deployment)
+	result, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Create(&deployment)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +261,7 @@ func (provider KubernetesInstanceMemcachedProvider) Provision(Id string, plan *P
 	// Create the service
 	service := v1core.Service{
 		Spec: v1core.ServiceSpec{
-			Type: v1core.ServiceTypeNodePort,
+			Type: kube.ServiceType,
 			Ports: []v1core.ServicePort{
 				v1core.ServicePort{
 					Port:       int32(*settings.Port),
@@ -237,11 +274,11 @@ func (provider KubernetesInstanceMemcachedProvider) Provision(Id string, plan *P
 		},
 	}
 	service.SetName(name)
-	service.SetNamespace(namespace)
-	service.SetLabels(map[string]string{"app": name})
+	service.SetNamespace(kube.Namespace)
+	service.SetLabels(kube.ObjectLabels(name))
 	service.SetAnnotations(map[string]string{"owner": Owner})
 
-	if _, err = provider.kubernetes.CoreV1().Services(namespace).Create(&service); err != nil {
+	if _, err = provider.kubernetes.CoreV1().Services(kube.Namespace).Create(&service); err != nil {
 		return nil, err
 	}
 
@@ -252,7 +289,7 @@ func (provider KubernetesInstanceMemcachedProvider) Provision(Id string, plan *P
 		Plan:          plan,
 		Username:      "",
 		Password:      "",
-		Endpoint:      name + "." + namespace + ".svc.cluster.local:" + strconv.Itoa(*settings.Port),
+		Endpoint:      kube.Endpoint(name, *settings.Port),
 		Status:        "creating",
 		Ready:         IsReadyKubernetes(result),
 		Engine:        "memcached",
@@ -262,16 +299,26 @@ func (provider KubernetesInstanceMemcachedProvider) Provision(Id string, plan *P
 }
 
 func (provider KubernetesInstanceMemcachedProvider) Deprovision(Instance *Instance, takeSnapshot bool) error {
-	err := provider.kubernetes.CoreV1().Services(namespace).Delete(Instance.Name, &metav1.DeleteOptions{})
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	kube, err := GetKubernetesSettings(Instance.Plan, "memcached")
+	if err != nil {
+		return err
+	}
+	err = provider.kubernetes.CoreV1().Services(kube.Namespace).Delete(Instance.Name, &metav1.DeleteOptions{})
 	if err != nil {
 		return err
 	}
-	err = provider.kubernetes.AppsV1().Deployments(namespace).Delete(Instance.Name, &metav1.DeleteOptions{})
+	err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Delete(Instance.Name, &metav1.DeleteOptions{})
 	return err
 }
 
 func (provider KubernetesInstanceMemcachedProvider) Modify(Instance *Instance, plan *ProviderPlan) (*Instance, error) {
-	result, err := provider.kubernetes.AppsV1().Deployments(namespace).Get(Instance.ProviderId, metav1.GetOptions{})
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	kube, err := GetKubernetesSettings(Instance.Plan, "memcached")
+	if err != nil {
+		return nil, err
+	}
+	result, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -283,27 +330,40 @@ func (provider KubernetesInstanceMemcachedProvider) Modify(Instance *Instance, p
 }
 
 func (provider KubernetesInstanceMemcachedProvider) Tag(Instance *Instance, Name string, Value string) error {
-	result, err := provider.kubernetes.AppsV1().Deployments(namespace).Get(Instance.ProviderId, metav1.GetOptions{})
+	kube, err := GetKubernetesSettings(Instance.Plan, "memcached")
+	if err != nil {
+		return err
+	}
+	result, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
 	result.Annotations[Name] = Value
-	_, err = provider.kubernetes.AppsV1().Deployments(namespace).Update(result)
+	_, err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Update(result)
 	return err
 }
 
 func (provider KubernetesInstanceMemcachedProvider) Untag(Instance *Instance, Name string) error {
-	result, err := provider.kubernetes.AppsV1().Deployments(namespace).Get(Instance.ProviderId, metav1.GetOptions{})
+	kube, err := GetKubernetesSettings(Instance.Plan, "memcached")
+	if err != nil {
+		return err
+	}
+	result, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
 	delete(result.Annotations, Name)
-	_, err = provider.kubernetes.AppsV1().Deployments(namespace).Update(result)
+	_, err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Update(result)
 	return err
 }
 
 func (provider KubernetesInstanceMemcachedProvider) Restart(Instance *Instance) error {
-	return provider.kubernetes.CoreV1().Pods(namespace).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: "app=" + Instance.ProviderId})
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	kube, err := GetKubernetesSettings(Instance.Plan, "memcached")
+	if err != nil {
+		return err
+	}
+	return provider.kubernetes.CoreV1().Pods(kube.Namespace).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: "app=" + Instance.ProviderId})
 }
 
 func (provider KubernetesInstanceMemcachedProvider) Flush(Instance *Instance) error {
@@ -367,6 +427,71 @@ func (provider KubernetesInstanceMemcachedProvider) CreateBackup(*Instance) (*Ba
 	return nil, errors.New("Backups are unavailable on a memcached")
 }
 
+func (provider KubernetesInstanceMemcachedProvider) CopyBackup(*Instance, string) error {
+	return errors.New("Backups are unavailable on a memcached")
+}
+
 func (provider KubernetesInstanceMemcachedProvider) RestoreBackup(*Instance, string) error {
 	return errors.New("Backups are unavailable on a memcached")
 }
+
+func (provider KubernetesInstanceMemcachedProvider) CreateBindingUser(*Instance, string) (string, string, error) {
+	return "", "", errors.New("This feature is not available on this plan.")
+}
+
+func (provider KubernetesInstanceMemcachedProvider) DeleteBindingUser(*Instance, string) error {
+	return errors.New("This feature is not available on this plan.")
+}
+
+func (provider KubernetesInstanceMemcachedProvider) UpdateAuthToken(*Instance, string, string) error {
+	return errors.New("This feature is not available on this plan.")
+}
+
+// UpdateConfig gives memcached the settings of the instance's plan with a rolling update.
+func (provider KubernetesInstanceMemcachedProvider) UpdateConfig(Instance *Instance) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	settings, err := memcachedSettings(Instance.Plan)
+	if err != nil {
+		return err
+	}
+	kube, err := GetKubernetesSettings(Instance.Plan, "memcached")
+	if err != nil {
+		return err
+	}
+	deployment, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	deployment.Spec.Template.Spec.Containers[0].Args = memcachedArgs(settings)
+	_, err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Update(deployment)
+	return err
+}
+
+// EngineVersions lists the allowed image tags newer than the instance's version.
+func (provider KubernetesInstanceMemcachedProvider) EngineVersions(Instance *Instance) ([]string, error) {
+	kube, err := GetKubernetesSettings(Instance.Plan, "memcached")
+	if err != nil {
+		return nil, err
+	}
+	return upgradableEngineVersions(Instance.EngineVersion, kube.AllowedVersions), nil
+}
+
+// UpgradeEngine changes the memcached image with a rolling update.
+func (provider KubernetesInstanceMemcachedProvider) UpgradeEngine(Instance *Instance, version string) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	settings, err := memcachedSettings(Instance.Plan)
+	if err != nil {
+		return err
+	}
+	kube, err := GetKubernetesSettings(Instance.Plan, "memcached")
+	if err != nil {
+		return err
+	}
+	deployment, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	deployment.Spec.Template.Spec.Containers[0].Image = kube.Image(*settings.DockerImage + ":" + version)
+	_, err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Update(deployment)
+	return err
+}
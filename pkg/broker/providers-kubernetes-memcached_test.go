@@ -22,12 +22,12 @@ func TestKubernetesMemcachedProvision(t *testing.T) {
 	os.Setenv("USE_KUBERNETES", "true")
 
 	Convey("Given a provisioner on kubernetes.", t, func() {
-		logic, err = NewBusinessLogic(context.TODO(), Options{DatabaseUrl: os.Getenv("DATABASE_URL"), NamePrefix: namePrefix})
+		logic, err = NewBusinessLogic(context.TODO(), Options{DatabaseUrl: testDatabaseUrl(), NamePrefix: namePrefix})
 		So(err, ShouldBeNil)
 		So(logic, ShouldNotBeNil)
 
 		Convey("Ensure the initialization for the database works", func() {
-			storage, err := InitStorage(context.TODO(), Options{DatabaseUrl: os.Getenv("DATABASE_URL"), NamePrefix: namePrefix})
+			storage, err := NewStorage(context.TODO(), Options{DatabaseUrl: testDatabaseUrl(), NamePrefix: namePrefix})
 			So(err, ShouldBeNil)
 			So(storage, ShouldNotBeNil)
 		})
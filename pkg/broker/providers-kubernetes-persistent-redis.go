@@ -0,0 +1,681 @@
+package broker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/golang/glog"
+	"io"
+	"io/ioutil"
+	v1apps "k8s.io/api/apps/v1"
+	v1core "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KubernetesPersistentRedisProvider runs redis in a stateful set with a persistent volume
+// mounted at /data, so data survives restarts and plan changes. Everything that does not
+// depend on the workload type is shared with the ephemeral kubernetes redis provider.
+type KubernetesPersistentRedisProvider struct {
+	*KubernetesInstanceRedisProvider
+	blobs BlobStore
+	dump  func(*Instance) (io.ReadCloser, error)
+}
+
+func NewKubernetesPersistentRedisProvider(ctx context.Context, namePrefix string) (*KubernetesPersistentRedisProvider, error) {
+	redisProvider, err := NewKubernetesInstanceRedisProvider(ctx, namePrefix)
+	if err != nil {
+		return nil, err
+	}
+	provider := KubernetesPersistentRedisProvider{KubernetesInstanceRedisProvider: redisProvider}
+	provider.dump = provider.dumpFromPod
+	if os.Getenv("TEST") == "true" {
+		provider.dump = func(*Instance) (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader("REDIS0009")), nil
+		}
+	}
+	// Backups are optional, without a backup store they are reported as unavailable.
+	if blobs, err := NewBlobStoreFromEnv(); err == nil {
+		provider.blobs = blobs
+	} else if os.Getenv("BACKUP_STORE") != "" {
+		return nil, err
+	}
+	return &provider, nil
+}
+
+func init() {
+	RegisterProvider(KubernetesRedisPersistent, func(ctx context.Context, namePrefix string) (Provider, error) {
+		if os.Getenv("USE_KUBERNETES") != "true" {
+			return nil, errors.New("Unable to find provider for plan.")
+		}
+		return NewKubernetesPersistentRedisProvider(ctx, namePrefix)
+	})
+}
+
+func persistenceArgs(settings *redisProviderPlan) []string {
+	args := make([]string, 0)
+	if settings.AppendOnly {
+		args = append(args, "--appendonly", "yes")
+		if settings.AppendFsync != "" {
+			args = append(args, "--appendfsync", settings.AppendFsync)
+		}
+	} else {
+		args = append(args, "--appendonly", "no")
+	}
+	if settings.Save != "" {
+		args = append(args, "--save", settings.Save)
+	}
+	return args
+}
+
+func persistentVolumeClaimName(name string) string {
+	return "data-" + name + "-0"
+}
+
+func (provider KubernetesPersistentRedisProvider) GetInstance(name string, plan *ProviderPlan) (*Instance, error) {
+	kube, err := GetKubernetesSettings(plan, "redis")
+	if err != nil {
+		return nil, err
+	}
+	result, err := provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var settings redisProviderPlan
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	status, err := GetKubernetesStatus(provider.kubernetes, statefulSetWorkload(result))
+	if err != nil {
+		return nil, err
+	}
+	return &Instance{
+		Id:            "", // providers should not store this.
+		ProviderId:    name,
+		Name:          name,
+		Plan:          plan,
+		Username:      "", // providers should not store this.
+		Password:      "", // providers should not store this.
+		Endpoint:      kube.Endpoint(name, 6379),
+		Status:        status.Status,
+		StatusDetail:  status.Description,
+		Ready:         status.Ready,
+		Engine:        "redis",
+		EngineVersion: settings.Version,
+		Scheme:        plan.Scheme,
+	}, nil
+}
+
+func (provider KubernetesPersistentRedisProvider) Provision(Id string, plan *ProviderPlan, Owner string) (*Instance, error) {
+	var settings redisProviderPlan
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	if settings.StorageSize == "" {
+		return nil, errors.New("Persistent redis plans must specify the size of their volume (storage_size).")
+	}
+	storage, err := resource.ParseQuantity(settings.StorageSize)
+	if err != nil {
+		return nil, err
+	}
+	kube, err := GetKubernetesSettings(plan, "redis")
+	if err != nil {
+		return nil, err
+	}
+	name := provider.namePrefix + strings.ToLower(RandomString(9))
+	pod, password, err := provider.redisPodTemplate(name, Owner, &settings, kube)
+	if err != nil {
+		return nil, err
+	}
+	pod.Spec.Containers[0].Args = append(pod.Spec.Containers[0].Args, persistenceArgs(&settings)...)
+	pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, v1core.VolumeMount{
+		Name:      "data",
+		MountPath: "/data",
+	})
+
+	claim := v1core.PersistentVolumeClaim{
+		Spec: v1core.PersistentVolumeClaimSpec{
+			AccessModes: []v1core.PersistentVolumeAccessMode{v1core.ReadWriteOnce},
+			Resources: v1core.ResourceRequirements{
+				Requests: v1core.ResourceList{v1core.ResourceStorage: storage},
+			},
+		},
+	}
+	if settings.StorageClass != "" {
+		claim.Spec.StorageClassName = &settings.StorageClass
+	}
+	claim.SetName("data")
+	claim.SetLabels(kube.ObjectLabels(name))
+
+	var replicas int32 = 1
+	statefulset := v1apps.StatefulSet{
+		Spec: v1apps.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: name,
+			Template:    *pod,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			VolumeClaimTemplates: []v1core.PersistentVolumeClaim{claim},
+		},
+	}
+	statefulset.SetName(name)
+	statefulset.SetNamespace(kube.Namespace)
+	statefulset.SetLabels(kube.ObjectLabels(name))
+	statefulset.SetAnnotations(map[string]string{"owner": Owner})
+
+	if _, err = provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Create(&statefulset); err != nil {
+		return nil, err
+	}
+	if err = provider.createService(name, Owner, kube); err != nil {
+		return nil, err
+	}
+
+	return &Instance{
+		Id:            Id,
+		Name:          name,
+		ProviderId:    name,
+		Plan:          plan,
+		Username:      "",
+		Password:      password,
+		Endpoint:      kube.Endpoint(name, 6379),
+		Status:        "creating",
+		Ready:         false,
+		Engine:        "redis",
+		EngineVersion: settings.Version,
+		Scheme:        plan.Scheme,
+	}, nil
+}
+
+func (provider KubernetesPersistentRedisProvider) Deprovision(Instance *Instance, takeSnapshot bool) error {
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	err = provider.kubernetes.CoreV1().Services(kube.Namespace).Delete(Instance.Name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	err = provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Delete(Instance.Name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	// Volumes created from a stateful set's claim templates are not removed with it.
+	err = provider.kubernetes.CoreV1().PersistentVolumeClaims(kube.Namespace).Delete(persistentVolumeClaimName(Instance.Name), &metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	err = provider.kubernetes.CoreV1().Secrets(kube.Namespace).Delete(Instance.Name+"-acl", &metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// Modify resizes the instance in place, the memory limit and persistence settings are changed
+// with a rolling update of the stateful set and the volume claim is expanded, data is kept.
+func (provider KubernetesPersistentRedisProvider) Modify(instance *Instance, plan *ProviderPlan) (*Instance, error) {
+	if !CanBeModified(instance.Status) {
+		return nil, errors.New("Databases cannot be modifed during backups, upgrades or while maintenance is being performed.")
+	}
+	var current redisProviderPlan
+	if err := json.Unmarshal([]byte(instance.Plan.providerPrivateDetails), &current); err != nil {
+		return nil, err
+	}
+	var settings redisProviderPlan
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	if settings.Version != current.Version {
+		return nil, errors.New("The redis version cannot be changed by changing plans.")
+	}
+	memory, err := resource.ParseQuantity(settings.SizeInMegabytes + "Mi")
+	if err != nil {
+		return nil, err
+	}
+	storage, err := resource.ParseQuantity(settings.StorageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	kube, err := GetKubernetesSettings(instance.Plan, "redis")
+	if err != nil {
+		return nil, err
+	}
+	target, err := GetKubernetesSettings(plan, "redis")
+	if err != nil {
+		return nil, err
+	}
+	if target.Namespace != kube.Namespace {
+		return nil, errors.New("The namespace of a persistent redis cannot be changed by changing plans.")
+	}
+	claim, err := provider.kubernetes.CoreV1().PersistentVolumeClaims(kube.Namespace).Get(persistentVolumeClaimName(instance.Name), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	existing := claim.Spec.Resources.Requests[v1core.ResourceStorage]
+	if storage.Cmp(existing) < 0 {
+		return nil, errors.New("The storage of a persistent redis cannot be reduced.")
+	} else if storage.Cmp(existing) > 0 {
+		claim.Spec.Resources.Requests[v1core.ResourceStorage] = storage
+		if _, err = provider.kubernetes.CoreV1().PersistentVolumeClaims(kube.Namespace).Update(claim); err != nil {
+			return nil, err
+		}
+	}
+
+	statefulset, err := provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Get(instance.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	container := &statefulset.Spec.Template.Spec.Containers[0]
+	container.Resources.Limits[v1core.ResourceMemory] = memory
+	container.Args = append(redisArgs(container, &settings), persistenceArgs(&settings)...)
+	if _, err = provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Update(statefulset); err != nil {
+		return nil, err
+	}
+
+	return &Instance{
+		Id:            instance.Id,
+		Name:          instance.Name,
+		ProviderId:    instance.ProviderId,
+		Plan:          plan,
+		Username:      instance.Username,
+		Password:      instance.Password,
+		Endpoint:      instance.Endpoint,
+		Status:        "modifying",
+		Ready:         false,
+		Engine:        "redis",
+		EngineVersion: settings.Version,
+		Scheme:        plan.Scheme,
+	}, nil
+}
+
+func (provider KubernetesPersistentRedisProvider) Tag(Instance *Instance, Name string, Value string) error {
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	result, err := provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	result.Annotations[Name] = Value
+	_, err = provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Update(result)
+	return err
+}
+
+func (provider KubernetesPersistentRedisProvider) Untag(Instance *Instance, Name string) error {
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	result, err := provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	delete(result.Annotations, Name)
+	_, err = provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Update(result)
+	return err
+}
+
+// dumpFromPod streams the RDB file out of the redis container, the same as kubectl exec cat.
+func (provider KubernetesPersistentRedisProvider) dumpFromPod(instance *Instance) (io.ReadCloser, error) {
+	if provider.config == nil {
+		return nil, errors.New("Unable to copy the backup from the pod without a kubernetes config.")
+	}
+	kube, err := GetKubernetesSettings(instance.Plan, "redis")
+	if err != nil {
+		return nil, err
+	}
+	req := provider.kubernetes.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(instance.Name+"-0").
+		Namespace(kube.Namespace).
+		SubResource("exec").
+		VersionedParams(&v1core.PodExecOptions{
+			Container: "redis",
+			Command:   []string{"cat", "/data/dump.rdb"},
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(provider.config, "POST", req.URL())
+	if err != nil {
+		return nil, err
+	}
+	reader, writer := io.Pipe()
+	go (func() {
+		var stderr bytes.Buffer
+		if err := exec.Stream(remotecommand.StreamOptions{Stdout: writer, Stderr: &stderr}); err != nil {
+			writer.CloseWithError(errors.New(err.Error() + " " + stderr.String()))
+			return
+		}
+		writer.Close()
+	})()
+	return reader, nil
+}
+
+func (provider KubernetesPersistentRedisProvider) lastSave(instance *Instance) (int64, error) {
+	result, err := provider.execute(instance, "LASTSAVE")
+	if err != nil {
+		return 0, err
+	}
+	if lastsave, ok := result.(int64); ok {
+		return lastsave, nil
+	}
+	return 0, nil
+}
+
+// waitForRollout waits for every replica of the stateful set to be updated and ready.
+func (provider KubernetesPersistentRedisProvider) waitForRollout(namespace string, name string) error {
+	for i := 0; i < 180; i++ {
+		result, err := provider.kubernetes.AppsV1().StatefulSets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if result.Status.ObservedGeneration >= result.Generation && result.Status.UpdatedReplicas == result.Status.Replicas && result.Status.ReadyReplicas == result.Status.Replicas {
+			return nil
+		}
+		time.Sleep(time.Second * 5)
+	}
+	return errors.New("Timed out waiting for " + name + " to become ready.")
+}
+
+// How long redis is given to save the RDB file of a backup before it is given up on.
+var backupSaveTimeout = time.Minute * 10
+
+func backupKey(instance *Instance, Id string) string {
+	return instance.Name + "/" + Id + ".rdb"
+}
+
+func backupMarkerKey(instance *Instance, Id string) string {
+	return instance.Name + "/" + Id + ".creating"
+}
+
+func blobToBackupSpec(instance *Instance, Id string, status string, created time.Time) BackupSpec {
+	var progress int64 = 100
+	if status == "creating" {
+		progress = 50
+	}
+	return BackupSpec{
+		Resource: ResourceSpec{
+			Name: instance.Name,
+		},
+		Id:       &Id,
+		Progress: &progress,
+		Status:   &status,
+		Created:  created.UTC().Format(time.RFC3339),
+	}
+}
+
+func (provider KubernetesPersistentRedisProvider) GetBackup(instance *Instance, Id string) (*BackupSpec, error) {
+	if provider.blobs == nil {
+		return nil, errors.New("Backups are unavailable, no backup store is configured.")
+	}
+	blob, err := provider.blobs.Stat(backupKey(instance, Id))
+	if err == nil {
+		backup := blobToBackupSpec(instance, Id, "available", blob.Modified)
+		return &backup, nil
+	} else if err.Error() != "Not found" {
+		return nil, err
+	}
+	blob, err = provider.blobs.Stat(backupMarkerKey(instance, Id))
+	if err != nil {
+		return nil, err
+	}
+	backup := blobToBackupSpec(instance, Id, "creating", blob.Modified)
+	return &backup, nil
+}
+
+func (provider KubernetesPersistentRedisProvider) ListBackups(instance *Instance) ([]BackupSpec, error) {
+	if provider.blobs == nil {
+		return nil, errors.New("Backups are unavailable, no backup store is configured.")
+	}
+	blobs, err := provider.blobs.List(instance.Name + "/")
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(blobs, func(i, j int) bool { return blobs[i].Modified.Before(blobs[j].Modified) })
+	backups := make([]BackupSpec, 0)
+	for _, blob := range blobs {
+		name := strings.TrimPrefix(blob.Key, instance.Name+"/")
+		if strings.HasSuffix(name, ".rdb") {
+			backups = append(backups, blobToBackupSpec(instance, strings.TrimSuffix(name, ".rdb"), "available", blob.Modified))
+		} else if strings.HasSuffix(name, ".creating") {
+			backups = append(backups, blobToBackupSpec(instance, strings.TrimSuffix(name, ".creating"), "creating", blob.Modified))
+		}
+	}
+	return backups, nil
+}
+
+// CreateBackup triggers a BGSAVE and returns immediately, the time redis last saved before
+// it is kept in the backup's marker so CopyBackup can tell when the RDB file is ready.
+func (provider KubernetesPersistentRedisProvider) CreateBackup(instance *Instance) (*BackupSpec, error) {
+	if provider.blobs == nil {
+		return nil, errors.New("Backups are unavailable, no backup store is configured.")
+	}
+	Id := instance.Name + "-" + time.Now().UTC().Format("20060102150405")
+	before, err := provider.lastSave(instance)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = provider.execute(instance, "BGSAVE"); err != nil {
+		return nil, err
+	}
+	if err = provider.blobs.Put(backupMarkerKey(instance, Id), strings.NewReader(strconv.FormatInt(before, 10))); err != nil {
+		return nil, err
+	}
+	backup := blobToBackupSpec(instance, Id, "creating", time.Now())
+	return &backup, nil
+}
+
+// CopyBackup copies the RDB file from the pod into the backup store once redis has finished
+// saving it, until then an error is returned so the task running it is retried. Backups redis
+// does not save within backupSaveTimeout are given up on.
+func (provider KubernetesPersistentRedisProvider) CopyBackup(instance *Instance, Id string) error {
+	if provider.blobs == nil {
+		return errors.New("Backups are unavailable, no backup store is configured.")
+	}
+	marker, err := provider.blobs.Stat(backupMarkerKey(instance, Id))
+	if err != nil && err.Error() == "Not found" {
+		// A retry of a copy that finished but was not recorded as finished.
+		_, err = provider.blobs.Stat(backupKey(instance, Id))
+		return err
+	} else if err != nil {
+		return err
+	}
+	reader, err := provider.blobs.Get(backupMarkerKey(instance, Id))
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		return err
+	}
+	before, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return errors.New("The marker of backup " + Id + " is not valid: " + err.Error())
+	}
+	after, err := provider.lastSave(instance)
+	if err != nil {
+		return err
+	}
+	if after <= before {
+		if time.Since(marker.Modified) > backupSaveTimeout {
+			if err = provider.blobs.Delete(backupMarkerKey(instance, Id)); err != nil {
+				return err
+			}
+			return errors.New("Timed out waiting for redis to save backup " + Id + ".")
+		}
+		return errors.New("Redis has not yet saved backup " + Id + ".")
+	}
+	dump, err := provider.dump(instance)
+	if err != nil {
+		return err
+	}
+	defer dump.Close()
+	if err = provider.blobs.Put(backupKey(instance, Id), dump); err != nil {
+		return err
+	}
+	return provider.blobs.Delete(backupMarkerKey(instance, Id))
+}
+
+// RestoreBackup seeds a new pod from the dump with an init container. Redis ignores the
+// RDB file when AOF is enabled, so the pod first starts without AOF, AOF is then turned
+// on (which rewrites it from the restored data) and the pod is rolled back to normal.
+func (provider KubernetesPersistentRedisProvider) RestoreBackup(instance *Instance, Id string) error {
+	backup, err := provider.GetBackup(instance, Id)
+	if err != nil {
+		return err
+	}
+	if *backup.Status != "available" {
+		return errors.New("The backup is not yet available.")
+	}
+	url, err := provider.blobs.SignedUrl(backupKey(instance, Id), time.Hour)
+	if err != nil {
+		return err
+	}
+	var settings redisProviderPlan
+	if err := json.Unmarshal([]byte(instance.Plan.providerPrivateDetails), &settings); err != nil {
+		return err
+	}
+	kube, err := GetKubernetesSettings(instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+
+	statefulset, err := provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Get(instance.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	container := &statefulset.Spec.Template.Spec.Containers[0]
+	args := container.Args
+	seeding := settings
+	seeding.AppendOnly = false
+	container.Args = make([]string, 0)
+	if len(args) > 1 && args[0] == "--aclfile" {
+		container.Args = append(container.Args, args[0:2]...)
+	}
+	container.Args = append(container.Args, redisConfigArgs(&seeding)...)
+	container.Args = append(container.Args, persistenceArgs(&seeding)...)
+	statefulset.Spec.Template.Spec.InitContainers = []v1core.Container{
+		v1core.Container{
+			Name:    "restore",
+			Image:   kube.Image("busybox"),
+			Command: []string{"sh", "-c", "rm -f /data/appendonly.aof /data/dump.rdb && wget -q -O /data/dump.rdb \"$BACKUP_URL\""},
+			Env: []v1core.EnvVar{
+				v1core.EnvVar{Name: "BACKUP_URL", Value: url},
+			},
+			VolumeMounts: []v1core.VolumeMount{
+				v1core.VolumeMount{Name: "data", MountPath: "/data"},
+			},
+		},
+	}
+	if statefulset.Spec.Template.Annotations == nil {
+		statefulset.Spec.Template.Annotations = make(map[string]string)
+	}
+	statefulset.Spec.Template.Annotations["restored-from"] = Id
+	if _, err = provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Update(statefulset); err != nil {
+		return err
+	}
+	err = provider.seedFromBackup(instance, kube.Namespace, settings.AppendOnly)
+
+	// The init container empties the volume, it must be removed even when seeding failed or
+	// every restart of the pod would wipe the data.
+	if removeErr := provider.removeRestoreContainer(kube.Namespace, instance.Name, args); removeErr != nil {
+		if err != nil {
+			glog.Errorf("Unable to remove the restore init container from %s: %s\n", instance.Name, removeErr.Error())
+			return err
+		}
+		return removeErr
+	}
+	if err != nil {
+		return err
+	}
+	return provider.waitForRollout(kube.Namespace, instance.Name)
+}
+
+// seedFromBackup waits for the pod to load the restored RDB file and, if the plan uses AOF,
+// for the AOF to be rewritten from it.
+func (provider KubernetesPersistentRedisProvider) seedFromBackup(instance *Instance, namespace string, appendOnly bool) error {
+	if err := provider.waitForRollout(namespace, instance.Name); err != nil {
+		return err
+	}
+	if !appendOnly {
+		return nil
+	}
+	if _, err := provider.execute(instance, "CONFIG", "SET", "appendonly", "yes"); err != nil {
+		return err
+	}
+	for i := 0; i < 600; i++ {
+		info, err := provider.execute(instance, "INFO", "persistence")
+		if err != nil {
+			return err
+		}
+		if text, ok := info.(string); !ok || (!strings.Contains(text, "aof_rewrite_in_progress:1") && !strings.Contains(text, "aof_rewrite_scheduled:1")) {
+			return nil
+		}
+		time.Sleep(time.Second)
+	}
+	return errors.New("Timed out waiting for redis to rewrite the AOF of " + instance.Name + ".")
+}
+
+// removeRestoreContainer rolls the stateful set back to the arguments it had before the
+// restore, without the init container.
+func (provider KubernetesPersistentRedisProvider) removeRestoreContainer(namespace string, name string, args []string) error {
+	statefulset, err := provider.kubernetes.AppsV1().StatefulSets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	statefulset.Spec.Template.Spec.InitContainers = nil
+	statefulset.Spec.Template.Spec.Containers[0].Args = args
+	_, err = provider.kubernetes.AppsV1().StatefulSets(namespace).Update(statefulset)
+	return err
+}
+
+// UpdateConfig gives redis the settings of the instance's plan with a rolling update.
+func (provider KubernetesPersistentRedisProvider) UpdateConfig(Instance *Instance) error {
+	var settings redisProviderPlan
+	if err := json.Unmarshal([]byte(Instance.Plan.providerPrivateDetails), &settings); err != nil {
+		return err
+	}
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	statefulset, err := provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Get(Instance.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	container := &statefulset.Spec.Template.Spec.Containers[0]
+	container.Args = append(redisArgs(container, &settings), persistenceArgs(&settings)...)
+	_, err = provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Update(statefulset)
+	return err
+}
+
+// UpgradeEngine changes the redis image with a rolling update of the stateful set, the new
+// version loads the data left on the volume by the old one.
+func (provider KubernetesPersistentRedisProvider) UpgradeEngine(Instance *Instance, version string) error {
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	statefulset, err := provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Get(Instance.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	statefulset.Spec.Template.Spec.Containers[0].Image = kube.Image("redis:" + version)
+	_, err = provider.kubernetes.AppsV1().StatefulSets(kube.Namespace).Update(statefulset)
+	return err
+}
@@ -0,0 +1,173 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	"github.com/pmorie/osb-broker-lib/pkg/broker"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	v1core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestKubernetesPersistentRedisProvision(t *testing.T) {
+	var namePrefix = "test"
+	var logic *BusinessLogic
+	var plan osb.Plan
+	var highPlan osb.Plan
+	var instanceId string = RandomString(12)
+	var err error
+
+	os.Setenv("TEST", "true")
+	os.Setenv("USE_KUBERNETES", "true")
+	backups, err := ioutil.TempDir("", "redis-backups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(backups)
+	os.Setenv("BACKUP_STORE", "file://"+backups)
+	defer os.Unsetenv("BACKUP_STORE")
+
+	Convey("Given a persistent redis provisioner on kubernetes.", t, func() {
+		logic, err = NewBusinessLogic(context.TODO(), Options{DatabaseUrl: testDatabaseUrl(), NamePrefix: namePrefix})
+		So(err, ShouldBeNil)
+		So(logic, ShouldNotBeNil)
+
+		Convey("Ensure we can get the catalog and target plans exist", func() {
+			rc := broker.RequestContext{}
+			catalog, err := logic.GetCatalog(&rc)
+			So(err, ShouldBeNil)
+			So(catalog, ShouldNotBeNil)
+
+			var foundPersistent0 = false
+			var foundPersistent1 = false
+			for _, s := range catalog.Services {
+				if s.Name == "akkeris-redis" {
+					for _, p := range s.Plans {
+						if p.Name == "persistent-0" {
+							plan = p
+							foundPersistent0 = true
+						} else if p.Name == "persistent-1" {
+							highPlan = p
+							foundPersistent1 = true
+						}
+					}
+				}
+			}
+			So(foundPersistent0, ShouldEqual, true)
+			So(foundPersistent1, ShouldEqual, true)
+		})
+
+		Convey("Ensure kubernetes provisioner can provision a persistent redis instance", func() {
+			var request osb.ProvisionRequest = osb.ProvisionRequest{AcceptsIncomplete: true, InstanceID: instanceId, PlanID: plan.ID}
+			var c broker.RequestContext
+			res, err := logic.Provision(&request, &c)
+			So(err, ShouldBeNil)
+			So(res, ShouldNotBeNil)
+
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			statefulset, err := fakeClient.AppsV1().StatefulSets("redis-system").Get(instance.Name, metav1.GetOptions{})
+			So(err, ShouldBeNil)
+			So(len(statefulset.Spec.VolumeClaimTemplates), ShouldEqual, 1)
+			So(statefulset.Spec.Template.Spec.Containers[0].Args, ShouldContain, "--appendonly")
+		})
+
+		Convey("Ensure backups of persistent redis are copied to the backup store", func() {
+			var c broker.RequestContext
+			res, err := logic.ActionCreateBackup(instanceId, map[string]string{}, &c)
+			So(err, ShouldBeNil)
+			backup := res.(*BackupSpec)
+			So(*backup.Status, ShouldEqual, "creating")
+
+			// The copy is left to a task, which waits for redis to have saved since the backup
+			// was started (the fake LASTSAVE is the current second).
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			tasks, err := logic.storage.ListTasks(TaskFilter{ResourceId: instance.Id, Action: string(CopyBackupTask)})
+			So(err, ShouldBeNil)
+			So(len(tasks), ShouldEqual, 1)
+			time.Sleep(time.Millisecond * 1100)
+			RunTask(context.TODO(), logic.storage, namePrefix, &tasks[0])
+			task, err := logic.storage.GetTask(tasks[0].Id)
+			So(err, ShouldBeNil)
+			So(task.Status, ShouldEqual, "finished")
+
+			res, err = logic.ActionGetBackup(instanceId, map[string]string{"backup": *backup.Id}, &c)
+			So(err, ShouldBeNil)
+			So(*res.(*BackupSpec).Status, ShouldEqual, "available")
+
+			res, err = logic.ActionListBackups(instanceId, map[string]string{}, &c)
+			So(err, ShouldBeNil)
+			list := res.([]BackupSpec)
+			So(len(list), ShouldEqual, 1)
+			So(*list[0].Id, ShouldEqual, *backup.Id)
+		})
+
+		Convey("Ensure a failed restore does not leave the restore init container behind", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			provider, err := NewKubernetesPersistentRedisProvider(context.TODO(), namePrefix)
+			So(err, ShouldBeNil)
+			provider.execute = func(instance *Instance, args ...interface{}) (interface{}, error) {
+				return nil, errors.New("Unable to connect to redis.")
+			}
+			backups, err := provider.ListBackups(instance)
+			So(err, ShouldBeNil)
+			So(len(backups), ShouldEqual, 1)
+			before, err := fakeClient.AppsV1().StatefulSets("redis-system").Get(instance.Name, metav1.GetOptions{})
+			So(err, ShouldBeNil)
+
+			So(provider.RestoreBackup(instance, *backups[0].Id), ShouldNotBeNil)
+			statefulset, err := fakeClient.AppsV1().StatefulSets("redis-system").Get(instance.Name, metav1.GetOptions{})
+			So(err, ShouldBeNil)
+			So(len(statefulset.Spec.Template.Spec.InitContainers), ShouldEqual, 0)
+			So(statefulset.Spec.Template.Spec.Containers[0].Args, ShouldResemble, before.Spec.Template.Spec.Containers[0].Args)
+		})
+
+		Convey("Ensure plan changes resize the persistent redis in place", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+
+			// The fake client does not create claims from the stateful set's templates.
+			claim := v1core.PersistentVolumeClaim{
+				Spec: v1core.PersistentVolumeClaimSpec{
+					Resources: v1core.ResourceRequirements{
+						Requests: v1core.ResourceList{v1core.ResourceStorage: resource.MustParse("1Gi")},
+					},
+				},
+			}
+			claim.SetName(persistentVolumeClaimName(instance.Name))
+			claim.SetNamespace("redis-system")
+			_, err = fakeClient.CoreV1().PersistentVolumeClaims("redis-system").Create(&claim)
+			So(err, ShouldBeNil)
+
+			target, err := logic.storage.GetPlanByID(highPlan.ID)
+			So(err, ShouldBeNil)
+			provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+			So(err, ShouldBeNil)
+			modified, err := provider.Modify(instance, target)
+			So(err, ShouldBeNil)
+			So(modified.Name, ShouldEqual, instance.Name)
+			So(modified.Password, ShouldEqual, instance.Password)
+
+			resized, err := fakeClient.CoreV1().PersistentVolumeClaims("redis-system").Get(persistentVolumeClaimName(instance.Name), metav1.GetOptions{})
+			So(err, ShouldBeNil)
+			size := resized.Spec.Resources.Requests[v1core.ResourceStorage]
+			So(size.String(), ShouldEqual, "2Gi")
+		})
+
+		Convey("Ensure deprovisioner for persistent kubernetes redis works", func() {
+			var c broker.RequestContext
+			var drequest osb.DeprovisionRequest = osb.DeprovisionRequest{InstanceID: instanceId}
+			dres, err := logic.Deprovision(&drequest, &c)
+			So(err, ShouldBeNil)
+			So(dres, ShouldNotBeNil)
+		})
+	})
+}
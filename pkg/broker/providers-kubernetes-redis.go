@@ -1,8 +1,12 @@
 package broker
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	v1apps "k8s.io/api/apps/v1"
 	v1core "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -13,9 +17,12 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 	"github.com/go-redis/redis"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
@@ -23,27 +30,81 @@ type KubernetesInstanceRedisProvider struct {
 	Provider
 	kubernetes    kubernetes.Interface
 	namePrefix    string
-	instanceCache map[string]*Instance
+	instanceCache *InstanceCache
+	config        *rest.Config
+	execute       func(*Instance, ...interface{}) (interface{}, error)
 }
 
 type redisProviderPlan struct {
 	SizeInMegabytes string `json:"size_in_megabytes"`
 	Version         string `json:"version"`
+	// The settings below are only used by persistent plans.
+	StorageSize  string `json:"storage_size,omitempty"`
+	StorageClass string `json:"storage_class,omitempty"`
+	AppendOnly   bool   `json:"appendonly,omitempty"`
+	AppendFsync  string `json:"appendfsync,omitempty"`
+	Save         string `json:"save,omitempty"`
+	// Redis configuration given to redis-server, e.g., {"maxmemory-policy":"allkeys-lru"}
+	Config map[string]interface{} `json:"config,omitempty"`
 }
 
-var namespaceRedis string = "redis-system"
+// redisConfigArgs renders the plan's redis configuration as redis-server arguments.
+func redisConfigArgs(settings *redisProviderPlan) []string {
+	keys := make([]string, 0)
+	for key := range settings.Config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	args := make([]string, 0)
+	for _, key := range keys {
+		args = append(args, "--"+key, fmt.Sprintf("%v", settings.Config[key]))
+	}
+	return args
+}
+
+// Users for each binding are given everything but administrative commands (ACL, CONFIG, etc).
+var aclBindingRules string = "~* +@all -@admin"
+var aclFilePath string = "/etc/redis/users.acl"
+
+func redisMajorVersion(version string) int {
+	major, err := strconv.Atoi(strings.Split(version, ".")[0])
+	if err != nil {
+		return 0
+	}
+	return major
+}
+
+func aclUserLine(username string, password string, rules string) string {
+	sum := sha256.Sum256([]byte(password))
+	return "user " + username + " on #" + hex.EncodeToString(sum[:]) + " " + rules
+}
+
+// Redis 6 and above keep their users in an ACL file backed by a secret, so users
+// survive pod restarts, while changes are applied live with ACL SETUSER/DELUSER.
+func executeRedisCommand(instance *Instance, args ...interface{}) (interface{}, error) {
+	client := redis.NewClient(redisOptions(instance))
+	defer client.Close()
+	return client.Do(args...).Result()
+}
 
-func NewKubernetesInstanceRedisProvider(namePrefix string) (*KubernetesInstanceRedisProvider, error) {
+func NewKubernetesInstanceRedisProvider(ctx context.Context, namePrefix string) (*KubernetesInstanceRedisProvider, error) {
 	var provider KubernetesInstanceRedisProvider = KubernetesInstanceRedisProvider{
 		namePrefix:    namePrefix,
-		instanceCache: make(map[string]*Instance),
+		instanceCache: NewInstanceCache(instanceCacheTTL),
 		kubernetes:    nil,
+		execute:       executeRedisCommand,
 	}
 	if os.Getenv("TEST") == "true" {
 		if fakeClient == nil {
 			fakeClient = fake.NewSimpleClientset()
 		}
 		provider.kubernetes = fakeClient
+		provider.execute = func(instance *Instance, args ...interface{}) (interface{}, error) {
+			if len(args) > 0 && args[0] == "LASTSAVE" {
+				return time.Now().Unix(), nil
+			}
+			return nil, nil
+		}
 	} else {
 		config, err := rest.InClusterConfig()
 		if err != nil {
@@ -63,21 +124,30 @@ func NewKubernetesInstanceRedisProvider(namePrefix string) (*KubernetesInstanceR
 			panic(err.Error())
 		}
 		provider.kubernetes = clientset
+		provider.config = config
 	}
 
-	t := time.NewTicker(time.Second * 5)
-	go (func() {
-		for {
-			provider.instanceCache = make(map[string]*Instance)
-			<-t.C
-		}
-	})()
-
 	return &provider, nil
 }
 
+func init() {
+	RegisterProvider(KubernetesRedisInstance, func(ctx context.Context, namePrefix string) (Provider, error) {
+		if os.Getenv("USE_KUBERNETES") != "true" {
+			return nil, errors.New("Unable to find provider for plan.")
+		}
+		return NewKubernetesInstanceRedisProvider(ctx, namePrefix)
+	})
+}
+
 func (provider KubernetesInstanceRedisProvider) GetInstance(name string, plan *ProviderPlan) (*Instance, error) {
-	result, err := provider.kubernetes.AppsV1().Deployments(namespaceRedis).Get(name, metav1.GetOptions{})
+	if instance, ok := provider.instanceCache.Get(name, plan); ok {
+		return instance, nil
+	}
+	kube, err := GetKubernetesSettings(plan, "redis")
+	if err != nil {
+		return nil, err
+	}
+	result, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -85,34 +155,28 @@ func (provider KubernetesInstanceRedisProvider) GetInstance(name string, plan *P
 	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
 		return nil, err
 	}
-	status := "unknown"
-	if result.Status.ReadyReplicas > 0 {
-		status = "available"
-	} else if result.Status.Replicas > 0 {
-		status = "creating"
-	}
-	if len(result.Status.Conditions) > 0 && result.Status.Conditions[0].Message == "Terminating" {
-		status = "deleting"
-	}
-	if len(result.Status.Conditions) > 0 && result.Status.Conditions[0].Message == "Pending" {
-		status = "creating"
+	status, err := GetKubernetesStatus(provider.kubernetes, deploymentWorkload(result))
+	if err != nil {
+		return nil, err
 	}
-	provider.instanceCache[name+plan.ID] = &Instance{
+	instance := &Instance{
 		Id:            "", // providers should not store this.
 		ProviderId:    name,
 		Name:          name,
 		Plan:          plan,
 		Username:      "", // providers should not store this.
 		Password:      "", // providers should not store this.
-		Endpoint:      name + "." + namespaceRedis + ".svc.cluster.local:6379",
-		Status:        status,
-		Ready:         IsReadyKubernetes(result),
+		Endpoint:      kube.Endpoint(name, 6379),
+		Status:        status.Status,
+		StatusDetail:  status.Description,
+		Ready:         status.Ready,
 		Engine:        "redis",
 		EngineVersion: settings.Version,
 		Scheme:        plan.Scheme,
 	}
 
-	return provider.instanceCache[name+plan.ID], nil
+	provider.instanceCache.Set(name, instance)
+	return instance, nil
 }
 
 func (provider KubernetesInstanceRedisProvider) PerformPostProvision(db *Instance) (*Instance, error) {
@@ -121,28 +185,26 @@ func (provider KubernetesInstanceRedisProvider) PerformPostProvision(db *Instanc
 
 func (provider KubernetesInstanceRedisProvider) GetUrl(instance *Instance) map[string]interface{} {
 	return map[string]interface{}{
-		"REDIS_URL": instance.Scheme + "://" + instance.Endpoint,
+		"REDIS_URL": redisUrl(instance, instance.Endpoint),
 	}
 }
 
-func (provider KubernetesInstanceRedisProvider) Provision(Id string, plan *ProviderPlan, Owner string) (*Instance, error) {
-	var settings redisProviderPlan
-	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
-		return nil, err
-	}
+// redisPodTemplate builds the pod spec shared by the kubernetes redis providers, on redis 6
+// and above it also creates the ACL secret when there is none and returns the password for
+// the default user, an existing ACL secret is kept along with its users.
+func (provider KubernetesInstanceRedisProvider) redisPodTemplate(name string, Owner string, settings *redisProviderPlan, kube *KubernetesSettings) (*v1core.PodTemplateSpec, string, error) {
 	limits := v1core.ResourceList{}
 	qty, err := resource.ParseQuantity(settings.SizeInMegabytes + "Mi")
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	limits[v1core.ResourceMemory] = qty
-	name := provider.namePrefix + strings.ToLower(RandomString(9))
 	pod := v1core.PodTemplateSpec{
 		Spec: v1core.PodSpec{
 			Containers: []v1core.Container{
 				v1core.Container{
 					Name:  "redis",
-					Image: "redis:" + settings.Version,
+					Image: kube.Image("redis:" + settings.Version),
 					Resources: v1core.ResourceRequirements{
 						Limits: limits,
 					},
@@ -156,10 +218,94 @@ func (provider KubernetesInstanceRedisProvider) Provision(Id string, plan *Provi
 			},
 		},
 	}
+
+	var password = ""
+	if redisMajorVersion(settings.Version) >= 6 {
+		_, err = provider.kubernetes.CoreV1().Secrets(kube.Namespace).Get(name+"-acl", metav1.GetOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return nil, "", err
+		} else if err != nil {
+			password, err = RandomSecureString(32)
+			if err != nil {
+				return nil, "", err
+			}
+			secret := v1core.Secret{
+				StringData: map[string]string{
+					"users.acl": aclUserLine("default", password, "~* +@all") + "\n",
+				},
+			}
+			secret.SetName(name + "-acl")
+			secret.SetNamespace(kube.Namespace)
+			secret.SetLabels(kube.ObjectLabels(name))
+			secret.SetAnnotations(map[string]string{"owner": Owner})
+			if _, err = provider.kubernetes.CoreV1().Secrets(kube.Namespace).Create(&secret); err != nil {
+				return nil, "", err
+			}
+		}
+		pod.Spec.Volumes = []v1core.Volume{
+			v1core.Volume{
+				Name: "acl",
+				VolumeSource: v1core.VolumeSource{
+					Secret: &v1core.SecretVolumeSource{SecretName: name + "-acl"},
+				},
+			},
+		}
+		pod.Spec.Containers[0].Args = []string{"--aclfile", aclFilePath}
+		pod.Spec.Containers[0].VolumeMounts = []v1core.VolumeMount{
+			v1core.VolumeMount{
+				Name:      "acl",
+				MountPath: "/etc/redis",
+				ReadOnly:  true,
+			},
+		}
+	}
+	pod.Spec.Containers[0].Args = append(pod.Spec.Containers[0].Args, redisConfigArgs(settings)...)
 	pod.SetName(name)
-	pod.SetNamespace(namespaceRedis)
-	pod.SetLabels(map[string]string{"app": name})
+	pod.SetNamespace(kube.Namespace)
+	pod.SetLabels(kube.ObjectLabels(name))
 	pod.SetAnnotations(map[string]string{"owner": Owner})
+	kube.ApplyToPod(&pod)
+	return &pod, password, nil
+}
+
+func (provider KubernetesInstanceRedisProvider) createService(name string, Owner string, kube *KubernetesSettings) error {
+	service := v1core.Service{
+		Spec: v1core.ServiceSpec{
+			Type: kube.ServiceType,
+			Ports: []v1core.ServicePort{
+				v1core.ServicePort{
+					Port: 6379,
+					TargetPort: intstr.FromInt(6379),
+				},
+			},
+			Selector: map[string]string{
+				"app": name,
+			},
+		},
+	}
+	service.SetName(name)
+	service.SetNamespace(kube.Namespace)
+	service.SetLabels(kube.ObjectLabels(name))
+	service.SetAnnotations(map[string]string{"owner": Owner})
+
+	_, err := provider.kubernetes.CoreV1().Services(kube.Namespace).Create(&service)
+	return err
+}
+
+func (provider KubernetesInstanceRedisProvider) Provision(Id string, plan *ProviderPlan, Owner string) (*Instance, error) {
+	var settings redisProviderPlan
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	kube, err := GetKubernetesSettings(plan, "redis")
+	if err != nil {
+		return nil, err
+	}
+	name := provider.namePrefix + strings.ToLower(RandomString(9))
+	pod, password, err := provider.redisPodTemplate(name, Owner, &settings, kube)
+	if err != nil {
+		return nil, err
+	}
 
 	var replicas int32 = 1
 	selector := metav1.LabelSelector{
@@ -171,41 +317,21 @@ func (provider KubernetesInstanceRedisProvider) Provision(Id string, plan *Provi
 	deployment := v1apps.Deployment{
 		Spec: v1apps.DeploymentSpec{
 			Replicas: &replicas,
-			Template: pod,
+			Template: *pod,
 			Selector: &selector,
 		},
 	}
 	deployment.SetName(name)
-	deployment.SetNamespace(namespaceRedis)
-	deployment.SetLabels(map[string]string{"app": name})
+	deployment.SetNamespace(kube.Namespace)
+	deployment.SetLabels(kube.ObjectLabels(name))
 	deployment.SetAnnotations(map[string]string{"owner": Owner})
 
-	result, err := provider.kubernetes.AppsV1().Deployments(namespaceRedis).Create(&deployment)
+	result, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Create(&deployment)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create the service
-	service := v1core.Service{
-		Spec: v1core.ServiceSpec{
-			Type: v1core.ServiceTypeNodePort,
-			Ports: []v1core.ServicePort{
-				v1core.ServicePort{
-					Port: 6379,
-					TargetPort: intstr.FromInt(6379),
-				},
-			},
-			Selector: map[string]string{
-				"app": name,
-			},
-		},
-	}
-	service.SetName(name)
-	service.SetNamespace(namespaceRedis)
-	service.SetLabels(map[string]string{"app": name})
-	service.SetAnnotations(map[string]string{"owner": Owner})
-
-	if _, err = provider.kubernetes.CoreV1().Services(namespaceRedis).Create(&service); err != nil {
+	if err = provider.createService(name, Owner, kube); err != nil {
 		return nil, err
 	}
 
@@ -215,8 +341,8 @@ func (provider KubernetesInstanceRedisProvider) Provision(Id string, plan *Provi
 		ProviderId:    name,
 		Plan:          plan,
 		Username:      "",
-		Password:      "",
-		Endpoint:      name + "." + namespaceRedis + ".svc.cluster.local:6379",
+		Password:      password,
+		Endpoint:      kube.Endpoint(name, 6379),
 		Status:        "creating",
 		Ready:         IsReadyKubernetes(result),
 		Engine:        "redis",
@@ -226,48 +352,115 @@ func (provider KubernetesInstanceRedisProvider) Provision(Id string, plan *Provi
 }
 
 func (provider KubernetesInstanceRedisProvider) Deprovision(Instance *Instance, takeSnapshot bool) error {
-	err := provider.kubernetes.CoreV1().Services(namespaceRedis).Delete(Instance.Name, &metav1.DeleteOptions{})
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
 	if err != nil {
 		return err
 	}
-	err = provider.kubernetes.AppsV1().Deployments(namespaceRedis).Delete(Instance.Name, &metav1.DeleteOptions{})
-	return err
+	err = provider.kubernetes.CoreV1().Services(kube.Namespace).Delete(Instance.Name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Delete(Instance.Name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	err = provider.kubernetes.CoreV1().Secrets(kube.Namespace).Delete(Instance.Name+"-acl", &metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
 }
 
-func (provider KubernetesInstanceRedisProvider) Modify(Instance *Instance, plan *ProviderPlan) (*Instance, error) {
-	result, err := provider.kubernetes.AppsV1().Deployments(namespaceRedis).Get(Instance.ProviderId, metav1.GetOptions{})
+// Modify changes the instance in place with a rolling update of its deployment, the name,
+// password and ACL secret are kept so the users of its bindings keep working.
+func (provider KubernetesInstanceRedisProvider) Modify(instance *Instance, plan *ProviderPlan) (*Instance, error) {
+	defer provider.instanceCache.Invalidate(instance.Name)
+	var settings redisProviderPlan
+	if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &settings); err != nil {
+		return nil, err
+	}
+	kube, err := GetKubernetesSettings(instance.Plan, "redis")
 	if err != nil {
 		return nil, err
 	}
-
-	if err := provider.Deprovision(Instance, true); err != nil {
+	target, err := GetKubernetesSettings(plan, "redis")
+	if err != nil {
+		return nil, err
+	}
+	if target.Namespace != kube.Namespace {
+		return nil, errors.New("The namespace of a redis cannot be changed by changing plans.")
+	}
+	deployment, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(instance.ProviderId, metav1.GetOptions{})
+	if err != nil {
 		return nil, err
 	}
-	return provider.Provision(Instance.Id, plan, result.Annotations["owner"])
+	if len(deployment.Spec.Template.Spec.Containers) > 0 && len(deployment.Spec.Template.Spec.Containers[0].Args) > 0 && deployment.Spec.Template.Spec.Containers[0].Args[0] == "--aclfile" && redisMajorVersion(settings.Version) < 6 {
+		return nil, errors.New("The redis version cannot be changed to one without users by changing plans.")
+	}
+	pod, password, err := provider.redisPodTemplate(instance.Name, deployment.Annotations["owner"], &settings, target)
+	if err != nil {
+		return nil, err
+	}
+	if password == "" {
+		password = instance.Password
+	}
+	deployment.Spec.Template = *pod
+	if _, err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Update(deployment); err != nil {
+		return nil, err
+	}
+
+	return &Instance{
+		Id:            instance.Id,
+		Name:          instance.Name,
+		ProviderId:    instance.ProviderId,
+		Plan:          plan,
+		Username:      instance.Username,
+		Password:      password,
+		Endpoint:      target.Endpoint(instance.Name, 6379),
+		Status:        "modifying",
+		Ready:         false,
+		Engine:        "redis",
+		EngineVersion: settings.Version,
+		Scheme:        plan.Scheme,
+	}, nil
 }
 
 func (provider KubernetesInstanceRedisProvider) Tag(Instance *Instance, Name string, Value string) error {
-	result, err := provider.kubernetes.AppsV1().Deployments(namespaceRedis).Get(Instance.ProviderId, metav1.GetOptions{})
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	result, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
 	result.Annotations[Name] = Value
-	_, err = provider.kubernetes.AppsV1().Deployments(namespaceRedis).Update(result)
+	_, err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Update(result)
 	return err
 }
 
 func (provider KubernetesInstanceRedisProvider) Untag(Instance *Instance, Name string) error {
-	result, err := provider.kubernetes.AppsV1().Deployments(namespaceRedis).Get(Instance.ProviderId, metav1.GetOptions{})
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	result, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
 	delete(result.Annotations, Name)
-	_, err = provider.kubernetes.AppsV1().Deployments(namespaceRedis).Update(result)
+	_, err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Update(result)
 	return err
 }
 
 func (provider KubernetesInstanceRedisProvider) Restart(Instance *Instance) error {
-	return provider.kubernetes.CoreV1().Pods(namespaceRedis).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: "app=" + Instance.ProviderId})
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	return provider.kubernetes.CoreV1().Pods(kube.Namespace).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: "app=" + Instance.ProviderId})
 }
 
 func (provider KubernetesInstanceRedisProvider) Flush(Instance *Instance) error {
@@ -275,11 +468,7 @@ func (provider KubernetesInstanceRedisProvider) Flush(Instance *Instance) error
 }
 
 func (provider KubernetesInstanceRedisProvider) Stats(Instance *Instance) ([]Stat, error) {
-	client := redis.NewClient(&redis.Options{
-		Addr:     Instance.Endpoint,
-		Password: Instance.Password,
-		DB:       0,
-	})
+	client := redis.NewClient(redisOptions(Instance))
 	defer client.Close()
 	info, err := client.Info().Result()
 	if err != nil {
@@ -315,6 +504,139 @@ func (provider KubernetesInstanceRedisProvider) CreateBackup(*Instance) (*Backup
 	return nil, errors.New("Backups are unavailable on ephemeral redis")
 }
 
+func (provider KubernetesInstanceRedisProvider) CopyBackup(*Instance, string) error {
+	return errors.New("Backups are unavailable on ephemeral redis")
+}
+
 func (provider KubernetesInstanceRedisProvider) RestoreBackup(*Instance, string) error {
 	return errors.New("Backups are unavailable on ephemeral redis")
 }
+
+func (provider KubernetesInstanceRedisProvider) updateAclFile(Instance *Instance, username string, line string) error {
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	secret, err := provider.kubernetes.CoreV1().Secrets(kube.Namespace).Get(Instance.Name+"-acl", metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	var content string
+	if data, ok := secret.Data["users.acl"]; ok {
+		content = string(data)
+	} else {
+		content = secret.StringData["users.acl"]
+	}
+	lines := make([]string, 0)
+	for _, existing := range strings.Split(content, "\n") {
+		if strings.TrimSpace(existing) != "" && !strings.HasPrefix(existing, "user "+username+" ") {
+			lines = append(lines, existing)
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	secret.Data = nil
+	secret.StringData = map[string]string{"users.acl": strings.Join(lines, "\n") + "\n"}
+	_, err = provider.kubernetes.CoreV1().Secrets(kube.Namespace).Update(secret)
+	return err
+}
+
+func (provider KubernetesInstanceRedisProvider) CreateBindingUser(Instance *Instance, bindingId string) (string, string, error) {
+	if redisMajorVersion(Instance.EngineVersion) < 6 || Instance.Password == "" {
+		return "", "", errors.New("This feature is not available on this plan.")
+	}
+	username := "binding-" + strings.ToLower(bindingId)
+	password, err := RandomSecureString(32)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err = provider.execute(Instance, "ACL", "SETUSER", username, "reset", "on", ">"+password, "~*", "+@all", "-@admin"); err != nil {
+		return "", "", err
+	}
+	if err = provider.updateAclFile(Instance, username, aclUserLine(username, password, aclBindingRules)); err != nil {
+		return "", "", err
+	}
+	return username, password, nil
+}
+
+func (provider KubernetesInstanceRedisProvider) DeleteBindingUser(Instance *Instance, username string) error {
+	if redisMajorVersion(Instance.EngineVersion) < 6 || Instance.Password == "" {
+		return errors.New("This feature is not available on this plan.")
+	}
+	if _, err := provider.execute(Instance, "ACL", "DELUSER", username); err != nil {
+		return err
+	}
+	return provider.updateAclFile(Instance, username, "")
+}
+
+func (provider KubernetesInstanceRedisProvider) UpdateAuthToken(*Instance, string, string) error {
+	return errors.New("This feature is not available on this plan.")
+}
+
+// redisArgs keeps the ACL file argument of a redis container and replaces its configuration.
+func redisArgs(container *v1core.Container, settings *redisProviderPlan) []string {
+	args := make([]string, 0)
+	if len(container.Args) > 1 && container.Args[0] == "--aclfile" {
+		args = append(args, container.Args[0:2]...)
+	}
+	return append(args, redisConfigArgs(settings)...)
+}
+
+// UpdateConfig gives redis the settings of the instance's plan with a rolling update.
+func (provider KubernetesInstanceRedisProvider) UpdateConfig(Instance *Instance) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	var settings redisProviderPlan
+	if err := json.Unmarshal([]byte(Instance.Plan.providerPrivateDetails), &settings); err != nil {
+		return err
+	}
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	deployment, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	container := &deployment.Spec.Template.Spec.Containers[0]
+	container.Args = redisArgs(container, &settings)
+	_, err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Update(deployment)
+	return err
+}
+
+// redisEngineVersions keeps instances on the same side of redis 6, users of redis 6 and
+// above are kept in an ACL file that is only created when provisioning.
+func redisEngineVersions(current string, allowed []string) []string {
+	versions := make([]string, 0)
+	for _, version := range upgradableEngineVersions(current, allowed) {
+		if (redisMajorVersion(version) >= 6) == (redisMajorVersion(current) >= 6) {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// EngineVersions lists the allowed image tags the instance can be upgraded to.
+func (provider KubernetesInstanceRedisProvider) EngineVersions(Instance *Instance) ([]string, error) {
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return nil, err
+	}
+	return redisEngineVersions(Instance.EngineVersion, kube.AllowedVersions), nil
+}
+
+// UpgradeEngine changes the redis image with a rolling update, data is not kept.
+func (provider KubernetesInstanceRedisProvider) UpgradeEngine(Instance *Instance, version string) error {
+	defer provider.instanceCache.Invalidate(Instance.Name)
+	kube, err := GetKubernetesSettings(Instance.Plan, "redis")
+	if err != nil {
+		return err
+	}
+	deployment, err := provider.kubernetes.AppsV1().Deployments(kube.Namespace).Get(Instance.ProviderId, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	deployment.Spec.Template.Spec.Containers[0].Image = kube.Image("redis:" + version)
+	_, err = provider.kubernetes.AppsV1().Deployments(kube.Namespace).Update(deployment)
+	return err
+}
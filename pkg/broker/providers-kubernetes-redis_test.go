@@ -2,13 +2,25 @@ package broker
 
 import (
 	"context"
+	"errors"
 	osb "github.com/pmorie/go-open-service-broker-client/v2"
 	"github.com/pmorie/osb-broker-lib/pkg/broker"
 	. "github.com/smartystreets/goconvey/convey"
+	v1core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"os"
 	"testing"
 )
 
+// failingBindingStorage is a storage that cannot store bindings.
+type failingBindingStorage struct {
+	Storage
+}
+
+func (s *failingBindingStorage) AddBinding(binding *Binding) error {
+	return errors.New("cannot store bindings")
+}
+
 func TestKubernetesRedisProvision(t *testing.T) {
 	var namePrefix = "test"
 	var logic *BusinessLogic
@@ -22,12 +34,12 @@ func TestKubernetesRedisProvision(t *testing.T) {
 	os.Setenv("USE_KUBERNETES", "true")
 
 	Convey("Given a provisioner on kubernetes.", t, func() {
-		logic, err = NewBusinessLogic(context.TODO(), Options{DatabaseUrl: os.Getenv("DATABASE_URL"), NamePrefix: namePrefix})
+		logic, err = NewBusinessLogic(context.TODO(), Options{DatabaseUrl: testDatabaseUrl(), NamePrefix: namePrefix})
 		So(err, ShouldBeNil)
 		So(logic, ShouldNotBeNil)
 
 		Convey("Ensure the initialization for the database works", func() {
-			storage, err := InitStorage(context.TODO(), Options{DatabaseUrl: os.Getenv("DATABASE_URL"), NamePrefix: namePrefix})
+			storage, err := NewStorage(context.TODO(), Options{DatabaseUrl: testDatabaseUrl(), NamePrefix: namePrefix})
 			So(err, ShouldBeNil)
 			So(storage, ShouldNotBeNil)
 		})
@@ -106,6 +118,7 @@ func TestKubernetesRedisProvision(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(dres, ShouldNotBeNil)
 			So(dres.Credentials["REDIS_URL"].(string), ShouldEndWith, "redis-system.svc.cluster.local:6379")
+			So(dres.Credentials["REDIS_URL"].(string), ShouldStartWith, "redis://binding-foo:")
 
 			var gbrequest osb.GetBindingRequest = osb.GetBindingRequest{InstanceID: instanceId, BindingID: "foo"}
 			gbres, err := logic.GetBinding(&gbrequest, &c)
@@ -113,6 +126,48 @@ func TestKubernetesRedisProvision(t *testing.T) {
 			So(gbres, ShouldNotBeNil)
 			So(gbres.Credentials["REDIS_URL"].(string), ShouldEndWith, "redis-system.svc.cluster.local:6379")
 			So(gbres.Credentials["REDIS_URL"].(string), ShouldEqual, dres.Credentials["REDIS_URL"].(string))
+
+			rres, err := logic.Bind(&brequest, &c)
+			So(err, ShouldBeNil)
+			So(rres.Exists, ShouldEqual, true)
+			So(rres.Credentials["REDIS_URL"].(string), ShouldEqual, dres.Credentials["REDIS_URL"].(string))
+
+			var otherGuid = "123e4567-e89b-12d3-a456-426655440001"
+			var conflicting osb.BindRequest = osb.BindRequest{InstanceID: instanceId, BindingID: "foo", BindResource: &osb.BindResource{AppGUID: &otherGuid}}
+			_, err = logic.Bind(&conflicting, &c)
+			So(err, ShouldNotBeNil)
+			So(err.(osb.HTTPStatusCodeError).StatusCode, ShouldEqual, 409)
+
+			var second osb.BindRequest = osb.BindRequest{InstanceID: instanceId, BindingID: "bar", BindResource: &osb.BindResource{AppGUID: &otherGuid}}
+			sres, err := logic.Bind(&second, &c)
+			So(err, ShouldBeNil)
+			So(sres.Exists, ShouldEqual, false)
+			So(sres.Credentials["REDIS_URL"].(string), ShouldNotEqual, dres.Credentials["REDIS_URL"].(string))
+
+			_, err = logic.GetBinding(&osb.GetBindingRequest{InstanceID: instanceId, BindingID: "unknown"}, &c)
+			So(err, ShouldNotBeNil)
+			So(err.(osb.HTTPStatusCodeError).StatusCode, ShouldEqual, 404)
+		})
+
+		Convey("Ensure changing plans keeps the instance and the users of its bindings", func() {
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			_, err = UpgradeWithinProviders(logic.storage, instance, highPlan.ID, nil, namePrefix)
+			So(err, ShouldBeNil)
+
+			modified, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			So(modified.Name, ShouldEqual, instance.Name)
+			So(modified.Password, ShouldEqual, instance.Password)
+			So(modified.Plan.ID, ShouldEqual, highPlan.ID)
+			deployment, err := fakeClient.AppsV1().Deployments("redis-system").Get(instance.Name, metav1.GetOptions{})
+			So(err, ShouldBeNil)
+			memory := deployment.Spec.Template.Spec.Containers[0].Resources.Limits[v1core.ResourceMemory]
+			So(memory.String(), ShouldEqual, "1Gi")
+			secret, err := fakeClient.CoreV1().Secrets("redis-system").Get(instance.Name+"-acl", metav1.GetOptions{})
+			So(err, ShouldBeNil)
+			So(secret.StringData["users.acl"], ShouldContainSubstring, "user binding-foo ")
+			So(secret.StringData["users.acl"], ShouldContainSubstring, "user binding-bar ")
 		})
 
 		Convey("Ensure unbind for kubernetes redis works", func() {
@@ -121,6 +176,33 @@ func TestKubernetesRedisProvision(t *testing.T) {
 			ures, err := logic.Unbind(&urequest, &c)
 			So(err, ShouldBeNil)
 			So(ures, ShouldNotBeNil)
+
+			gbres, err := logic.GetBinding(&osb.GetBindingRequest{InstanceID: instanceId, BindingID: "bar"}, &c)
+			So(err, ShouldBeNil)
+			So(gbres.Credentials["REDIS_URL"].(string), ShouldStartWith, "redis://binding-bar:")
+
+			_, err = logic.Unbind(&urequest, &c)
+			So(err, ShouldNotBeNil)
+			So(err.(osb.HTTPStatusCodeError).StatusCode, ShouldEqual, 410)
+
+			ures, err = logic.Unbind(&osb.UnbindRequest{InstanceID: instanceId, BindingID: "bar"}, &c)
+			So(err, ShouldBeNil)
+			So(ures, ShouldNotBeNil)
+		})
+
+		Convey("Ensure a binding that cannot be stored does not leave its user behind", func() {
+			var c broker.RequestContext
+			var guid = "123e4567-e89b-12d3-a456-426655440000"
+			logic.storage = &failingBindingStorage{Storage: logic.storage}
+			_, err := logic.Bind(&osb.BindRequest{InstanceID: instanceId, BindingID: "baz", BindResource: &osb.BindResource{AppGUID: &guid}}, &c)
+			So(err, ShouldNotBeNil)
+			So(err.(osb.HTTPStatusCodeError).StatusCode, ShouldEqual, 500)
+
+			instance, err := logic.GetInstanceById(instanceId)
+			So(err, ShouldBeNil)
+			secret, err := fakeClient.CoreV1().Secrets("redis-system").Get(instance.Name+"-acl", metav1.GetOptions{})
+			So(err, ShouldBeNil)
+			So(secret.StringData["users.acl"], ShouldNotContainSubstring, "user binding-baz ")
 		})
 
 		Convey("Ensure deprovisioner for kubernetes redis works", func() {
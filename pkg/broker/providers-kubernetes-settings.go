@@ -0,0 +1,139 @@
+package broker
+
+import (
+	"encoding/json"
+	"errors"
+	v1core "k8s.io/api/core/v1"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// KubernetesSettings control where and how the kubernetes providers run instances. Each
+// setting has a broker wide default from the environment and can be overridden per plan
+// in provider_private_details.
+type KubernetesSettings struct {
+	Namespace     string              `json:"namespace,omitempty"`
+	ServiceType   v1core.ServiceType  `json:"service_type,omitempty"`
+	ClusterDomain string              `json:"cluster_domain,omitempty"`
+	Labels        map[string]string   `json:"labels,omitempty"`
+	NodeSelector  map[string]string   `json:"node_selector,omitempty"`
+	Tolerations   []v1core.Toleration `json:"tolerations,omitempty"`
+	ImageRegistry string              `json:"image_registry,omitempty"`
+	// Image tags instances may be upgraded to, e.g., ["5.0.9","5.0.14"]
+	AllowedVersions []string `json:"allowed_versions,omitempty"`
+}
+
+// parseKeyValues reads settings in the form key1=value1,key2=value2
+func parseKeyValues(str string) map[string]string {
+	values := make(map[string]string)
+	for _, pair := range strings.Split(str, ",") {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(kv) == 2 && kv[0] != "" {
+			values[kv[0]] = kv[1]
+		}
+	}
+	return values
+}
+
+// GetKubernetesSettings merges the settings in the plan over those in the environment, the
+// namespace defaults to KUBERNETES_<ENGINE>_NAMESPACE or <engine>-system (e.g., redis-system).
+func GetKubernetesSettings(plan *ProviderPlan, engine string) (*KubernetesSettings, error) {
+	settings := KubernetesSettings{
+		Namespace:     engine + "-system",
+		ServiceType:   v1core.ServiceTypeNodePort,
+		ClusterDomain: "cluster.local",
+		Labels:        parseKeyValues(os.Getenv("KUBERNETES_LABELS")),
+		NodeSelector:  parseKeyValues(os.Getenv("KUBERNETES_NODE_SELECTOR")),
+		ImageRegistry: os.Getenv("KUBERNETES_IMAGE_REGISTRY"),
+	}
+	if os.Getenv("KUBERNETES_"+strings.ToUpper(engine)+"_NAMESPACE") != "" {
+		settings.Namespace = os.Getenv("KUBERNETES_" + strings.ToUpper(engine) + "_NAMESPACE")
+	}
+	if os.Getenv("KUBERNETES_SERVICE_TYPE") != "" {
+		settings.ServiceType = v1core.ServiceType(os.Getenv("KUBERNETES_SERVICE_TYPE"))
+	}
+	if os.Getenv("KUBERNETES_CLUSTER_DOMAIN") != "" {
+		settings.ClusterDomain = os.Getenv("KUBERNETES_CLUSTER_DOMAIN")
+	}
+	if os.Getenv("KUBERNETES_"+strings.ToUpper(engine)+"_VERSIONS") != "" {
+		for _, version := range strings.Split(os.Getenv("KUBERNETES_"+strings.ToUpper(engine)+"_VERSIONS"), ",") {
+			if strings.TrimSpace(version) != "" {
+				settings.AllowedVersions = append(settings.AllowedVersions, strings.TrimSpace(version))
+			}
+		}
+	}
+	if os.Getenv("KUBERNETES_TOLERATIONS") != "" {
+		if err := json.Unmarshal([]byte(os.Getenv("KUBERNETES_TOLERATIONS")), &settings.Tolerations); err != nil {
+			return nil, errors.New("Unable to parse KUBERNETES_TOLERATIONS: " + err.Error())
+		}
+	}
+
+	if plan != nil && plan.providerPrivateDetails != "" {
+		var overrides KubernetesSettings
+		if err := json.Unmarshal([]byte(plan.providerPrivateDetails), &overrides); err != nil {
+			return nil, err
+		}
+		if overrides.Namespace != "" {
+			settings.Namespace = overrides.Namespace
+		}
+		if overrides.ServiceType != "" {
+			settings.ServiceType = overrides.ServiceType
+		}
+		if overrides.ClusterDomain != "" {
+			settings.ClusterDomain = overrides.ClusterDomain
+		}
+		for key, value := range overrides.Labels {
+			settings.Labels[key] = value
+		}
+		for key, value := range overrides.NodeSelector {
+			settings.NodeSelector[key] = value
+		}
+		if overrides.Tolerations != nil {
+			settings.Tolerations = overrides.Tolerations
+		}
+		if overrides.ImageRegistry != "" {
+			settings.ImageRegistry = overrides.ImageRegistry
+		}
+		if overrides.AllowedVersions != nil {
+			settings.AllowedVersions = overrides.AllowedVersions
+		}
+	}
+
+	if settings.ServiceType != v1core.ServiceTypeClusterIP && settings.ServiceType != v1core.ServiceTypeNodePort && settings.ServiceType != v1core.ServiceTypeLoadBalancer {
+		return nil, errors.New("The service type " + string(settings.ServiceType) + " is not supported, use ClusterIP, NodePort or LoadBalancer.")
+	}
+	return &settings, nil
+}
+
+// Image prefixes the image with the configured registry, if any.
+func (settings *KubernetesSettings) Image(image string) string {
+	if settings.ImageRegistry == "" {
+		return image
+	}
+	return strings.TrimSuffix(settings.ImageRegistry, "/") + "/" + image
+}
+
+func (settings *KubernetesSettings) Endpoint(name string, port int) string {
+	return name + "." + settings.Namespace + ".svc." + settings.ClusterDomain + ":" + strconv.Itoa(port)
+}
+
+// ObjectLabels returns the configured labels along with the app label used by selectors.
+func (settings *KubernetesSettings) ObjectLabels(name string) map[string]string {
+	labels := map[string]string{}
+	for key, value := range settings.Labels {
+		labels[key] = value
+	}
+	labels["app"] = name
+	return labels
+}
+
+// ApplyToPod schedules the pod according to the node selectors and tolerations.
+func (settings *KubernetesSettings) ApplyToPod(pod *v1core.PodTemplateSpec) {
+	if len(settings.NodeSelector) > 0 {
+		pod.Spec.NodeSelector = settings.NodeSelector
+	}
+	if len(settings.Tolerations) > 0 {
+		pod.Spec.Tolerations = settings.Tolerations
+	}
+}
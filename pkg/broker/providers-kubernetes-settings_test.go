@@ -0,0 +1,59 @@
+package broker
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	v1core "k8s.io/api/core/v1"
+	"os"
+	"testing"
+)
+
+func TestKubernetesSettings(t *testing.T) {
+	Convey("Given kubernetes settings in the environment.", t, func() {
+		os.Setenv("KUBERNETES_REDIS_NAMESPACE", "cache")
+		os.Setenv("KUBERNETES_LABELS", "team=data, tier=cache")
+		os.Setenv("KUBERNETES_NODE_SELECTOR", "pool=cache")
+		os.Setenv("KUBERNETES_IMAGE_REGISTRY", "registry.example.com/")
+		os.Setenv("KUBERNETES_TOLERATIONS", `[{"key":"dedicated","operator":"Equal","value":"cache","effect":"NoSchedule"}]`)
+		defer os.Unsetenv("KUBERNETES_REDIS_NAMESPACE")
+		defer os.Unsetenv("KUBERNETES_LABELS")
+		defer os.Unsetenv("KUBERNETES_NODE_SELECTOR")
+		defer os.Unsetenv("KUBERNETES_IMAGE_REGISTRY")
+		defer os.Unsetenv("KUBERNETES_TOLERATIONS")
+
+		Convey("Ensure the defaults come from the environment", func() {
+			kube, err := GetKubernetesSettings(nil, "redis")
+			So(err, ShouldBeNil)
+			So(kube.Namespace, ShouldEqual, "cache")
+			So(kube.ServiceType, ShouldEqual, v1core.ServiceTypeNodePort)
+			So(kube.Endpoint("foo", 6379), ShouldEqual, "foo.cache.svc.cluster.local:6379")
+			So(kube.Image("redis:6.0.7"), ShouldEqual, "registry.example.com/redis:6.0.7")
+			So(kube.ObjectLabels("foo"), ShouldResemble, map[string]string{"team": "data", "tier": "cache", "app": "foo"})
+			So(len(kube.Tolerations), ShouldEqual, 1)
+
+			memcached, err := GetKubernetesSettings(nil, "memcached")
+			So(err, ShouldBeNil)
+			So(memcached.Namespace, ShouldEqual, "memcached-system")
+		})
+
+		Convey("Ensure the plan overrides the environment", func() {
+			kube, err := GetKubernetesSettings(&ProviderPlan{providerPrivateDetails: `{"namespace":"other","service_type":"ClusterIP","cluster_domain":"example.local","labels":{"tier":"premium"},"tolerations":[]}`}, "redis")
+			So(err, ShouldBeNil)
+			So(kube.Namespace, ShouldEqual, "other")
+			So(kube.ServiceType, ShouldEqual, v1core.ServiceTypeClusterIP)
+			So(kube.Endpoint("foo", 6379), ShouldEqual, "foo.other.svc.example.local:6379")
+			So(kube.Labels["team"], ShouldEqual, "data")
+			So(kube.Labels["tier"], ShouldEqual, "premium")
+			So(len(kube.Tolerations), ShouldEqual, 0)
+
+			var pod v1core.PodTemplateSpec
+			kube.ApplyToPod(&pod)
+			So(pod.Spec.NodeSelector["pool"], ShouldEqual, "cache")
+			So(pod.Spec.Tolerations, ShouldBeNil)
+		})
+
+		Convey("Ensure unsupported service types are rejected", func() {
+			_, err := GetKubernetesSettings(&ProviderPlan{providerPrivateDetails: `{"service_type":"ExternalName"}`}, "redis")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,192 @@
+package broker
+
+import (
+	"fmt"
+	v1apps "k8s.io/api/apps/v1"
+	v1core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sort"
+	"time"
+)
+
+// Statuses reported by the kubernetes providers in addition to creating, modifying,
+// available and deleting. Each comes with a description of what went wrong.
+const (
+	KubernetesStatusImagePullFailed = "image-pull-failed"
+	KubernetesStatusCrashLooping    = "crash-looping"
+	KubernetesStatusOutOfMemory     = "out-of-memory"
+	KubernetesStatusUnschedulable   = "unschedulable"
+	KubernetesStatusFailed          = "failed"
+)
+
+// Warning events older than this are not considered when describing an instance.
+var kubernetesEventWindow = time.Minute * 15
+
+type KubernetesStatus struct {
+	Status      string
+	Ready       bool
+	Description string
+}
+
+// kubernetesWorkload is the part of a deployment or stateful set the status is worked out from.
+type kubernetesWorkload struct {
+	Name               string
+	Namespace          string
+	Generation         int64
+	ObservedGeneration int64
+	Replicas           int32
+	ReadyReplicas      int32
+	UpdatedReplicas    int32
+	Deleting           bool
+	Failure            string
+}
+
+func deploymentWorkload(dep *v1apps.Deployment) kubernetesWorkload {
+	workload := kubernetesWorkload{
+		Name:               dep.Name,
+		Namespace:          dep.Namespace,
+		Generation:         dep.Generation,
+		ObservedGeneration: dep.Status.ObservedGeneration,
+		Replicas:           dep.Status.Replicas,
+		ReadyReplicas:      dep.Status.ReadyReplicas,
+		UpdatedReplicas:    dep.Status.UpdatedReplicas,
+		Deleting:           dep.DeletionTimestamp != nil,
+	}
+	for _, condition := range dep.Status.Conditions {
+		if condition.Type == v1apps.DeploymentProgressing && condition.Status == v1core.ConditionFalse && condition.Reason == "ProgressDeadlineExceeded" {
+			workload.Failure = condition.Message
+		} else if condition.Type == v1apps.DeploymentReplicaFailure && condition.Status == v1core.ConditionTrue {
+			workload.Failure = condition.Message
+		}
+	}
+	return workload
+}
+
+func statefulSetWorkload(set *v1apps.StatefulSet) kubernetesWorkload {
+	workload := kubernetesWorkload{
+		Name:               set.Name,
+		Namespace:          set.Namespace,
+		Generation:         set.Generation,
+		ObservedGeneration: set.Status.ObservedGeneration,
+		Replicas:           set.Status.Replicas,
+		ReadyReplicas:      set.Status.ReadyReplicas,
+		UpdatedReplicas:    set.Status.UpdatedReplicas,
+		Deleting:           set.DeletionTimestamp != nil,
+	}
+	// Stateful sets do not report a progress deadline, but may carry conditions set by other controllers.
+	for _, condition := range set.Status.Conditions {
+		if condition.Status == v1core.ConditionTrue && condition.Type == "Failure" {
+			workload.Failure = condition.Message
+		}
+	}
+	return workload
+}
+
+// containerStatus maps a single container's state to a failure status, if it has one.
+func containerStatus(container v1core.ContainerStatus) (string, string) {
+	if waiting := container.State.Waiting; waiting != nil {
+		switch waiting.Reason {
+		case "ImagePullBackOff", "ErrImagePull", "InvalidImageName", "ErrImageNeverPull":
+			return KubernetesStatusImagePullFailed, container.Name + ": " + waiting.Message
+		case "CrashLoopBackOff":
+			if last := container.LastTerminationState.Terminated; last != nil {
+				if last.Reason == "OOMKilled" {
+					return KubernetesStatusOutOfMemory, container.Name + " ran out of memory and is restarting."
+				}
+				return KubernetesStatusCrashLooping, fmt.Sprintf("%s exited with %d (%s) and is restarting. %s", container.Name, last.ExitCode, last.Reason, last.Message)
+			}
+			return KubernetesStatusCrashLooping, container.Name + ": " + waiting.Message
+		case "CreateContainerConfigError", "CreateContainerError", "RunContainerError":
+			return KubernetesStatusFailed, container.Name + ": " + waiting.Message
+		}
+	}
+	if terminated := container.State.Terminated; terminated != nil && terminated.Reason == "OOMKilled" {
+		return KubernetesStatusOutOfMemory, container.Name + " ran out of memory."
+	}
+	return "", ""
+}
+
+// podStatus maps the phase, conditions and containers of a pod to a failure status, if it has one.
+func podStatus(pod *v1core.Pod) (string, string) {
+	if pod.Status.Phase == v1core.PodFailed {
+		return KubernetesStatusFailed, pod.Name + " failed: " + pod.Status.Reason + " " + pod.Status.Message
+	}
+	for _, container := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if status, description := containerStatus(container); status != "" {
+			return status, description
+		}
+	}
+	if pod.Status.Phase == v1core.PodPending {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == v1core.PodScheduled && condition.Status == v1core.ConditionFalse && condition.Reason == v1core.PodReasonUnschedulable {
+				return KubernetesStatusUnschedulable, condition.Message
+			}
+		}
+	}
+	return "", ""
+}
+
+// recentWarning returns the message of the latest warning event about any of the objects.
+func recentWarning(client kubernetes.Interface, namespace string, names map[string]bool) (string, string, error) {
+	events, err := client.CoreV1().Events(namespace).List(metav1.ListOptions{FieldSelector: "type=" + v1core.EventTypeWarning})
+	if err != nil {
+		return "", "", err
+	}
+	warnings := make([]v1core.Event, 0)
+	for _, event := range events.Items {
+		if event.Type == v1core.EventTypeWarning && names[event.InvolvedObject.Name] && time.Since(event.LastTimestamp.Time) < kubernetesEventWindow {
+			warnings = append(warnings, event)
+		}
+	}
+	if len(warnings) == 0 {
+		return "", "", nil
+	}
+	sort.Slice(warnings, func(i, j int) bool {
+		return warnings[i].LastTimestamp.Time.After(warnings[j].LastTimestamp.Time)
+	})
+	return warnings[0].Reason, warnings[0].InvolvedObject.Name + ": " + warnings[0].Message, nil
+}
+
+// GetKubernetesStatus works out the status of a deployment or stateful set from its
+// rollout, the state of its pods (selected by the app label) and recent warning events.
+func GetKubernetesStatus(client kubernetes.Interface, workload kubernetesWorkload) (*KubernetesStatus, error) {
+	if workload.Deleting {
+		return &KubernetesStatus{Status: "deleting"}, nil
+	}
+
+	pods, err := client.CoreV1().Pods(workload.Namespace).List(metav1.ListOptions{LabelSelector: "app=" + workload.Name})
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]bool{workload.Name: true}
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		names[pod.Name] = true
+		if status, description := podStatus(&pod); status != "" {
+			return &KubernetesStatus{Status: status, Description: description}, nil
+		}
+	}
+	if workload.Failure != "" {
+		return &KubernetesStatus{Status: KubernetesStatusFailed, Description: workload.Failure}, nil
+	}
+
+	rolledOut := workload.ObservedGeneration >= workload.Generation && workload.UpdatedReplicas == workload.Replicas
+	if rolledOut && workload.ReadyReplicas == workload.Replicas {
+		return &KubernetesStatus{Status: "available", Ready: true}, nil
+	}
+
+	reason, warning, err := recentWarning(client, workload.Namespace, names)
+	if err != nil {
+		return nil, err
+	}
+	if reason == "FailedScheduling" {
+		return &KubernetesStatus{Status: KubernetesStatusUnschedulable, Description: warning}, nil
+	}
+	if workload.ReadyReplicas > 0 {
+		return &KubernetesStatus{Status: "modifying", Description: warning}, nil
+	}
+	return &KubernetesStatus{Status: "creating", Description: warning}, nil
+}
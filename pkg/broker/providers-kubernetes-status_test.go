@@ -0,0 +1,149 @@
+package broker
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	v1apps "k8s.io/api/apps/v1"
+	v1core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"testing"
+	"time"
+)
+
+func statusTestPod(name string, app string, status v1core.PodStatus) *v1core.Pod {
+	pod := v1core.Pod{Status: status}
+	pod.SetName(name)
+	pod.SetNamespace("redis-system")
+	pod.SetLabels(map[string]string{"app": app})
+	return &pod
+}
+
+func TestKubernetesStatus(t *testing.T) {
+	Convey("Given deployments in various states.", t, func() {
+		client := fake.NewSimpleClientset()
+		deployment := v1apps.Deployment{
+			Status: v1apps.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1},
+		}
+		deployment.SetName("foo")
+		deployment.SetNamespace("redis-system")
+		deployment.Generation = 1
+
+		Convey("Ensure a ready deployment is available", func() {
+			deployment.Status.ReadyReplicas = 1
+			status, err := GetKubernetesStatus(client, deploymentWorkload(&deployment))
+			So(err, ShouldBeNil)
+			So(status.Status, ShouldEqual, "available")
+			So(status.Ready, ShouldBeTrue)
+		})
+
+		Convey("Ensure an unobserved change is reported as in progress", func() {
+			deployment.Generation = 2
+			deployment.Status.ReadyReplicas = 1
+			status, err := GetKubernetesStatus(client, deploymentWorkload(&deployment))
+			So(err, ShouldBeNil)
+			So(status.Status, ShouldEqual, "modifying")
+			So(status.Ready, ShouldBeFalse)
+		})
+
+		Convey("Ensure image pull failures are reported", func() {
+			_, err := client.CoreV1().Pods("redis-system").Create(statusTestPod("foo-1", "foo", v1core.PodStatus{
+				Phase: v1core.PodPending,
+				ContainerStatuses: []v1core.ContainerStatus{
+					v1core.ContainerStatus{
+						Name:  "redis",
+						State: v1core.ContainerState{Waiting: &v1core.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}},
+					},
+				},
+			}))
+			So(err, ShouldBeNil)
+			status, err := GetKubernetesStatus(client, deploymentWorkload(&deployment))
+			So(err, ShouldBeNil)
+			So(status.Status, ShouldEqual, KubernetesStatusImagePullFailed)
+			So(status.Description, ShouldEqual, "redis: Back-off pulling image")
+			So(InProgress(status.Status), ShouldBeFalse)
+			So(CanBeDeleted(status.Status), ShouldBeTrue)
+		})
+
+		Convey("Ensure containers killed for memory are reported", func() {
+			_, err := client.CoreV1().Pods("redis-system").Create(statusTestPod("foo-1", "foo", v1core.PodStatus{
+				Phase: v1core.PodRunning,
+				ContainerStatuses: []v1core.ContainerStatus{
+					v1core.ContainerStatus{
+						Name:                 "redis",
+						State:                v1core.ContainerState{Waiting: &v1core.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: v1core.ContainerState{Terminated: &v1core.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+					},
+				},
+			}))
+			So(err, ShouldBeNil)
+			status, err := GetKubernetesStatus(client, deploymentWorkload(&deployment))
+			So(err, ShouldBeNil)
+			So(status.Status, ShouldEqual, KubernetesStatusOutOfMemory)
+		})
+
+		Convey("Ensure crash loops are reported", func() {
+			_, err := client.CoreV1().Pods("redis-system").Create(statusTestPod("foo-1", "foo", v1core.PodStatus{
+				Phase: v1core.PodRunning,
+				ContainerStatuses: []v1core.ContainerStatus{
+					v1core.ContainerStatus{
+						Name:                 "redis",
+						State:                v1core.ContainerState{Waiting: &v1core.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: v1core.ContainerState{Terminated: &v1core.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+					},
+				},
+			}))
+			So(err, ShouldBeNil)
+			status, err := GetKubernetesStatus(client, deploymentWorkload(&deployment))
+			So(err, ShouldBeNil)
+			So(status.Status, ShouldEqual, KubernetesStatusCrashLooping)
+		})
+
+		Convey("Ensure pods of other instances are ignored", func() {
+			deployment.Status.ReadyReplicas = 1
+			_, err := client.CoreV1().Pods("redis-system").Create(statusTestPod("bar-1", "bar", v1core.PodStatus{Phase: v1core.PodFailed}))
+			So(err, ShouldBeNil)
+			status, err := GetKubernetesStatus(client, deploymentWorkload(&deployment))
+			So(err, ShouldBeNil)
+			So(status.Status, ShouldEqual, "available")
+		})
+
+		Convey("Ensure scheduling failures are taken from events", func() {
+			_, err := client.CoreV1().Pods("redis-system").Create(statusTestPod("foo-1", "foo", v1core.PodStatus{Phase: v1core.PodPending}))
+			So(err, ShouldBeNil)
+			event := v1core.Event{
+				Type:           v1core.EventTypeWarning,
+				Reason:         "FailedScheduling",
+				Message:        "0/3 nodes are available: 3 Insufficient memory.",
+				InvolvedObject: v1core.ObjectReference{Kind: "Pod", Name: "foo-1", Namespace: "redis-system"},
+				LastTimestamp:  metav1.NewTime(time.Now()),
+			}
+			event.SetName("foo-1.1")
+			event.SetNamespace("redis-system")
+			_, err = client.CoreV1().Events("redis-system").Create(&event)
+			So(err, ShouldBeNil)
+			status, err := GetKubernetesStatus(client, deploymentWorkload(&deployment))
+			So(err, ShouldBeNil)
+			So(status.Status, ShouldEqual, KubernetesStatusUnschedulable)
+			So(status.Description, ShouldEqual, "foo-1: 0/3 nodes are available: 3 Insufficient memory.")
+			So(InProgress(status.Status), ShouldBeTrue)
+		})
+
+		Convey("Ensure a deployment past its progress deadline has failed", func() {
+			deployment.Status.Conditions = []v1apps.DeploymentCondition{
+				v1apps.DeploymentCondition{Type: v1apps.DeploymentProgressing, Status: v1core.ConditionFalse, Reason: "ProgressDeadlineExceeded", Message: "ReplicaSet foo has timed out progressing."},
+			}
+			status, err := GetKubernetesStatus(client, deploymentWorkload(&deployment))
+			So(err, ShouldBeNil)
+			So(status.Status, ShouldEqual, KubernetesStatusFailed)
+			So(status.Ready, ShouldBeFalse)
+		})
+
+		Convey("Ensure deleted deployments are deleting", func() {
+			now := metav1.Now()
+			deployment.DeletionTimestamp = &now
+			status, err := GetKubernetesStatus(client, deploymentWorkload(&deployment))
+			So(err, ShouldBeNil)
+			So(status.Status, ShouldEqual, "deleting")
+		})
+	})
+}
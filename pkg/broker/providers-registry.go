@@ -0,0 +1,94 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ProviderFactory builds a provider for a name prefix, anything the provider runs in the
+// background must stop once ctx is done.
+type ProviderFactory func(ctx context.Context, namePrefix string) (Provider, error)
+
+var providerFactories = make(map[Providers]ProviderFactory)
+
+// RegisterProvider sets the factory used to build the provider for plans that use it,
+// providers register themselves when the package is initialized.
+func RegisterProvider(provider Providers, factory ProviderFactory) {
+	providerFactories[provider] = factory
+}
+
+type providerKey struct {
+	provider   Providers
+	namePrefix string
+}
+
+// ProviderRegistry builds each provider once for a name prefix and shares it between
+// requests and tasks, so providers keep what they have cached. The providers are built
+// with the registry's context and are shut down when it is done.
+type ProviderRegistry struct {
+	ctx       context.Context
+	lock      sync.Mutex
+	providers map[providerKey]Provider
+}
+
+func NewProviderRegistry(ctx context.Context) *ProviderRegistry {
+	registry := &ProviderRegistry{
+		ctx:       ctx,
+		providers: make(map[providerKey]Provider),
+	}
+	go (func() {
+		<-ctx.Done()
+		registry.lock.Lock()
+		registry.providers = make(map[providerKey]Provider)
+		registry.lock.Unlock()
+	})()
+	return registry
+}
+
+// Get returns the shared provider, building it the first time it is asked for.
+func (registry *ProviderRegistry) Get(namePrefix string, provider Providers) (Provider, error) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	if registry.ctx.Err() != nil {
+		return nil, errors.New("The providers have been shut down.")
+	}
+	key := providerKey{provider: provider, namePrefix: namePrefix}
+	if shared, ok := registry.providers[key]; ok {
+		return shared, nil
+	}
+	factory, ok := providerFactories[provider]
+	if !ok {
+		return nil, errors.New("Unable to find provider for plan.")
+	}
+	built, err := factory(registry.ctx, namePrefix)
+	if err != nil {
+		return nil, err
+	}
+	registry.providers[key] = instrumentProvider(provider, built)
+	return registry.providers[key], nil
+}
+
+var sharedProvidersLock sync.Mutex
+var sharedProviders *ProviderRegistry = nil
+
+// StartProviders shares providers through GetProviderByPlan until ctx is done, providers
+// that are already being shared are kept.
+func StartProviders(ctx context.Context) {
+	sharedProvidersLock.Lock()
+	defer sharedProvidersLock.Unlock()
+	if sharedProviders == nil || sharedProviders.ctx.Err() != nil {
+		sharedProviders = NewProviderRegistry(ctx)
+	}
+}
+
+// currentProviders returns the shared providers, if none were started they are shared for
+// as long as the process runs.
+func currentProviders() *ProviderRegistry {
+	sharedProvidersLock.Lock()
+	defer sharedProvidersLock.Unlock()
+	if sharedProviders == nil {
+		sharedProviders = NewProviderRegistry(context.Background())
+	}
+	return sharedProviders
+}
@@ -0,0 +1,85 @@
+package broker
+
+import (
+	"context"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+var stopTestProviders context.CancelFunc = func() {}
+
+// restartProviders shuts down the shared providers and replaces them, so nothing they
+// cached is used by the next call.
+func restartProviders() {
+	stopTestProviders()
+	var ctx context.Context
+	ctx, stopTestProviders = context.WithCancel(context.Background())
+	sharedProvidersLock.Lock()
+	sharedProviders = NewProviderRegistry(ctx)
+	sharedProvidersLock.Unlock()
+}
+
+type countingProvider struct {
+	Provider
+	ctx        context.Context
+	namePrefix string
+}
+
+func TestProviderRegistry(t *testing.T) {
+	var testProvider Providers = "test-provider"
+	var built int
+	RegisterProvider(testProvider, func(ctx context.Context, namePrefix string) (Provider, error) {
+		built++
+		return &countingProvider{ctx: ctx, namePrefix: namePrefix}, nil
+	})
+
+	Convey("Given a provider registry.", t, func() {
+		built = 0
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		registry := NewProviderRegistry(ctx)
+
+		Convey("Ensure each provider is built once for a name prefix", func() {
+			first, err := registry.Get("test", testProvider)
+			So(err, ShouldBeNil)
+			second, err := registry.Get("test", testProvider)
+			So(err, ShouldBeNil)
+			So(second, ShouldEqual, first)
+			So(built, ShouldEqual, 1)
+
+			other, err := registry.Get("other", testProvider)
+			So(err, ShouldBeNil)
+			So(other, ShouldNotEqual, first)
+			So(other.(*instrumentedProvider).provider.(*countingProvider).namePrefix, ShouldEqual, "other")
+			So(built, ShouldEqual, 2)
+		})
+
+		Convey("Ensure unknown providers are not found", func() {
+			_, err := registry.Get("test", Unknown)
+			So(err.Error(), ShouldEqual, "Unable to find provider for plan.")
+		})
+
+		Convey("Ensure providers are shut down with the registry", func() {
+			provider, err := registry.Get("test", testProvider)
+			So(err, ShouldBeNil)
+			cancel()
+			<-provider.(*instrumentedProvider).provider.(*countingProvider).ctx.Done()
+			_, err = registry.Get("test", testProvider)
+			So(err.Error(), ShouldEqual, "The providers have been shut down.")
+		})
+
+		Convey("Ensure plans get the shared provider", func() {
+			restartProviders()
+			first, err := GetProviderByPlan("test", &ProviderPlan{Provider: testProvider})
+			So(err, ShouldBeNil)
+			second, err := GetProviderByPlan("test", &ProviderPlan{Provider: testProvider})
+			So(err, ShouldBeNil)
+			So(second, ShouldEqual, first)
+
+			StartProviders(context.TODO())
+			third, err := GetProviderByPlan("test", &ProviderPlan{Provider: testProvider})
+			So(err, ShouldBeNil)
+			So(third, ShouldEqual, first)
+		})
+	})
+}
@@ -1,30 +1,37 @@
 package broker
 
 import (
-	"errors"
 	osb "github.com/pmorie/go-open-service-broker-client/v2"
-	"os"
 )
 
 type Providers string
 
 const (
 	AWSRedisInstance            Providers = "aws-redis-instance"
+	AWSRedisReplicationGroup    Providers = "aws-redis-replication-group"
+	AWSRedisCluster             Providers = "aws-redis-cluster"
 	AWSMemcachedInstance        Providers = "aws-memcached-instance"
 	KubernetesMemcachedInstance Providers = "kubernetes-memcached-instance"
 	KubernetesRedisInstance 	Providers = "kubernetes-redis-instance"
+	KubernetesRedisPersistent   Providers = "kubernetes-redis-persistent"
 	Unknown                     Providers = "unknown"
 )
 
 func GetProvidersFromString(str string) Providers {
 	if str == "aws-redis-instance" {
 		return AWSRedisInstance
+	} else if str == "aws-redis-replication-group" {
+		return AWSRedisReplicationGroup
+	} else if str == "aws-redis-cluster" {
+		return AWSRedisCluster
 	} else if str == "aws-memcached-instance" {
 		return AWSMemcachedInstance
 	} else if str == "kubernetes-memcached-instance" {
 		return KubernetesMemcachedInstance
 	} else if str == "kubernetes-redis-instance" {
 		return KubernetesRedisInstance
+	} else if str == "kubernetes-redis-persistent" {
+		return KubernetesRedisPersistent
 	}
 	return Unknown
 }
@@ -35,6 +42,7 @@ type ProviderPlan struct {
 	providerPrivateDetails string    `json:"-"` /* NEVER allow this to be serialized into a JSON call as it may accidently send sensitive info to callbacks */
 	ID                     string    `json:"id"`
 	Scheme                 string    `json:"scheme"`
+	parametersSchema       string    `json:"-"`
 }
 
 type Provider interface {
@@ -52,27 +60,17 @@ type Provider interface {
 	GetBackup(*Instance, string) (*BackupSpec, error)
 	ListBackups(*Instance) ([]BackupSpec, error)
 	CreateBackup(*Instance) (*BackupSpec, error)
+	CopyBackup(*Instance, string) error
 	RestoreBackup(*Instance, string) error
+	CreateBindingUser(*Instance, string) (string, string, error)
+	DeleteBindingUser(*Instance, string) error
+	UpdateAuthToken(*Instance, string, string) error
+	UpdateConfig(*Instance) error
+	EngineVersions(*Instance) ([]string, error)
+	UpgradeEngine(*Instance, string) error
 }
 
+// GetProviderByPlan returns the shared provider for the plan.
 func GetProviderByPlan(namePrefix string, plan *ProviderPlan) (Provider, error) {
-	if plan.Provider == AWSRedisInstance {
-		return NewAWSInstanceRedisProvider(namePrefix)
-	} else if plan.Provider == AWSMemcachedInstance {
-		return NewAWSInstanceMemcachedProvider(namePrefix)
-	} else if plan.Provider == KubernetesMemcachedInstance && os.Getenv("USE_KUBERNETES") == "true" {
-		if os.Getenv("TEST") == "true" {
-			return NewKubernetesInstanceMemcachedProvider(namePrefix)
-		} else {
-			return NewKubernetesInstanceMemcachedProvider(namePrefix)
-		}
-	} else if plan.Provider == KubernetesRedisInstance && os.Getenv("USE_KUBERNETES") == "true" {
-		if os.Getenv("TEST") == "true" {
-			return NewKubernetesInstanceRedisProvider(namePrefix)
-		} else {
-			return NewKubernetesInstanceRedisProvider(namePrefix)
-		}
-	} else {
-		return nil, errors.New("Unable to find provider for plan.")
-	}
+	return currentProviders().Get(namePrefix, plan.Provider)
 }
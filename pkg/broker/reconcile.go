@@ -0,0 +1,570 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/golang/glog"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The reconciler compares what the providers are running under the name prefix with the
+// resources table. Orphans are running at a provider with no instance, ghosts are instances
+// the provider is no longer running, and drifted instances have a different status at the
+// provider than the one recorded.
+
+const (
+	ResourceCacheCluster     = "cache-cluster"
+	ResourceReplicationGroup = "replication-group"
+	ResourceParameterGroup   = "parameter-group"
+	ResourceDeployment       = "deployment"
+	ResourceStatefulSet      = "statefulset"
+)
+
+// Resources and instances younger than this are left alone, they may be part way through
+// being provisioned (the provider creates them before they are recorded).
+var reconcileGracePeriod = time.Hour
+
+type ProviderResource struct {
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	Namespace string    `json:"namespace,omitempty"`
+	Status    string    `json:"status"`
+	Created   time.Time `json:"created"`
+	// The instance a parameter group belongs to, other resources are the instance.
+	Owner  string `json:"owner,omitempty"`
+	source reconcileSource
+}
+
+type ReconcileDrift struct {
+	Id             string `json:"id"`
+	Name           string `json:"name"`
+	Status         string `json:"status"`
+	ProviderStatus string `json:"provider_status"`
+}
+
+type ReconcileReport struct {
+	Orphans []ProviderResource `json:"orphans"`
+	Ghosts  []Entry            `json:"ghosts"`
+	Drifted []ReconcileDrift   `json:"drifted"`
+}
+
+// ReconcilePolicy is what the reconciler does beyond reporting what it finds.
+type ReconcilePolicy struct {
+	Enabled           bool
+	DeleteOrphans     bool
+	MarkGhostsDeleted bool
+}
+
+// ParseReconcilePolicy reads a policy of off, report (the default) or a comma separated list
+// of delete-orphans and mark-ghosts-deleted.
+func ParseReconcilePolicy(str string) (*ReconcilePolicy, error) {
+	policy := ReconcilePolicy{Enabled: true}
+	for _, part := range strings.Split(str, ",") {
+		switch strings.TrimSpace(part) {
+		case "", "report":
+		case "off":
+			policy.Enabled = false
+		case "delete-orphans":
+			policy.DeleteOrphans = true
+		case "mark-ghosts-deleted":
+			policy.MarkGhostsDeleted = true
+		default:
+			return nil, errors.New("The reconcile policy " + strings.TrimSpace(part) + " is not valid, use off, report, delete-orphans or mark-ghosts-deleted.")
+		}
+	}
+	if !policy.Enabled && (policy.DeleteOrphans || policy.MarkGhostsDeleted) {
+		return nil, errors.New("The reconcile policy cannot be off and delete orphans or mark ghosts deleted.")
+	}
+	return &policy, nil
+}
+
+// ReconcileSettings returns the reconcile policy and how often to reconcile from the options
+// or environment.
+func ReconcileSettings(o Options) (*ReconcilePolicy, time.Duration, error) {
+	if o.ReconcilePolicy == "" {
+		o.ReconcilePolicy = os.Getenv("RECONCILE_POLICY")
+	}
+	if o.ReconcileInterval == 0 && os.Getenv("RECONCILE_INTERVAL") != "" {
+		o.ReconcileInterval, _ = strconv.Atoi(os.Getenv("RECONCILE_INTERVAL"))
+	}
+	if o.ReconcileInterval < 1 {
+		o.ReconcileInterval = 3600
+	}
+	policy, err := ParseReconcilePolicy(o.ReconcilePolicy)
+	if err != nil {
+		return nil, 0, err
+	}
+	return policy, time.Second * time.Duration(o.ReconcileInterval), nil
+}
+
+// reconcileSource lists and removes the resources of one kind of provider.
+type reconcileSource interface {
+	Handles(Providers) bool
+	List(namePrefix string) ([]ProviderResource, error)
+	Delete(ProviderResource) error
+}
+
+type sourceListing struct {
+	source    reconcileSource
+	resources []ProviderResource
+}
+
+// listsInstances is whether the source found anything an instance runs on, parameter
+// groups are only kept alongside one.
+func (listing sourceListing) listsInstances() bool {
+	for _, resource := range listing.resources {
+		if resource.Kind != ResourceParameterGroup {
+			return true
+		}
+	}
+	return false
+}
+
+func sourceListingFor(listings []sourceListing, provider Providers) (*sourceListing, bool) {
+	for i, listing := range listings {
+		if listing.source.Handles(provider) {
+			return &listings[i], true
+		}
+	}
+	return nil, false
+}
+
+// compareWithProviders finds orphans, ghosts and drift. Only instances on a provider whose
+// resources could be listed can be ghosts, and instances with tasks running are skipped as
+// their status is expected to change. A provider that lists nothing while instances are on
+// it is more likely to be listed wrong (e.g., the wrong account or name prefix) than to have
+// lost every instance, so none of its instances are ghosts.
+func compareWithProviders(entries []Entry, providers map[string]Providers, listings []sourceListing, now time.Time) *ReconcileReport {
+	report := ReconcileReport{Orphans: make([]ProviderResource, 0), Ghosts: make([]Entry, 0), Drifted: make([]ReconcileDrift, 0)}
+	byName := make(map[string]Entry)
+	for _, entry := range entries {
+		if entry.Name != "" {
+			byName[entry.Name] = entry
+		}
+	}
+	seen := make(map[string]bool)
+	empty := make(map[reconcileSource]bool)
+	for _, listing := range listings {
+		for _, resource := range listing.resources {
+			owner := resource.Name
+			if resource.Owner != "" {
+				owner = resource.Owner
+			}
+			entry, ok := byName[owner]
+			if !ok {
+				if resource.Status != "deleting" && now.Sub(resource.Created) > reconcileGracePeriod {
+					report.Orphans = append(report.Orphans, resource)
+				}
+				continue
+			}
+			if resource.Kind == ResourceParameterGroup {
+				continue
+			}
+			seen[owner] = true
+			if entry.Tasks == 0 && resource.Status != "" && entry.Status != resource.Status {
+				report.Drifted = append(report.Drifted, ReconcileDrift{Id: entry.Id, Name: entry.Name, Status: entry.Status, ProviderStatus: resource.Status})
+			}
+		}
+	}
+	for _, entry := range entries {
+		if entry.Name == "" || seen[entry.Name] || entry.Tasks > 0 || now.Sub(entry.Created) <= reconcileGracePeriod {
+			continue
+		}
+		provider, ok := providers[entry.PlanId]
+		if !ok {
+			continue
+		}
+		listing, ok := sourceListingFor(listings, provider)
+		if !ok {
+			continue
+		}
+		if !listing.listsInstances() {
+			if !empty[listing.source] {
+				glog.Errorf("WARNING: Nothing was listed at the provider of instance %s (%s) and others may be on it, not looking for ghosts there.\n", entry.Id, entry.Name)
+				empty[listing.source] = true
+			}
+			continue
+		}
+		report.Ghosts = append(report.Ghosts, entry)
+	}
+	return &report
+}
+
+// Reconcile compares the providers with the resources table and acts on what it finds
+// according to the policy.
+func Reconcile(storage Storage, sources []reconcileSource, namePrefix string, policy *ReconcilePolicy) (*ReconcileReport, error) {
+	if namePrefix == "" {
+		return nil, errors.New("Refusing to reconcile without a name prefix, every resource would be an orphan.")
+	}
+	entries, err := storage.ListInstances()
+	if err != nil {
+		return nil, err
+	}
+	providers := make(map[string]Providers)
+	for _, entry := range entries {
+		if _, ok := providers[entry.PlanId]; ok {
+			continue
+		}
+		plan, err := storage.GetPlanByID(entry.PlanId)
+		if err != nil {
+			glog.Errorf("Unable to find plan %s of instance %s while reconciling: %s\n", entry.PlanId, entry.Id, err.Error())
+			continue
+		}
+		providers[entry.PlanId] = plan.Provider
+	}
+	listings := make([]sourceListing, 0)
+	for _, source := range sources {
+		resources, err := source.List(namePrefix)
+		if err != nil {
+			glog.Errorf("Unable to list provider resources while reconciling, its instances are skipped: %s\n", err.Error())
+			continue
+		}
+		for i := range resources {
+			resources[i].source = source
+		}
+		listings = append(listings, sourceListing{source: source, resources: resources})
+	}
+
+	report := compareWithProviders(entries, providers, listings, time.Now())
+	for _, orphan := range report.Orphans {
+		glog.Errorf("WARNING: Found an orphan, %s %s (namespace: %s, status: %s) has no instance.\n", orphan.Kind, orphan.Name, orphan.Namespace, orphan.Status)
+		if policy.DeleteOrphans {
+			if err := orphan.source.Delete(orphan); err != nil {
+				glog.Errorf("Unable to delete orphan %s %s: %s\n", orphan.Kind, orphan.Name, err.Error())
+			} else {
+				glog.Infof("Deleted orphan %s %s\n", orphan.Kind, orphan.Name)
+			}
+		}
+	}
+	for _, ghost := range report.Ghosts {
+		glog.Errorf("WARNING: Found a ghost, instance %s (%s) is %s but does not exist at its provider.\n", ghost.Id, ghost.Name, ghost.Status)
+		if policy.MarkGhostsDeleted {
+			if err := storage.DeleteInstance(&Instance{Id: ghost.Id}); err != nil {
+				glog.Errorf("Unable to mark ghost %s deleted: %s\n", ghost.Id, err.Error())
+			} else {
+				glog.Infof("Marked ghost %s (%s) deleted\n", ghost.Id, ghost.Name)
+			}
+		}
+	}
+	for _, drift := range report.Drifted {
+		glog.Errorf("WARNING: Instance %s (%s) is %s but %s at its provider.\n", drift.Id, drift.Name, drift.Status, drift.ProviderStatus)
+	}
+	glog.Infof("Reconciled with providers, found %d orphans, %d ghosts and %d drifted instances\n", len(report.Orphans), len(report.Ghosts), len(report.Drifted))
+	return report, nil
+}
+
+// reconcileSources returns a source for each provider the broker is configured to use, the
+// kubernetes namespaces are those of the environment and of every plan.
+func reconcileSources(ctx context.Context, namePrefix string, storage Storage) ([]reconcileSource, error) {
+	sources := make([]reconcileSource, 0)
+	if os.Getenv("AWS_REGION") != "" {
+		svc, err := newElastiCacheClient()
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, &awsReconcileSource{svc: svc})
+	}
+	if os.Getenv("USE_KUBERNETES") == "true" {
+		provider, err := NewKubernetesInstanceRedisProvider(ctx, namePrefix)
+		if err != nil {
+			return nil, err
+		}
+		namespaces, err := kubernetesNamespaces(storage)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, &kubernetesReconcileSource{client: provider.kubernetes, namespaces: namespaces})
+	}
+	return sources, nil
+}
+
+func kubernetesNamespaces(storage Storage) ([]string, error) {
+	plans := []ProviderPlan{{Provider: KubernetesRedisInstance}, {Provider: KubernetesMemcachedInstance}}
+	services, err := storage.GetServices()
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range services {
+		servicePlans, err := storage.GetPlans(service.ID)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, servicePlans...)
+	}
+	namespaces := make([]string, 0)
+	found := make(map[string]bool)
+	for i, plan := range plans {
+		engine := "redis"
+		if plan.Provider == KubernetesMemcachedInstance {
+			engine = "memcached"
+		} else if !strings.HasPrefix(string(plan.Provider), "kubernetes-") {
+			continue
+		}
+		kube, err := GetKubernetesSettings(&plans[i], engine)
+		if err != nil {
+			return nil, err
+		}
+		if !found[kube.Namespace] {
+			found[kube.Namespace] = true
+			namespaces = append(namespaces, kube.Namespace)
+		}
+	}
+	return namespaces, nil
+}
+
+// RunReconciler reconciles every interval until the context is cancelled. Only the longest
+// running worker reconciles so workers do not report or delete the same resources.
+func RunReconciler(ctx context.Context, o Options, namePrefix string, storage Storage, worker *Worker) {
+	policy, interval, err := ReconcileSettings(o)
+	if err != nil {
+		glog.Errorf("Unable to start the reconciler: %s\n", err.Error())
+		return
+	}
+	if !policy.Enabled {
+		return
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		workers, err := storage.ListWorkers(workerLease)
+		if err != nil {
+			glog.Errorf("Unable to list workers before reconciling: %s\n", err.Error())
+			continue
+		}
+		if len(workers) == 0 || workers[0].Id != worker.Id {
+			continue
+		}
+		// The sources are only needed for this run, the kubernetes provider they use is
+		// shut down once it is done.
+		runCtx, cancel := context.WithCancel(ctx)
+		sources, err := reconcileSources(runCtx, namePrefix, storage)
+		if err != nil {
+			cancel()
+			glog.Errorf("Unable to reconcile with providers: %s\n", err.Error())
+			continue
+		}
+		if _, err = Reconcile(storage, sources, namePrefix, policy); err != nil {
+			glog.Errorf("Unable to reconcile with providers: %s\n", err.Error())
+		}
+		cancel()
+	}
+}
+
+type awsReconcileSource struct {
+	svc elasticacheiface.ElastiCacheAPI
+}
+
+func (source *awsReconcileSource) Handles(provider Providers) bool {
+	return strings.HasPrefix(string(provider), "aws-")
+}
+
+// List returns the cache clusters that are not part of a replication group, the replication
+// groups and the parameter groups of instances with their own engine settings.
+func (source *awsReconcileSource) List(namePrefix string) ([]ProviderResource, error) {
+	// The providers lower case the ids they create, as ElastiCache does.
+	namePrefix = strings.ToLower(namePrefix)
+	resources := make([]ProviderResource, 0)
+	created := make(map[string]time.Time)
+	err := source.svc.DescribeCacheClustersPages(&elasticache.DescribeCacheClustersInput{MaxRecords: aws.Int64(100)}, func(page *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
+		for _, cluster := range page.CacheClusters {
+			created[aws.StringValue(cluster.CacheClusterId)] = aws.TimeValue(cluster.CacheClusterCreateTime)
+			if cluster.ReplicationGroupId != nil || !strings.HasPrefix(aws.StringValue(cluster.CacheClusterId), namePrefix) {
+				continue
+			}
+			resources = append(resources, ProviderResource{
+				Kind:    ResourceCacheCluster,
+				Name:    aws.StringValue(cluster.CacheClusterId),
+				Status:  aws.StringValue(cluster.CacheClusterStatus),
+				Created: aws.TimeValue(cluster.CacheClusterCreateTime),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = source.svc.DescribeReplicationGroupsPages(&elasticache.DescribeReplicationGroupsInput{MaxRecords: aws.Int64(100)}, func(page *elasticache.DescribeReplicationGroupsOutput, lastPage bool) bool {
+		for _, group := range page.ReplicationGroups {
+			if !strings.HasPrefix(aws.StringValue(group.ReplicationGroupId), namePrefix) {
+				continue
+			}
+			// Replication groups do not report when they were created, their oldest member does.
+			var groupCreated time.Time
+			for _, member := range group.MemberClusters {
+				if memberCreated, ok := created[aws.StringValue(member)]; ok && (groupCreated.IsZero() || memberCreated.Before(groupCreated)) {
+					groupCreated = memberCreated
+				}
+			}
+			resources = append(resources, ProviderResource{
+				Kind:    ResourceReplicationGroup,
+				Name:    aws.StringValue(group.ReplicationGroupId),
+				Status:  aws.StringValue(group.Status),
+				Created: groupCreated,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = source.svc.DescribeCacheParameterGroupsPages(&elasticache.DescribeCacheParameterGroupsInput{MaxRecords: aws.Int64(100)}, func(page *elasticache.DescribeCacheParameterGroupsOutput, lastPage bool) bool {
+		for _, group := range page.CacheParameterGroups {
+			name := aws.StringValue(group.CacheParameterGroupName)
+			owner := parameterGroupOwner(name, aws.StringValue(group.CacheParameterGroupFamily))
+			if owner == "" || !strings.HasPrefix(owner, namePrefix) {
+				continue
+			}
+			resources = append(resources, ProviderResource{
+				Kind:  ResourceParameterGroup,
+				Name:  name,
+				Owner: owner,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// parameterGroupOwner is the instance a parameter group created by ensureParameterGroup
+// belongs to, or nothing for any other parameter group.
+func parameterGroupOwner(name string, family string) string {
+	suffix := "-" + strings.Replace(family, ".", "-", -1)
+	if !strings.HasSuffix(name, suffix) || len(name) == len(suffix) {
+		return ""
+	}
+	return strings.TrimSuffix(name, suffix)
+}
+
+// Delete removes an orphan, a final snapshot is taken of redis so its data can be recovered.
+func (source *awsReconcileSource) Delete(resource ProviderResource) error {
+	switch resource.Kind {
+	case ResourceCacheCluster:
+		clusters, err := source.svc.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{CacheClusterId: aws.String(resource.Name)})
+		if err != nil {
+			return err
+		}
+		var snapshot *string = nil
+		if len(clusters.CacheClusters) > 0 && aws.StringValue(clusters.CacheClusters[0].Engine) == "redis" {
+			snapshot = aws.String(resource.Name + "-final")
+		}
+		_, err = source.svc.DeleteCacheCluster(&elasticache.DeleteCacheClusterInput{
+			CacheClusterId:          aws.String(resource.Name),
+			FinalSnapshotIdentifier: snapshot,
+		})
+		return err
+	case ResourceReplicationGroup:
+		_, err := source.svc.DeleteReplicationGroup(&elasticache.DeleteReplicationGroupInput{
+			FinalSnapshotIdentifier: aws.String(resource.Name + "-final"),
+			ReplicationGroupId:      aws.String(resource.Name),
+		})
+		return err
+	case ResourceParameterGroup:
+		_, err := source.svc.DeleteCacheParameterGroup(&elasticache.DeleteCacheParameterGroupInput{
+			CacheParameterGroupName: aws.String(resource.Name),
+		})
+		return err
+	}
+	return errors.New("Unable to delete a " + resource.Kind + " from AWS.")
+}
+
+type kubernetesReconcileSource struct {
+	client     kubernetes.Interface
+	namespaces []string
+}
+
+func (source *kubernetesReconcileSource) Handles(provider Providers) bool {
+	return strings.HasPrefix(string(provider), "kubernetes-")
+}
+
+// List returns the deployments and stateful sets in each namespace.
+func (source *kubernetesReconcileSource) List(namePrefix string) ([]ProviderResource, error) {
+	resources := make([]ProviderResource, 0)
+	for _, namespace := range source.namespaces {
+		deployments, err := source.client.AppsV1().Deployments(namespace).List(metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for i, deployment := range deployments.Items {
+			if !strings.HasPrefix(deployment.Name, namePrefix) {
+				continue
+			}
+			status, err := GetKubernetesStatus(source.client, deploymentWorkload(&deployments.Items[i]))
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, ProviderResource{
+				Kind:      ResourceDeployment,
+				Name:      deployment.Name,
+				Namespace: namespace,
+				Status:    status.Status,
+				Created:   deployment.CreationTimestamp.Time,
+			})
+		}
+		statefulsets, err := source.client.AppsV1().StatefulSets(namespace).List(metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for i, statefulset := range statefulsets.Items {
+			if !strings.HasPrefix(statefulset.Name, namePrefix) {
+				continue
+			}
+			status, err := GetKubernetesStatus(source.client, statefulSetWorkload(&statefulsets.Items[i]))
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, ProviderResource{
+				Kind:      ResourceStatefulSet,
+				Name:      statefulset.Name,
+				Namespace: namespace,
+				Status:    status.Status,
+				Created:   statefulset.CreationTimestamp.Time,
+			})
+		}
+	}
+	return resources, nil
+}
+
+// Delete removes an orphan and everything created with it, as deprovisioning would.
+func (source *kubernetesReconcileSource) Delete(resource ProviderResource) error {
+	err := source.client.CoreV1().Services(resource.Namespace).Delete(resource.Name, &metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	if resource.Kind == ResourceStatefulSet {
+		err = source.client.AppsV1().StatefulSets(resource.Namespace).Delete(resource.Name, &metav1.DeleteOptions{})
+		if err != nil {
+			return err
+		}
+		err = source.client.CoreV1().PersistentVolumeClaims(resource.Namespace).Delete(persistentVolumeClaimName(resource.Name), &metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	} else {
+		err = source.client.AppsV1().Deployments(resource.Namespace).Delete(resource.Name, &metav1.DeleteOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	err = source.client.CoreV1().Secrets(resource.Namespace).Delete(resource.Name+"-acl", &metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package broker
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	. "github.com/smartystreets/goconvey/convey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testReconcileSource struct {
+	resources []ProviderResource
+	deleted   []string
+}
+
+func (source *testReconcileSource) Handles(provider Providers) bool {
+	return provider == KubernetesRedisInstance
+}
+
+func (source *testReconcileSource) List(namePrefix string) ([]ProviderResource, error) {
+	return source.resources, nil
+}
+
+func (source *testReconcileSource) Delete(resource ProviderResource) error {
+	source.deleted = append(source.deleted, resource.Name)
+	return nil
+}
+
+func TestReconcile(t *testing.T) {
+	Convey("Given a reconcile policy.", t, func() {
+		Convey("Ensure it reports by default and acts only when asked", func() {
+			policy, err := ParseReconcilePolicy("")
+			So(err, ShouldBeNil)
+			So(*policy, ShouldResemble, ReconcilePolicy{Enabled: true})
+			policy, err = ParseReconcilePolicy("delete-orphans, mark-ghosts-deleted")
+			So(err, ShouldBeNil)
+			So(*policy, ShouldResemble, ReconcilePolicy{Enabled: true, DeleteOrphans: true, MarkGhostsDeleted: true})
+			policy, err = ParseReconcilePolicy("off")
+			So(err, ShouldBeNil)
+			So(policy.Enabled, ShouldBeFalse)
+			_, err = ParseReconcilePolicy("delete-everything")
+			So(err, ShouldNotBeNil)
+			_, err = ParseReconcilePolicy("off,delete-orphans")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Ensure only parameter groups created for instances have an owner", func() {
+			So(parameterGroupOwner("testabcdefgh-redis5-0", "redis5.0"), ShouldEqual, "testabcdefgh")
+			So(parameterGroupOwner("testabcdefgh-redis6-x", "redis6.x"), ShouldEqual, "testabcdefgh")
+			So(parameterGroupOwner("default.redis5.0", "redis5.0"), ShouldEqual, "")
+			So(parameterGroupOwner("-redis5-0", "redis5.0"), ShouldEqual, "")
+		})
+	})
+
+	Convey("Given instances and the resources running at their provider.", t, func() {
+		now := time.Now()
+		old := now.Add(-reconcileGracePeriod * 2)
+		source := &testReconcileSource{resources: []ProviderResource{
+			{Kind: ResourceDeployment, Name: "testavailable", Status: "available", Created: old},
+			{Kind: ResourceDeployment, Name: "testdrifted", Status: "crash-looping", Created: old},
+			{Kind: ResourceDeployment, Name: "testorphan", Status: "available", Created: old},
+			{Kind: ResourceDeployment, Name: "testnew", Status: "creating", Created: now},
+			{Kind: ResourceDeployment, Name: "testgoing", Status: "deleting", Created: old},
+			{Kind: ResourceParameterGroup, Name: "testavailable-redis5-0", Owner: "testavailable"},
+			{Kind: ResourceParameterGroup, Name: "testremoved-redis5-0", Owner: "testremoved"},
+		}}
+		entries := []Entry{
+			{Id: "1", Name: "testavailable", PlanId: "kube", Status: "available", Created: old},
+			{Id: "2", Name: "testdrifted", PlanId: "kube", Status: "available", Created: old},
+			{Id: "3", Name: "testghost", PlanId: "kube", Status: "available", Created: old},
+			{Id: "4", Name: "testbusy", PlanId: "kube", Status: "modifying", Created: old, Tasks: 1},
+			{Id: "5", Name: "testrecent", PlanId: "kube", Status: "creating", Created: now},
+			{Id: "6", Name: "testaws", PlanId: "aws", Status: "available", Created: old},
+			{Id: "7", Name: "", PlanId: "kube", Status: "provisioning", Created: old},
+		}
+		providers := map[string]Providers{"kube": KubernetesRedisInstance, "aws": AWSRedisInstance}
+		listings := []sourceListing{{source: source, resources: source.resources}}
+
+		Convey("Ensure orphans, ghosts and drift are found", func() {
+			report := compareWithProviders(entries, providers, listings, now)
+			orphans := make([]string, 0)
+			for _, orphan := range report.Orphans {
+				orphans = append(orphans, orphan.Name)
+			}
+			So(orphans, ShouldResemble, []string{"testorphan", "testremoved-redis5-0"})
+			So(len(report.Ghosts), ShouldEqual, 1)
+			So(report.Ghosts[0].Name, ShouldEqual, "testghost")
+			So(report.Drifted, ShouldResemble, []ReconcileDrift{{Id: "2", Name: "testdrifted", Status: "available", ProviderStatus: "crash-looping"}})
+		})
+
+		Convey("Ensure nothing is a ghost when the provider could not be listed", func() {
+			report := compareWithProviders(entries, providers, []sourceListing{}, now)
+			So(len(report.Ghosts), ShouldEqual, 0)
+			So(len(report.Orphans), ShouldEqual, 0)
+		})
+
+		Convey("Ensure nothing is a ghost when the provider lists nothing", func() {
+			empty := &testReconcileSource{resources: []ProviderResource{
+				{Kind: ResourceParameterGroup, Name: "testavailable-redis5-0", Owner: "testavailable"},
+			}}
+			report := compareWithProviders(entries, providers, []sourceListing{{source: empty, resources: empty.resources}}, now)
+			So(len(report.Ghosts), ShouldEqual, 0)
+		})
+	})
+
+	Convey("Given cache clusters on a fake elasticache.", t, func() {
+		svc := NewFakeElastiCache()
+		_, err := svc.CreateCacheCluster(&elasticache.CreateCacheClusterInput{
+			CacheClusterId: aws.String(strings.ToLower("TestPrefix" + RandomString(8))),
+			CacheNodeType:  aws.String("cache.t2.micro"),
+			Engine:         aws.String("redis"),
+			EngineVersion:  aws.String("5.0.6"),
+			NumCacheNodes:  aws.Int64(1),
+		})
+		So(err, ShouldBeNil)
+		source := &awsReconcileSource{svc: svc}
+
+		Convey("Ensure a mixed case name prefix lists the cache clusters the providers created", func() {
+			resources, err := source.List("TestPrefix")
+			So(err, ShouldBeNil)
+			So(len(resources), ShouldEqual, 1)
+			So(resources[0].Kind, ShouldEqual, ResourceCacheCluster)
+			So(resources[0].Name, ShouldStartWith, "testprefix")
+		})
+	})
+
+	Convey("Given instances running on kubernetes.", t, func() {
+		os.Setenv("TEST", "true")
+		provider, err := NewKubernetesInstanceRedisProvider(context.TODO(), "test")
+		So(err, ShouldBeNil)
+		plan := &ProviderPlan{ID: "reconcile-redis", Provider: KubernetesRedisInstance, providerPrivateDetails: `{"size_in_megabytes":"512","version":"5.0.4","namespace":"reconcile-test"}`}
+		instance, err := provider.Provision("reconcile-redis", plan, "owner")
+		So(err, ShouldBeNil)
+		source := &kubernetesReconcileSource{client: provider.kubernetes, namespaces: []string{"reconcile-test"}}
+
+		Convey("Ensure those with the name prefix are listed and can be deleted", func() {
+			resources, err := source.List("test")
+			So(err, ShouldBeNil)
+			So(len(resources), ShouldEqual, 1)
+			So(resources[0].Kind, ShouldEqual, ResourceDeployment)
+			So(resources[0].Name, ShouldEqual, instance.Name)
+			So(resources[0].Namespace, ShouldEqual, "reconcile-test")
+
+			resources, err = source.List("other")
+			So(err, ShouldBeNil)
+			So(len(resources), ShouldEqual, 0)
+
+			So(source.Delete(ProviderResource{Kind: ResourceDeployment, Name: instance.Name, Namespace: "reconcile-test"}), ShouldBeNil)
+			_, err = provider.kubernetes.AppsV1().Deployments("reconcile-test").Get(instance.Name, metav1.GetOptions{})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,1080 @@
+package broker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A database url starting with memory:// selects the in-memory storage, the rest of it names
+// the storage so brokers in the same process can share one (e.g., memory://test).
+const memoryDatabaseScheme = "memory://"
+
+var memoryStorages = make(map[string]*MemoryStorage)
+var memoryStoragesLock sync.Mutex
+
+type memoryResource struct {
+	entry      Entry
+	parameters string
+	config     string
+	deleted    bool
+	order      int64
+	// untrackedBindings marks resources bound before bindings were stored, nothing sets it
+	// other than tests as memory storage never had those.
+	untrackedBindings bool
+}
+
+type memoryTask struct {
+	task         Task
+	deleted      bool
+	updated      time.Time
+	leaseExpires *time.Time
+}
+
+type memoryBinding struct {
+	binding Binding
+	deleted bool
+	order   int64
+}
+
+// MemoryStorage keeps the services, plans, instances, tasks and bindings in memory with the
+// same behaviour as PostgresStorage. Nothing survives a restart and it cannot be shared with
+// other processes, so it is only meant for tests and local development.
+type MemoryStorage struct {
+	sync.Mutex
+	services  map[string]*ServiceDefinition
+	plans     map[string]*PlanDefinition
+	resources map[string]*memoryResource
+	tasks     map[string]*memoryTask
+	history   map[string][]TaskHistory
+	workers   map[string]*Worker
+	bindings  []*memoryBinding
+	order     int64
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		services:  make(map[string]*ServiceDefinition),
+		plans:     make(map[string]*PlanDefinition),
+		resources: make(map[string]*memoryResource),
+		tasks:     make(map[string]*memoryTask),
+		history:   make(map[string][]TaskHistory),
+		workers:   make(map[string]*Worker),
+		bindings:  make([]*memoryBinding, 0),
+	}
+}
+
+// InitMemoryStorage returns the in-memory storage named by the database url, creating it if this
+// is the first time it is used. Like InitStorage the catalog file is synced, or the default
+// services and plans are added when there is no catalog file.
+func InitMemoryStorage(ctx context.Context, o Options) (*MemoryStorage, error) {
+	if o.DatabaseUrl == "" && os.Getenv("DATABASE_URL") != "" {
+		o.DatabaseUrl = os.Getenv("DATABASE_URL")
+	}
+	if !strings.HasPrefix(o.DatabaseUrl, memoryDatabaseScheme) {
+		return nil, errors.New("The in-memory storage must be given a database url starting with " + memoryDatabaseScheme)
+	}
+	name := strings.TrimPrefix(o.DatabaseUrl, memoryDatabaseScheme)
+
+	if o.CatalogFile == "" && os.Getenv("CATALOG_FILE") != "" {
+		o.CatalogFile = os.Getenv("CATALOG_FILE")
+	}
+	dryRun := o.CatalogDryRun || os.Getenv("CATALOG_DRY_RUN") == "true"
+	if dryRun && o.CatalogFile == "" {
+		return nil, errors.New("A dry run of the catalog needs a catalog file, set one with --catalog-file or CATALOG_FILE.")
+	}
+
+	memoryStoragesLock.Lock()
+	defer memoryStoragesLock.Unlock()
+	storage, ok := memoryStorages[name]
+	if !ok {
+		storage = NewMemoryStorage()
+	}
+
+	if err := syncCatalogFile(storage, o.CatalogFile, dryRun); err != nil {
+		return nil, err
+	}
+	memoryStorages[name] = storage
+	return storage, nil
+}
+
+func (m *MemoryStorage) next() int64 {
+	m.order++
+	return m.order
+}
+
+// activePlan returns the plan if neither it nor its service has been deleted.
+func (m *MemoryStorage) activePlan(Id string) (*PlanDefinition, *ServiceDefinition, bool) {
+	plan, ok := m.plans[Id]
+	if !ok || plan.Deleted {
+		return nil, nil, false
+	}
+	service, ok := m.services[plan.Service]
+	if !ok || service.Deleted {
+		return nil, nil, false
+	}
+	return plan, service, true
+}
+
+func (m *MemoryStorage) GetPlans(serviceId string) ([]ProviderPlan, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.getPlans(serviceId)
+}
+
+func (m *MemoryStorage) getPlans(serviceId string) ([]ProviderPlan, error) {
+	definitions := make([]*PlanDefinition, 0)
+	for _, plan := range m.plans {
+		if _, _, ok := m.activePlan(plan.Id); ok && plan.Service == serviceId {
+			definitions = append(definitions, plan)
+		}
+	}
+	sort.Slice(definitions, func(i, j int) bool { return definitions[i].Name < definitions[j].Name })
+	plans := make([]ProviderPlan, 0)
+	for _, plan := range definitions {
+		providerPlan, err := newProviderPlan(plan, m.services[plan.Service].Name)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, *providerPlan)
+	}
+	return plans, nil
+}
+
+func (m *MemoryStorage) GetPlanByID(planId string) (*ProviderPlan, error) {
+	m.Lock()
+	defer m.Unlock()
+	plan, service, ok := m.activePlan(planId)
+	if !ok {
+		return nil, errors.New("Not found")
+	}
+	return newProviderPlan(plan, service.Name)
+}
+
+func (m *MemoryStorage) GetServices() ([]osb.Service, error) {
+	m.Lock()
+	defer m.Unlock()
+	definitions := make([]*ServiceDefinition, 0)
+	for _, service := range m.services {
+		if !service.Deleted {
+			definitions = append(definitions, service)
+		}
+	}
+	sort.Slice(definitions, func(i, j int) bool { return definitions[i].Name < definitions[j].Name })
+	services := make([]osb.Service, 0)
+	for _, service := range definitions {
+		plans, err := m.getPlans(service.Id)
+		if err != nil {
+			return nil, InternalServerError()
+		}
+		services = append(services, newOsbService(service, plans))
+	}
+	return services, nil
+}
+
+// hasOpenTask is whether the instance has a pending or started task with one of the actions.
+func (m *MemoryStorage) hasOpenTask(Id string, actions ...TaskAction) bool {
+	for _, task := range m.tasks {
+		if task.deleted || task.task.ResourceId != Id || (task.task.Status != "pending" && task.task.Status != "started") {
+			continue
+		}
+		for _, action := range actions {
+			if task.task.Action == action {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *MemoryStorage) IsUpgrading(dbId string) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.hasOpenTask(dbId, ChangeProvidersTask, ChangePlansTask, ApplyConfigTask, UpgradeEngineTask), nil
+}
+
+func (m *MemoryStorage) IsRestoring(dbId string) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.hasOpenTask(dbId, RestoreTask), nil
+}
+
+func (m *MemoryStorage) IsRotatingCredentials(dbId string) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.hasOpenTask(dbId, RotateCredentialsTask), nil
+}
+
+// GetUnclaimedInstance hands an available preprovisioned instance of the plan over to the
+// instance id, along with its tasks.
+func (m *MemoryStorage) GetUnclaimedInstance(PlanId string, InstanceId string) (*Entry, error) {
+	m.Lock()
+	defer m.Unlock()
+	var unclaimed *memoryResource
+	for _, resource := range m.resources {
+		if !resource.entry.Claimed && resource.entry.Status == "available" && !resource.deleted && resource.entry.Id != InstanceId && resource.entry.PlanId == PlanId {
+			if unclaimed == nil || resource.order < unclaimed.order {
+				unclaimed = resource
+			}
+		}
+	}
+	if unclaimed == nil {
+		return nil, errors.New("Cannot find resource instance")
+	}
+	if _, ok := m.resources[InstanceId]; ok {
+		return nil, errors.New("The instance id is already in use (even if deleted)")
+	}
+	entry := Entry{
+		Id:       InstanceId,
+		Name:     unclaimed.entry.Name,
+		PlanId:   unclaimed.entry.PlanId,
+		Claimed:  true,
+		Status:   unclaimed.entry.Status,
+		Username: unclaimed.entry.Username,
+		Password: unclaimed.entry.Password,
+		Endpoint: unclaimed.entry.Endpoint,
+	}
+	claimed := &memoryResource{entry: entry, parameters: "{}", config: "{}", order: m.next()}
+	claimed.entry.Created = time.Now()
+	m.resources[InstanceId] = claimed
+	for _, task := range m.tasks {
+		if task.task.ResourceId == unclaimed.entry.Id && !task.deleted {
+			task.task.ResourceId = InstanceId
+			task.updated = time.Now()
+		}
+	}
+	delete(m.resources, unclaimed.entry.Id)
+	return &entry, nil
+}
+
+func (m *MemoryStorage) ReturnClaimedInstance(Id string) error {
+	m.Lock()
+	defer m.Unlock()
+	resource, ok := m.resources[Id]
+	if !ok || resource.entry.Status != "available" || resource.deleted || !resource.entry.Claimed {
+		return errors.New("invalid count returned after trying to return unclaimed db " + Id)
+	}
+	delete(m.resources, Id)
+	resource.entry.Claimed = false
+	resource.entry.Id = uuid.New().String()
+	m.resources[resource.entry.Id] = resource
+	return nil
+}
+
+func (m *MemoryStorage) AddInstance(Instance *Instance) error {
+	parameters, err := marshalParameters(Instance.Parameters)
+	if err != nil {
+		return err
+	}
+	config, err := marshalConfig(Instance.Config)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.resources[Instance.Id]; ok {
+		return errors.New("The instance id is already in use (even if deleted)")
+	}
+	m.resources[Instance.Id] = &memoryResource{
+		entry: Entry{
+			Id:       Instance.Id,
+			Name:     Instance.Name,
+			PlanId:   Instance.Plan.ID,
+			Claimed:  true,
+			Status:   Instance.Status,
+			Username: Instance.Username,
+			Password: Instance.Password,
+			Endpoint: Instance.Endpoint,
+			Created:  time.Now(),
+		},
+		parameters: parameters,
+		config:     config,
+		order:      m.next(),
+	}
+	return nil
+}
+
+func (m *MemoryStorage) NukeInstance(Id string) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.resources, Id)
+	return nil
+}
+
+func (m *MemoryStorage) DeleteInstance(Instance *Instance) error {
+	m.Lock()
+	defer m.Unlock()
+	for _, task := range m.tasks {
+		if task.task.ResourceId == Instance.Id {
+			task.deleted = true
+			task.updated = time.Now()
+		}
+	}
+	for _, binding := range m.bindings {
+		if binding.binding.ResourceId == Instance.Id {
+			binding.deleted = true
+		}
+	}
+	if resource, ok := m.resources[Instance.Id]; ok {
+		resource.deleted = true
+	}
+	return nil
+}
+
+func (m *MemoryStorage) UpdateInstance(Instance *Instance, PlanId string) error {
+	m.Lock()
+	defer m.Unlock()
+	if resource, ok := m.resources[Instance.Id]; ok {
+		resource.entry.PlanId = PlanId
+		resource.entry.Endpoint = Instance.Endpoint
+		resource.entry.Status = Instance.Status
+		resource.entry.Username = Instance.Username
+		resource.entry.Password = Instance.Password
+		resource.entry.Name = Instance.Name
+	}
+	return nil
+}
+
+// activeResource returns the instance if it has not been deleted.
+func (m *MemoryStorage) activeResource(Id string) (*memoryResource, bool) {
+	resource, ok := m.resources[Id]
+	if !ok || resource.deleted {
+		return nil, false
+	}
+	return resource, true
+}
+
+func (m *MemoryStorage) UpdateInstanceParameters(Id string, parameters map[string]interface{}) error {
+	data, err := marshalParameters(parameters)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	if resource, ok := m.activeResource(Id); ok {
+		resource.parameters = data
+	}
+	return nil
+}
+
+func (m *MemoryStorage) UpdateInstanceConfig(Id string, config map[string]string) error {
+	data, err := marshalConfig(config)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	if resource, ok := m.activeResource(Id); ok {
+		resource.config = data
+	}
+	return nil
+}
+
+func (m *MemoryStorage) UpdateInstanceEngineVersion(Id string, version string) error {
+	m.Lock()
+	defer m.Unlock()
+	if resource, ok := m.activeResource(Id); ok {
+		resource.entry.EngineVersion = version
+	}
+	return nil
+}
+
+func (m *MemoryStorage) ValidateInstanceID(id string) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.resources[id]; ok {
+		return errors.New("The instance id is already in use (even if deleted)")
+	}
+	return nil
+}
+
+func (m *MemoryStorage) StartProvisioningTasks() ([]Entry, error) {
+	m.Lock()
+	defer m.Unlock()
+	entries := make([]Entry, 0)
+	planIds := make([]string, 0)
+	for Id := range m.plans {
+		planIds = append(planIds, Id)
+	}
+	sort.Strings(planIds)
+	for _, planId := range planIds {
+		plan, service, ok := m.activePlan(planId)
+		if !ok || plan.Deprecated || service.Deprecated {
+			continue
+		}
+		needed := plan.Preprovision
+		for _, resource := range m.resources {
+			if resource.entry.PlanId == planId && !resource.entry.Claimed && !resource.deleted {
+				switch resource.entry.Status {
+				case "available", "creating", "provisioning", "backing-up", "starting":
+					needed--
+				}
+			}
+		}
+		for i := 0; i < needed; i++ {
+			entry := Entry{Id: uuid.New().String(), PlanId: planId, Status: "provisioning", Created: time.Now()}
+			m.resources[entry.Id] = &memoryResource{entry: entry, parameters: "{}", config: "{}", order: m.next()}
+			entries = append(entries, Entry{Id: entry.Id, PlanId: planId})
+		}
+	}
+	return entries, nil
+}
+
+// startedTasks counts the tasks running for the instance.
+func (m *MemoryStorage) startedTasks(Id string) int {
+	count := 0
+	for _, task := range m.tasks {
+		if task.task.ResourceId == Id && task.task.Status == "started" && !task.deleted {
+			count++
+		}
+	}
+	return count
+}
+
+func (m *MemoryStorage) GetInstance(Id string) (*Entry, error) {
+	m.Lock()
+	defer m.Unlock()
+	resource, ok := m.activeResource(Id)
+	if !ok {
+		return nil, errors.New("Cannot find resource instance")
+	}
+	entry := resource.entry
+	entry.Tasks = m.startedTasks(Id)
+	if err := json.Unmarshal([]byte(resource.parameters), &entry.Parameters); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(resource.config), &entry.Config); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (m *MemoryStorage) ListInstances() ([]Entry, error) {
+	m.Lock()
+	defer m.Unlock()
+	resources := make([]*memoryResource, 0)
+	for _, resource := range m.resources {
+		if !resource.deleted {
+			resources = append(resources, resource)
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].order < resources[j].order })
+	entries := make([]Entry, 0)
+	for _, resource := range resources {
+		entries = append(entries, Entry{
+			Id:      resource.entry.Id,
+			Name:    resource.entry.Name,
+			PlanId:  resource.entry.PlanId,
+			Claimed: resource.entry.Claimed,
+			Status:  resource.entry.Status,
+			Created: resource.entry.Created,
+			Tasks:   m.startedTasks(resource.entry.Id),
+		})
+	}
+	return entries, nil
+}
+
+func (m *MemoryStorage) GetMetrics() (*StorageMetrics, error) {
+	m.Lock()
+	defer m.Unlock()
+	metrics := StorageMetrics{Tasks: make([]TaskCount, 0), Instances: make([]InstanceCount, 0), Pools: make([]PoolSize, 0)}
+
+	tasks := make(map[TaskCount]int64)
+	for _, task := range m.tasks {
+		if !task.deleted && (task.task.Status == "pending" || task.task.Status == "started") {
+			tasks[TaskCount{Action: string(task.task.Action), Status: task.task.Status}]++
+		}
+	}
+	for count, total := range tasks {
+		count.Count = total
+		metrics.Tasks = append(metrics.Tasks, count)
+	}
+	sort.Slice(metrics.Tasks, func(i, j int) bool {
+		return metrics.Tasks[i].Action+" "+metrics.Tasks[i].Status < metrics.Tasks[j].Action+" "+metrics.Tasks[j].Status
+	})
+
+	instances := make(map[InstanceCount]int64)
+	unclaimed := make(map[string]bool)
+	available := make(map[string]int64)
+	for _, resource := range m.resources {
+		plan, ok := m.plans[resource.entry.PlanId]
+		if resource.deleted || !ok {
+			continue
+		}
+		instances[InstanceCount{Plan: plan.Name, Status: resource.entry.Status}]++
+		if !resource.entry.Claimed {
+			unclaimed[plan.Id] = true
+			if resource.entry.Status == "available" {
+				available[plan.Id]++
+			}
+		}
+	}
+	for count, total := range instances {
+		count.Count = total
+		metrics.Instances = append(metrics.Instances, count)
+	}
+	sort.Slice(metrics.Instances, func(i, j int) bool {
+		return metrics.Instances[i].Plan+" "+metrics.Instances[i].Status < metrics.Instances[j].Plan+" "+metrics.Instances[j].Status
+	})
+
+	for _, plan := range m.plans {
+		if !plan.Deleted && (plan.Preprovision > 0 || unclaimed[plan.Id]) {
+			metrics.Pools = append(metrics.Pools, PoolSize{Plan: plan.Name, Target: int64(plan.Preprovision), Available: available[plan.Id]})
+		}
+	}
+	sort.Slice(metrics.Pools, func(i, j int) bool { return metrics.Pools[i].Plan < metrics.Pools[j].Plan })
+	return &metrics, nil
+}
+
+func (m *MemoryStorage) AddBinding(binding *Binding) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.resources[binding.ResourceId]; !ok {
+		return errors.New("Cannot find resource instance")
+	}
+	for _, existing := range m.bindings {
+		if existing.binding.ResourceId == binding.ResourceId && existing.binding.Id == binding.Id && !existing.deleted {
+			return errors.New("The binding already exists")
+		}
+	}
+	m.bindings = append(m.bindings, &memoryBinding{binding: *binding, order: m.next()})
+	return nil
+}
+
+func (m *MemoryStorage) GetBinding(ResourceId string, Id string) (*Binding, error) {
+	m.Lock()
+	defer m.Unlock()
+	for _, existing := range m.bindings {
+		if existing.binding.ResourceId == ResourceId && existing.binding.Id == Id && !existing.deleted {
+			binding := existing.binding
+			return &binding, nil
+		}
+	}
+	return nil, errors.New("Cannot find binding")
+}
+
+func (m *MemoryStorage) ListBindings(ResourceId string) ([]Binding, error) {
+	m.Lock()
+	defer m.Unlock()
+	bindings := make([]Binding, 0)
+	for _, existing := range m.bindings {
+		if existing.binding.ResourceId == ResourceId && !existing.deleted {
+			bindings = append(bindings, existing.binding)
+		}
+	}
+	return bindings, nil
+}
+
+func (m *MemoryStorage) DeleteBinding(ResourceId string, Id string) error {
+	m.Lock()
+	defer m.Unlock()
+	for _, existing := range m.bindings {
+		if existing.binding.ResourceId == ResourceId && existing.binding.Id == Id {
+			existing.deleted = true
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStorage) HasUntrackedBindings(ResourceId string) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	resource, ok := m.resources[ResourceId]
+	if !ok || resource.deleted {
+		return false, errors.New("Cannot find resource instance")
+	}
+	return resource.untrackedBindings, nil
+}
+
+// recordTask adds to the history of a task when its status or result changed, as the trigger
+// on the tasks table does.
+func (m *MemoryStorage) recordTask(task *memoryTask, previous *Task) {
+	if previous != nil && previous.Status == task.task.Status && previous.Result == task.task.Result {
+		return
+	}
+	m.history[task.task.Id] = append(m.history[task.task.Id], TaskHistory{
+		Status:  task.task.Status,
+		Retries: task.task.Retries,
+		Result:  task.task.Result,
+		Worker:  task.task.Worker,
+		Created: time.Now(),
+	})
+}
+
+func (m *MemoryStorage) AddTask(Id string, action TaskAction, metadata string) (string, error) {
+	return m.AddScheduledTask(Id, action, metadata, time.Now())
+}
+
+func (m *MemoryStorage) AddScheduledTask(Id string, action TaskAction, metadata string, notBefore time.Time) (string, error) {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.resources[Id]; !ok {
+		return "", errors.New("Cannot find resource instance")
+	}
+	now := time.Now()
+	task := &memoryTask{
+		task: Task{
+			Id:         uuid.New().String(),
+			Action:     action,
+			ResourceId: Id,
+			Status:     "pending",
+			Metadata:   metadata,
+			Created:    &now,
+			NotBefore:  &notBefore,
+		},
+		updated: now,
+	}
+	m.tasks[task.task.Id] = task
+	m.recordTask(task, nil)
+	return task.task.Id, nil
+}
+
+// updateTask changes a task and records it in the history.
+func (m *MemoryStorage) updateTask(Id string, change func(task *memoryTask)) bool {
+	task, ok := m.tasks[Id]
+	if !ok {
+		return false
+	}
+	previous := task.task
+	change(task)
+	task.updated = time.Now()
+	m.recordTask(task, &previous)
+	return true
+}
+
+func (m *MemoryStorage) RescheduleTask(Id string, retries int64, result string, notBefore time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	m.updateTask(Id, func(task *memoryTask) {
+		task.task.Status = "pending"
+		task.task.Retries = retries
+		task.task.Result = result
+		task.task.NotBefore = &notBefore
+		task.task.Worker = ""
+		task.leaseExpires = nil
+	})
+	return nil
+}
+
+func (m *MemoryStorage) UpdateTask(Id string, status *string, retries *int64, metadata *string, result *string, started *time.Time, finsihed *time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	m.updateTask(Id, func(task *memoryTask) {
+		if status != nil {
+			task.task.Status = *status
+		}
+		if retries != nil {
+			task.task.Retries = *retries
+		}
+		if metadata != nil {
+			task.task.Metadata = *metadata
+		}
+		if result != nil {
+			task.task.Result = *result
+		}
+		if started != nil {
+			task.task.Started = started
+		}
+		if finsihed != nil {
+			task.task.Finished = finsihed
+		}
+	})
+	return nil
+}
+
+func (m *MemoryStorage) WarnOnUnfinishedTasks() {}
+
+// PopPendingTask claims the pending task that has been due the longest for the worker,
+// resources with a task already started are skipped.
+func (m *MemoryStorage) PopPendingTask(worker string, lease time.Duration) (*Task, error) {
+	m.Lock()
+	defer m.Unlock()
+	now := time.Now()
+	busy := make(map[string]bool)
+	for _, task := range m.tasks {
+		if task.task.Status == "started" && !task.deleted {
+			busy[task.task.ResourceId] = true
+		}
+	}
+	var next *memoryTask
+	for _, task := range m.tasks {
+		if task.task.Status != "pending" || task.deleted || task.task.NotBefore.After(now) || busy[task.task.ResourceId] {
+			continue
+		}
+		if next == nil || task.task.NotBefore.Before(*next.task.NotBefore) || (task.task.NotBefore.Equal(*next.task.NotBefore) && task.updated.Before(next.updated)) {
+			next = task
+		}
+	}
+	if next == nil {
+		return nil, sql.ErrNoRows
+	}
+	expires := now.Add(lease)
+	m.updateTask(next.task.Id, func(task *memoryTask) {
+		task.task.Status = "started"
+		task.task.Started = &now
+		task.task.Worker = worker
+		task.leaseExpires = &expires
+	})
+	task := next.task
+	return &task, nil
+}
+
+func (m *MemoryStorage) RegisterWorker(worker *Worker) error {
+	m.Lock()
+	defer m.Unlock()
+	now := time.Now()
+	if existing, ok := m.workers[worker.Id]; ok {
+		existing.Hostname = worker.Hostname
+		existing.Concurrency = worker.Concurrency
+		existing.Heartbeat = now
+		return nil
+	}
+	m.workers[worker.Id] = &Worker{Id: worker.Id, Hostname: worker.Hostname, Concurrency: worker.Concurrency, Started: now, Heartbeat: now}
+	return nil
+}
+
+func (m *MemoryStorage) WorkerHeartbeat(Id string, lease time.Duration) error {
+	m.Lock()
+	defer m.Unlock()
+	now := time.Now()
+	if worker, ok := m.workers[Id]; ok {
+		worker.Heartbeat = now
+	}
+	expires := now.Add(lease)
+	for _, task := range m.tasks {
+		if task.task.Worker == Id && task.task.Status == "started" && !task.deleted {
+			task.leaseExpires = &expires
+			task.updated = now
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStorage) RemoveWorker(Id string) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.workers, Id)
+	return nil
+}
+
+func (m *MemoryStorage) RemoveStaleWorkers(age time.Duration) error {
+	m.Lock()
+	defer m.Unlock()
+	for Id, worker := range m.workers {
+		if worker.Heartbeat.Before(time.Now().Add(-age)) {
+			delete(m.workers, Id)
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStorage) ListWorkers(since time.Duration) ([]Worker, error) {
+	m.Lock()
+	defer m.Unlock()
+	workers := make([]Worker, 0)
+	for _, worker := range m.workers {
+		if worker.Heartbeat.After(time.Now().Add(-since)) {
+			listed := *worker
+			listed.Tasks = make([]Task, 0)
+			for _, task := range m.tasks {
+				if task.task.Worker == worker.Id && task.task.Status == "started" && !task.deleted {
+					listed.Tasks = append(listed.Tasks, task.task)
+				}
+			}
+			sort.Slice(listed.Tasks, func(i, j int) bool { return listed.Tasks[i].Started.Before(*listed.Tasks[j].Started) })
+			workers = append(workers, listed)
+		}
+	}
+	sort.Slice(workers, func(i, j int) bool { return workers[i].Started.Before(workers[j].Started) })
+	return workers, nil
+}
+
+// RequeueExpiredTasks puts started tasks whose worker stopped renewing their lease back to
+// pending, counting it as a retry.
+func (m *MemoryStorage) RequeueExpiredTasks() (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	now := time.Now()
+	var count int64
+	for Id, task := range m.tasks {
+		if task.task.Status != "started" || task.deleted {
+			continue
+		}
+		expired := task.leaseExpires != nil && task.leaseExpires.Before(now)
+		stale := task.leaseExpires == nil && task.task.Started != nil && task.task.Started.Before(now.Add(-time.Hour*24))
+		if !expired && !stale {
+			continue
+		}
+		m.updateTask(Id, func(task *memoryTask) {
+			task.task.Status = "pending"
+			task.task.Retries = task.task.Retries + 1
+			task.task.Result = "The worker running this task stopped responding, it was requeued."
+			task.task.Worker = ""
+			task.task.NotBefore = &now
+			task.leaseExpires = nil
+		})
+		count++
+	}
+	return count, nil
+}
+
+// ListTasks returns the most recently created tasks matching the filter, empty fields match any task.
+func (m *MemoryStorage) ListTasks(filter TaskFilter) ([]Task, error) {
+	if filter.Limit < 1 {
+		filter.Limit = 100
+	}
+	m.Lock()
+	defer m.Unlock()
+	tasks := make([]Task, 0)
+	for _, task := range m.tasks {
+		if task.deleted ||
+			(filter.ResourceId != "" && task.task.ResourceId != filter.ResourceId) ||
+			(filter.Action != "" && string(task.task.Action) != filter.Action) ||
+			(filter.Status != "" && task.task.Status != filter.Status) {
+			continue
+		}
+		tasks = append(tasks, task.task)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].Created.After(*tasks[j].Created) })
+	if len(tasks) > filter.Limit {
+		tasks = tasks[:filter.Limit]
+	}
+	return tasks, nil
+}
+
+func (m *MemoryStorage) GetTask(Id string) (*Task, error) {
+	m.Lock()
+	defer m.Unlock()
+	task, ok := m.tasks[Id]
+	if !ok || task.deleted {
+		return nil, errors.New("Not found")
+	}
+	found := task.task
+	return &found, nil
+}
+
+func (m *MemoryStorage) ListTaskHistory(Id string) ([]TaskHistory, error) {
+	m.Lock()
+	defer m.Unlock()
+	history := make([]TaskHistory, 0)
+	return append(history, m.history[Id]...), nil
+}
+
+// RequeueFailedTask puts a failed task back to pending with its retries reset.
+func (m *MemoryStorage) RequeueFailedTask(Id string) error {
+	m.Lock()
+	defer m.Unlock()
+	task, ok := m.tasks[Id]
+	if !ok || task.task.Status != "failed" || task.deleted {
+		return errors.New("Not found")
+	}
+	now := time.Now()
+	m.updateTask(Id, func(task *memoryTask) {
+		task.task.Status = "pending"
+		task.task.Retries = 0
+		task.task.Finished = nil
+		task.task.Worker = ""
+		task.task.NotBefore = &now
+		task.task.Result = "Requeued by an operator."
+		task.leaseExpires = nil
+	})
+	return nil
+}
+
+// CancelPendingTask marks a task that has not yet started as failed so it never runs.
+func (m *MemoryStorage) CancelPendingTask(Id string) error {
+	m.Lock()
+	defer m.Unlock()
+	task, ok := m.tasks[Id]
+	if !ok || task.task.Status != "pending" || task.deleted {
+		return errors.New("Not found")
+	}
+	now := time.Now()
+	m.updateTask(Id, func(task *memoryTask) {
+		task.task.Status = "failed"
+		task.task.Finished = &now
+		task.task.Result = "Cancelled by an operator."
+	})
+	return nil
+}
+
+func (m *MemoryStorage) ListServiceDefinitions(includeDeleted bool) ([]ServiceDefinition, error) {
+	m.Lock()
+	defer m.Unlock()
+	services := make([]ServiceDefinition, 0)
+	for _, service := range m.services {
+		if !service.Deleted || includeDeleted {
+			services = append(services, *service)
+		}
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+	return services, nil
+}
+
+func (m *MemoryStorage) GetServiceDefinition(Id string) (*ServiceDefinition, error) {
+	m.Lock()
+	defer m.Unlock()
+	service, ok := m.services[Id]
+	if !ok || service.Deleted {
+		return nil, errors.New("Not found")
+	}
+	found := *service
+	return &found, nil
+}
+
+// AddService adds the service, a new id is assigned if it does not have one.
+func (m *MemoryStorage) AddService(service *ServiceDefinition) error {
+	m.Lock()
+	defer m.Unlock()
+	if service.Id == "" {
+		service.Id = uuid.New().String()
+	}
+	if _, ok := m.services[service.Id]; ok {
+		return errors.New("The service " + service.Id + " already exists")
+	}
+	service.Deleted = false
+	service.Created = time.Now()
+	service.Updated = service.Created
+	added := *service
+	m.services[service.Id] = &added
+	return nil
+}
+
+func (m *MemoryStorage) UpdateService(service *ServiceDefinition) error {
+	m.Lock()
+	defer m.Unlock()
+	existing, ok := m.services[service.Id]
+	if !ok || existing.Deleted {
+		return errors.New("Not found")
+	}
+	updated := *service
+	updated.Deleted = false
+	updated.Created = existing.Created
+	updated.Updated = time.Now()
+	m.services[service.Id] = &updated
+	return nil
+}
+
+// DeleteService marks a service as deleted, services that still have plans are left alone and
+// "Not found" is returned.
+func (m *MemoryStorage) DeleteService(Id string) error {
+	m.Lock()
+	defer m.Unlock()
+	service, ok := m.services[Id]
+	if !ok || service.Deleted {
+		return errors.New("Not found")
+	}
+	for _, plan := range m.plans {
+		if plan.Service == Id && !plan.Deleted {
+			return errors.New("Not found")
+		}
+	}
+	service.Deleted = true
+	service.Updated = time.Now()
+	return nil
+}
+
+// ListPlanDefinitions returns the plans of a service, or every plan if the service is empty.
+func (m *MemoryStorage) ListPlanDefinitions(serviceId string, includeDeleted bool) ([]PlanDefinition, error) {
+	m.Lock()
+	defer m.Unlock()
+	plans := make([]PlanDefinition, 0)
+	for _, plan := range m.plans {
+		if (serviceId == "" || plan.Service == serviceId) && (!plan.Deleted || includeDeleted) {
+			plans = append(plans, *plan)
+		}
+	}
+	sort.Slice(plans, func(i, j int) bool { return plans[i].Name < plans[j].Name })
+	return plans, nil
+}
+
+func (m *MemoryStorage) GetPlanDefinition(Id string) (*PlanDefinition, error) {
+	m.Lock()
+	defer m.Unlock()
+	plan, ok := m.plans[Id]
+	if !ok || plan.Deleted {
+		return nil, errors.New("Not found")
+	}
+	found := *plan
+	return &found, nil
+}
+
+// AddPlan adds the plan, a new id is assigned if it does not have one.
+func (m *MemoryStorage) AddPlan(plan *PlanDefinition) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.services[plan.Service]; !ok {
+		return errors.New("The service " + plan.Service + " of the plan does not exist")
+	}
+	if plan.Id == "" {
+		plan.Id = uuid.New().String()
+	}
+	if _, ok := m.plans[plan.Id]; ok {
+		return errors.New("The plan " + plan.Id + " already exists")
+	}
+	plan.Deleted = false
+	plan.Created = time.Now()
+	plan.Updated = plan.Created
+	added := *plan
+	m.plans[plan.Id] = &added
+	return nil
+}
+
+func (m *MemoryStorage) UpdatePlan(plan *PlanDefinition) error {
+	m.Lock()
+	defer m.Unlock()
+	existing, ok := m.plans[plan.Id]
+	if !ok || existing.Deleted {
+		return errors.New("Not found")
+	}
+	if _, ok := m.services[plan.Service]; !ok {
+		return errors.New("The service " + plan.Service + " of the plan does not exist")
+	}
+	updated := *plan
+	updated.Deleted = false
+	updated.Created = existing.Created
+	updated.Updated = time.Now()
+	m.plans[plan.Id] = &updated
+	return nil
+}
+
+// DeletePlan marks a plan as deleted, plans that are used by an instance are left alone and
+// "Not found" is returned.
+func (m *MemoryStorage) DeletePlan(Id string) error {
+	m.Lock()
+	defer m.Unlock()
+	plan, ok := m.plans[Id]
+	if !ok || plan.Deleted {
+		return errors.New("Not found")
+	}
+	for _, resource := range m.resources {
+		if resource.entry.PlanId == Id && !resource.deleted {
+			return errors.New("Not found")
+		}
+	}
+	plan.Deleted = true
+	plan.Preprovision = 0
+	plan.Updated = time.Now()
+	return nil
+}
+
+// CountInstancesOnPlan counts the instances (claimed or preprovisioned) that have not been deleted.
+func (m *MemoryStorage) CountInstancesOnPlan(Id string) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	var count int64
+	for _, resource := range m.resources {
+		if resource.entry.PlanId == Id && !resource.deleted {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,211 @@
+package broker
+
+import (
+	"context"
+	. "github.com/smartystreets/goconvey/convey"
+	"os"
+	"testing"
+	"time"
+)
+
+// testDatabaseUrl is the database the business logic tests run against, the in-memory
+// storage unless DATABASE_URL is set.
+func testDatabaseUrl() string {
+	if os.Getenv("DATABASE_URL") != "" {
+		return os.Getenv("DATABASE_URL")
+	}
+	return "memory://test"
+}
+
+func TestMemoryStorage(t *testing.T) {
+	Convey("Given the default plans.", t, func() {
+		Convey("Ensure the plans of kubernetes providers are only added with kubernetes", func() {
+			catalog, err := defaultCatalog(false)
+			So(err, ShouldBeNil)
+			So(len(catalog.Services), ShouldEqual, 2)
+			var hobby PlanDefinition
+			for _, plan := range catalog.Plans {
+				So(plan.Provider, ShouldStartWith, "aws-")
+				if plan.Id == "c7b8e0b0-429a-4fa8-92a0-e60d9e781cac" {
+					hobby = plan
+				}
+			}
+			So(hobby.Name, ShouldEqual, "hobby-dev")
+			So(hobby.Service, ShouldEqual, "0a8d668d-2971-4533-8e38-a816a7c01bec")
+			So(hobby.CostCents, ShouldEqual, 1500)
+			So(hobby.Provider, ShouldEqual, "aws-redis-instance")
+			So(hobby.CostUnit, ShouldEqual, "month")
+			So(string(hobby.ProviderPrivateDetails), ShouldContainSubstring, `"CacheNodeType":"cache.t2.micro"`)
+
+			withKubernetes, err := defaultCatalog(true)
+			So(err, ShouldBeNil)
+			So(len(withKubernetes.Plans), ShouldBeGreaterThan, len(catalog.Plans))
+		})
+
+		Convey("Ensure plans changed or deleted since they were added are kept", func() {
+			storage := NewMemoryStorage()
+			So(syncCatalogFile(storage, "", false), ShouldBeNil)
+			plan, err := storage.GetPlanDefinition("c7b8e0b0-429a-4fa8-92a0-e60d9e781cac")
+			So(err, ShouldBeNil)
+			plan.CostCents = 2000
+			So(storage.UpdatePlan(plan), ShouldBeNil)
+			So(storage.DeletePlan("e9f28df0-552e-4fa6-be84-92fc88998f05"), ShouldBeNil)
+
+			So(syncCatalogFile(storage, "", false), ShouldBeNil)
+			plan, err = storage.GetPlanDefinition("c7b8e0b0-429a-4fa8-92a0-e60d9e781cac")
+			So(err, ShouldBeNil)
+			So(plan.CostCents, ShouldEqual, 2000)
+			_, err = storage.GetPlanDefinition("e9f28df0-552e-4fa6-be84-92fc88998f05")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Ensure storages with the same name are shared", func() {
+			first, err := NewStorage(context.TODO(), Options{DatabaseUrl: "memory://shared"})
+			So(err, ShouldBeNil)
+			second, err := NewStorage(context.TODO(), Options{DatabaseUrl: "memory://shared"})
+			So(err, ShouldBeNil)
+			other, err := NewStorage(context.TODO(), Options{DatabaseUrl: "memory://other"})
+			So(err, ShouldBeNil)
+			So(second, ShouldEqual, first)
+			So(other, ShouldNotEqual, first)
+
+			services, err := first.GetServices()
+			So(err, ShouldBeNil)
+			So(len(services), ShouldEqual, 2)
+			plan, err := first.GetPlanByID("c7b8e0b0-429a-4fa8-92a0-e60d9e781cac")
+			So(err, ShouldBeNil)
+			So(plan.Provider, ShouldEqual, AWSRedisInstance)
+		})
+
+		Convey("Ensure a dry run without a catalog file adds nothing", func() {
+			_, err := NewStorage(context.TODO(), Options{DatabaseUrl: "memory://dryrun", CatalogDryRun: true})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "needs a catalog file")
+			_, ok := memoryStorages["dryrun"]
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given an in-memory storage.", t, func() {
+		storage := NewMemoryStorage()
+		_, err := addMissingCatalog(storage, &Catalog{
+			Services: []ServiceDefinition{{Id: "service", Name: "redis"}},
+			Plans:    []PlanDefinition{{Id: "plan", Service: "service", Name: "small", Preprovision: 1, Attributes: []byte("{}"), ProviderPrivateDetails: []byte("{}"), ParametersSchema: []byte("{}")}},
+		})
+		So(err, ShouldBeNil)
+
+		Convey("Ensure preprovisioned instances are claimed along with their tasks", func() {
+			entries, err := storage.StartProvisioningTasks()
+			So(err, ShouldBeNil)
+			So(len(entries), ShouldEqual, 1)
+			entries, err = storage.StartProvisioningTasks()
+			So(err, ShouldBeNil)
+			So(len(entries), ShouldEqual, 0)
+
+			preprovisioned, err := storage.ListInstances()
+			So(err, ShouldBeNil)
+			So(len(preprovisioned), ShouldEqual, 1)
+			_, err = storage.GetUnclaimedInstance("plan", "claimed")
+			So(err, ShouldNotBeNil)
+			So(storage.UpdateInstance(&Instance{Id: preprovisioned[0].Id, Name: "testname", Status: "available"}, "plan"), ShouldBeNil)
+			_, err = storage.AddTask(preprovisioned[0].Id, ResyncFromProviderTask, "")
+			So(err, ShouldBeNil)
+
+			entry, err := storage.GetUnclaimedInstance("plan", "claimed")
+			So(err, ShouldBeNil)
+			So(entry.Id, ShouldEqual, "claimed")
+			So(entry.Name, ShouldEqual, "testname")
+			So(entry.Claimed, ShouldBeTrue)
+			tasks, err := storage.ListTasks(TaskFilter{ResourceId: "claimed"})
+			So(err, ShouldBeNil)
+			So(len(tasks), ShouldEqual, 1)
+			So(storage.ValidateInstanceID(preprovisioned[0].Id), ShouldBeNil)
+
+			So(storage.ReturnClaimedInstance("claimed"), ShouldBeNil)
+			_, err = storage.GetInstance("claimed")
+			So(err, ShouldNotBeNil)
+			_, err = storage.GetUnclaimedInstance("plan", "claimed-again")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("Ensure deleted instances are kept and their ids are never reused", func() {
+			instance := &Instance{Id: "deleted", Name: "testdeleted", Status: "available", Plan: &ProviderPlan{ID: "plan"}}
+			So(storage.AddInstance(instance), ShouldBeNil)
+			So(storage.AddInstance(instance), ShouldNotBeNil)
+			So(storage.AddBinding(&Binding{Id: "binding", ResourceId: "deleted"}), ShouldBeNil)
+			So(storage.AddBinding(&Binding{Id: "binding", ResourceId: "deleted"}), ShouldNotBeNil)
+			taskId, err := storage.AddTask("deleted", DeleteTask, "")
+			So(err, ShouldBeNil)
+
+			So(storage.DeleteInstance(instance), ShouldBeNil)
+			_, err = storage.GetInstance("deleted")
+			So(err.Error(), ShouldEqual, "Cannot find resource instance")
+			_, err = storage.GetBinding("deleted", "binding")
+			So(err, ShouldNotBeNil)
+			_, err = storage.GetTask(taskId)
+			So(err, ShouldNotBeNil)
+			So(storage.ValidateInstanceID("deleted"), ShouldNotBeNil)
+			So(storage.DeletePlan("plan"), ShouldBeNil)
+		})
+
+		Convey("Ensure pending tasks are popped once they are due, oldest first", func() {
+			So(storage.AddInstance(&Instance{Id: "tasks", Status: "available", Plan: &ProviderPlan{ID: "plan"}}), ShouldBeNil)
+			later, err := storage.AddScheduledTask("tasks", ResyncFromProviderTask, "", time.Now().Add(time.Hour))
+			So(err, ShouldBeNil)
+			first, err := storage.AddScheduledTask("tasks", DeleteTask, "", time.Now().Add(-time.Minute))
+			So(err, ShouldBeNil)
+			second, err := storage.AddTask("tasks", RestoreTask, "")
+			So(err, ShouldBeNil)
+
+			task, err := storage.PopPendingTask("worker", time.Minute)
+			So(err, ShouldBeNil)
+			So(task.Id, ShouldEqual, first)
+			So(task.Status, ShouldEqual, "started")
+			So(task.Worker, ShouldEqual, "worker")
+			restoring, err := storage.IsRestoring("tasks")
+			So(err, ShouldBeNil)
+			So(restoring, ShouldBeTrue)
+
+			// The resource already has a task started, so its next task waits for it.
+			_, err = storage.PopPendingTask("other-worker", time.Minute)
+			So(err.Error(), ShouldEqual, "sql: no rows in result set")
+			finished := "finished"
+			So(storage.UpdateTask(first, &finished, nil, nil, nil, nil, nil), ShouldBeNil)
+
+			task, err = storage.PopPendingTask("other-worker", time.Minute)
+			So(err, ShouldBeNil)
+			So(task.Id, ShouldEqual, second)
+			_, err = storage.PopPendingTask("worker", time.Minute)
+			So(err.Error(), ShouldEqual, "sql: no rows in result set")
+
+			entry, err := storage.GetInstance("tasks")
+			So(err, ShouldBeNil)
+			So(entry.Tasks, ShouldEqual, 1)
+			So(storage.CancelPendingTask(later), ShouldBeNil)
+			So(storage.RequeueFailedTask(later), ShouldBeNil)
+			history, err := storage.ListTaskHistory(later)
+			So(err, ShouldBeNil)
+			So(len(history), ShouldEqual, 3)
+		})
+
+		Convey("Ensure two tasks on one resource are never started at once", func() {
+			So(storage.AddInstance(&Instance{Id: "busy", Status: "available", Plan: &ProviderPlan{ID: "plan"}}), ShouldBeNil)
+			So(storage.AddInstance(&Instance{Id: "idle", Status: "available", Plan: &ProviderPlan{ID: "plan"}}), ShouldBeNil)
+			restore, err := storage.AddTask("busy", RestoreTask, "")
+			So(err, ShouldBeNil)
+			_, err = storage.AddTask("busy", DeleteTask, "")
+			So(err, ShouldBeNil)
+			other, err := storage.AddTask("idle", ResyncFromProviderTask, "")
+			So(err, ShouldBeNil)
+
+			task, err := storage.PopPendingTask("worker", time.Minute)
+			So(err, ShouldBeNil)
+			So(task.Id, ShouldEqual, restore)
+			task, err = storage.PopPendingTask("other-worker", time.Minute)
+			So(err, ShouldBeNil)
+			So(task.Id, ShouldEqual, other)
+			_, err = storage.PopPendingTask("other-worker", time.Minute)
+			So(err.Error(), ShouldEqual, "sql: no rows in result set")
+		})
+	})
+}
@@ -9,8 +9,11 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/golang/glog"
+	"math/rand"
 	"net/http"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 )
 
@@ -26,18 +29,43 @@ const (
 	ChangePlansTask                      TaskAction = "change-plans"
 	RestoreTask                          TaskAction = "restore"
 	PerformPostProvisionTask             TaskAction = "perform-post-provision"
+	RotateCredentialsTask                TaskAction = "rotate-credentials"
+	ApplyConfigTask                      TaskAction = "apply-config"
+	UpgradeEngineTask                    TaskAction = "upgrade-engine"
+	CopyBackupTask                       TaskAction = "copy-backup"
 )
 
+// Providers rarely report a change the moment it's made, resyncs are scheduled this far out.
+var resyncDelay = time.Second * 30
+
 type Task struct {
-	Id         string
-	Action     TaskAction
+	Id         string     `json:"id"`
+	Action     TaskAction `json:"action"`
+	ResourceId string     `json:"resource_id"`
+	Status     string     `json:"status"`
+	Retries    int64      `json:"retries"`
+	Metadata   string     `json:"-"` // may hold webhook secrets or credentials
+	Result     string     `json:"result"`
+	Created    *time.Time `json:"created"`
+	Started    *time.Time `json:"started"`
+	Finished   *time.Time `json:"finished"`
+	NotBefore  *time.Time `json:"not_before"`
+	Worker     string     `json:"worker,omitempty"`
+}
+
+type TaskFilter struct {
 	ResourceId string
+	Action     string
 	Status     string
-	Retries    int64
-	Metadata   string
-	Result     string
-	Started    *time.Time
-	Finished   *time.Time
+	Limit      int
+}
+
+type TaskHistory struct {
+	Status  string    `json:"status"`
+	Retries int64     `json:"retries"`
+	Result  string    `json:"result"`
+	Worker  string    `json:"worker,omitempty"`
+	Created time.Time `json:"created"`
 }
 
 type WebhookTaskMetadata struct {
@@ -50,13 +78,48 @@ type ChangeProvidersTaskMetadata struct {
 }
 
 type ChangePlansTaskMetadata struct {
-	Plan string `json:"plan"`
+	Plan       string                 `json:"plan"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
 }
 
 type RestoreTaskMetadata struct {
 	Backup string `json:"backup"`
 }
 
+type CopyBackupTaskMetadata struct {
+	Backup string `json:"backup"`
+}
+
+type UpgradeEngineTaskMetadata struct {
+	Version string `json:"version"`
+}
+
+type RotateCredentialsTaskMetadata struct {
+	Token  string `json:"token"`
+	Url    string `json:"url,omitempty"`
+	Secret string `json:"secret,omitempty"`
+}
+
+func SendWebhook(url string, secret string, byteData []byte) (*http.Response, error) {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(byteData)
+	sha := base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(byteData))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("content-type", "application/json")
+	req.Header.Add("x-osb-signature", sha)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close() // ignore it, we dont want to hear it.
+	return resp, nil
+}
+
 func FinishedTask(storage Storage, taskId string, retries int64, result string, status string) {
 	var t = time.Now()
 	err := storage.UpdateTask(taskId, &status, &retries, nil, &result, nil, &t)
@@ -114,7 +177,7 @@ func RunPreprovisionTasks(ctx context.Context, o Options, namePrefix string, sto
 			continue
 		}
 		if !IsAvailable(Instance.Status) {
-			if _, err = storage.AddTask(Instance.Id, ResyncFromProviderUntilAvailableTask, ""); err != nil {
+			if _, err = storage.AddScheduledTask(Instance.Id, ResyncFromProviderUntilAvailableTask, "", time.Now().Add(resyncDelay)); err != nil {
 				glog.Errorf("Error: Unable to schedule resync from provider! (%s): %s\n", Instance.Name, err.Error())
 			}
 		}
@@ -125,13 +188,35 @@ func RunPreprovisionTasks(ctx context.Context, o Options, namePrefix string, sto
 
 func TickTocPreprovisionTasks(ctx context.Context, o Options, namePrefix string, storage Storage) {
 	next_check := time.NewTicker(time.Second * 60 * 5)
+	defer next_check.Stop()
 	for {
 		RunPreprovisionTasks(ctx, o, namePrefix, storage, 60)
-		<-next_check.C
+		select {
+		case <-ctx.Done():
+			return
+		case <-next_check.C:
+		}
+	}
+}
+
+// planWithParameters applies the parameters of an instance to the plan it is moving to, any
+// parameters the plan does not offer are dropped.
+func planWithParameters(plan *ProviderPlan, parameters map[string]interface{}) (*ProviderPlan, map[string]interface{}, error) {
+	schema, err := ParseParametersSchema(plan.parametersSchema)
+	if err != nil {
+		return nil, nil, err
 	}
+	parameters = schema.Supported(parameters)
+	plan, err = plan.WithParameters(parameters)
+	if err != nil {
+		return nil, nil, err
+	}
+	return plan, parameters, nil
 }
 
-func UpgradeWithinProviders(storage Storage, fromDb *Instance, toPlanId string, namePrefix string) (string, error) {
+// UpgradeWithinProviders moves an instance to another plan of the same provider, or applies
+// new parameters to its current plan. Without parameters the instance keeps its own.
+func UpgradeWithinProviders(storage Storage, fromDb *Instance, toPlanId string, parameters map[string]interface{}, namePrefix string) (string, error) {
 	toPlan, err := storage.GetPlanByID(toPlanId)
 	if err != nil {
 		return "", err
@@ -140,12 +225,27 @@ func UpgradeWithinProviders(storage Storage, fromDb *Instance, toPlanId string,
 	if err != nil {
 		return "", err
 	}
-	if toPlanId == fromDb.Plan.ID {
+	if toPlanId == fromDb.Plan.ID && parameters == nil {
 		return "", errors.New("Cannot upgrade to the same plan")
 	}
 	if toPlan.Provider != fromDb.Plan.Provider {
 		return UpgradeAcrossProviders(storage, fromDb, toPlanId, namePrefix)
 	}
+	if parameters == nil {
+		parameters = fromDb.Parameters
+	}
+	toPlan, parameters, err = planWithParameters(toPlan, parameters)
+	if err != nil {
+		return "", err
+	}
+	toPlan, err = toPlan.WithConfig(fromDb.Config)
+	if err != nil {
+		return "", err
+	}
+	toPlan, err = planKeepingEngineVersion(toPlan, fromDb.EngineVersion)
+	if err != nil {
+		return "", err
+	}
 
 	// This could take a very long time.
 	Instance, err := fromProvider.Modify(fromDb, toPlan)
@@ -160,9 +260,13 @@ func UpgradeWithinProviders(storage Storage, fromDb *Instance, toPlanId string,
 		glog.Errorf("ERROR: Cannot update instance in database after upgrade change %s (to plan: %s) %s\n", Instance.Name, Instance.Plan.ID, err.Error())
 		return "", err
 	}
+	if err = storage.UpdateInstanceParameters(fromDb.Id, parameters); err != nil {
+		glog.Errorf("ERROR: Cannot update instance parameters in database after upgrade change %s (to plan: %s) %s\n", Instance.Name, Instance.Plan.ID, err.Error())
+		return "", err
+	}
 
 	if !IsAvailable(Instance.Status) {
-		if _, err = storage.AddTask(Instance.Id, ResyncFromProviderTask, ""); err != nil {
+		if _, err = storage.AddScheduledTask(Instance.Id, ResyncFromProviderTask, "", time.Now().Add(resyncDelay)); err != nil {
 			glog.Errorf("Error: Unable to schedule resync from provider! (%s): %s\n", Instance.Name, err.Error())
 		}
 	}
@@ -188,6 +292,14 @@ func UpgradeAcrossProviders(storage Storage, from *Instance, toPlanId string, na
 	if err != nil {
 		return "", err
 	}
+	toPlan, parameters, err := planWithParameters(toPlan, from.Parameters)
+	if err != nil {
+		return "", err
+	}
+	toPlan, err = toPlan.WithConfig(from.Config)
+	if err != nil {
+		return "", err
+	}
 
 	// Memcached is holds no state, create the new one, remove the old one, update the db with the same id.
 	newInstance, err := toProvider.Provision(from.Id, toPlan, "")
@@ -205,9 +317,23 @@ func UpgradeAcrossProviders(storage Storage, from *Instance, toPlanId string, na
 		glog.Errorf("ERROR: Cannot update instance of memcached after upgrade change %s (to plan: %s) %s\n", from.Name, from.Plan.ID, err.Error())
 		return "", err
 	}
+	if err = storage.UpdateInstanceParameters(from.Id, parameters); err != nil {
+		glog.Errorf("ERROR: Cannot update instance parameters of memcached after upgrade change %s (to plan: %s) %s\n", from.Name, from.Plan.ID, err.Error())
+		return "", err
+	}
+	// The new provider offers its own versions, the instance starts on the new plan's.
+	if err = storage.UpdateInstanceEngineVersion(from.Id, ""); err != nil {
+		glog.Errorf("ERROR: Cannot reset the engine version of memcached after upgrade change %s (to plan: %s) %s\n", from.Name, from.Plan.ID, err.Error())
+		return "", err
+	}
+	if len(from.Config) > 0 {
+		if _, err = storage.AddTask(from.Id, ApplyConfigTask, ""); err != nil {
+			glog.Errorf("Error: Unable to schedule applying the config of %s: %s\n", from.Name, err.Error())
+		}
+	}
 
 	if !IsAvailable(newInstance.Status) {
-		if _, err = storage.AddTask(newInstance.Id, ResyncFromProviderTask, ""); err != nil {
+		if _, err = storage.AddScheduledTask(newInstance.Id, ResyncFromProviderTask, "", time.Now().Add(resyncDelay)); err != nil {
 			glog.Errorf("Error: Unable to schedule resync from provider! (%s): %s\n", newInstance.Name, err.Error())
 		}
 	}
@@ -227,310 +353,639 @@ func RestoreBackup(storage Storage, instance *Instance, namePrefix string, backu
 	return nil
 }
 
-func RunWorkerTasks(ctx context.Context, o Options, namePrefix string, storage Storage) error {
+// RotateCredentials moves an instance to a new auth token in two steps, the new token is
+// added alongside the old one (ROTATE), saved and announced to bound apps, then the old
+// token is removed (SET). The old token is only removed once the webhook has accepted the
+// announcement, until then both work. Each step is safe to repeat if the task is retried.
+func RotateCredentials(storage Storage, instance *Instance, namePrefix string, metadata *RotateCredentialsTaskMetadata) error {
+	provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+	if err != nil {
+		glog.Errorf("Unable to rotate credentials, cannot find provider (GetProviderByPlan failed): %s\n", err.Error())
+		return err
+	}
+	if instance.Password != metadata.Token {
+		if err = provider.UpdateAuthToken(instance, metadata.Token, "ROTATE"); err != nil {
+			return err
+		}
+		instance.Password = metadata.Token
+		if err = storage.UpdateInstance(instance, instance.Plan.ID); err != nil {
+			return err
+		}
+	}
+	if metadata.Url == "" {
+		return errors.New("No webhook was given to notify bound apps of the new credentials, the old token is kept.")
+	}
+	bindings, err := storage.ListBindings(instance.Id)
+	if err != nil {
+		return err
+	}
+	apps := make([]map[string]string, 0)
+	for _, binding := range bindings {
+		apps = append(apps, map[string]string{"binding_id": binding.Id, "app_guid": binding.App})
+	}
+	byteData, err := json.Marshal(map[string]interface{}{"state": "succeeded", "description": "credentials-rotated", "bindings": apps})
+	if err != nil {
+		return err
+	}
+	resp, err := SendWebhook(metadata.Url, metadata.Secret, byteData)
+	if err != nil {
+		return errors.New("Unable to notify bound apps of the new credentials: " + err.Error())
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 399 {
+		return errors.New("Unable to notify bound apps of the new credentials, got: " + resp.Status)
+	}
+	return provider.UpdateAuthToken(instance, metadata.Token, "SET")
+}
 
-	t := time.NewTicker(time.Second * 60)
-	for {
-		<-t.C
-		storage.WarnOnUnfinishedTasks()
+func runDeleteTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Delete and deprovision database for task: %s\n", task.Id)
 
-		task, err := storage.PopPendingTask()
-		if err != nil && err.Error() != "sql: no rows in result set" {
-			glog.Errorf("Getting a pending task failed: %s\n", err.Error())
-			return err
-		} else if err != nil && err.Error() == "sql: no rows in result set" {
-			// Nothing to do...
-			continue
+	Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+
+	if err != nil {
+		RetryTask(storage, task, task.Retries+1, "Cannot get Instance: "+err.Error())
+		return
+	}
+	provider, err := GetProviderByPlan(namePrefix, Instance.Plan)
+	if err != nil {
+		RetryTask(storage, task, task.Retries+1, "Cannot get provider: "+err.Error())
+		return
+	}
+	if err = provider.Deprovision(Instance, true); err != nil {
+		RetryTask(storage, task, task.Retries+1, "Failed to deprovision: "+err.Error())
+		return
+	}
+	if err = storage.DeleteInstance(Instance); err != nil {
+		RetryTask(storage, task, task.Retries+1, "Failed to delete: "+err.Error())
+		return
+	}
+	FinishedTask(storage, task.Id, task.Retries, "", "finished")
+}
+
+func runResyncFromProviderTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Resyncing from provider for task: %s\n", task.Id)
+	Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get Instance: "+err.Error())
+		return
+	}
+	Entry, err := storage.GetInstance(task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get database instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get Entry: "+err.Error())
+		return
+	}
+	if Instance.Status != Entry.Status {
+		if err = storage.UpdateInstance(Instance, Instance.Plan.ID); err != nil {
+			RetryTask(storage, task, task.Retries+1, "Failed to update instance: "+err.Error())
+			return
 		}
+	} else {
+		glog.Infof("Status did not change at provider for task: %s\n", task.Id)
+		RetryTask(storage, task, task.Retries+1, "No change in status since last check")
+		return
+	}
 
-		glog.Infof("Started task: %s\n", task.Id)
+	FinishedTask(storage, task.Id, task.Retries, "", "finished")
+}
 
-		if task.Action == DeleteTask {
-			glog.Infof("Delete and deprovision database for task: %s\n", task.Id)
+func runResyncFromProviderUntilAvailableTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Resyncing from provider until available for task: %s\n", task.Id)
+	Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get Instance: "+err.Error())
+		return
+	}
+	if err = storage.UpdateInstance(Instance, Instance.Plan.ID); err != nil {
+		RetryTask(storage, task, task.Retries+1, "Failed to update instance: "+err.Error())
+		return
+	}
+	if !IsAvailable(Instance.Status) {
+		glog.Infof("Status did not change at provider for task: %s\n", task.Id)
+		RetryTask(storage, task, task.Retries+1, "No change in status since last check ("+Instance.Status+")")
+		return
+	}
+	FinishedTask(storage, task.Id, task.Retries, "", "finished")
+}
 
-			if task.Retries >= 10 {
-				glog.Infof("Retry limit was reached for task: %s %d\n", task.Id, task.Retries)
-				FinishedTask(storage, task.Id, task.Retries, "Unable to delete database "+task.ResourceId+" as it failed multiple times ("+task.Result+")", "failed")
-				continue
-			}
+func runPerformPostProvisionTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Resyncing from provider until available (for perform post provision) for task: %s\n", task.Id)
+	Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get Instance: "+err.Error())
+		return
+	}
+	if err = storage.UpdateInstance(Instance, Instance.Plan.ID); err != nil {
+		RetryTask(storage, task, task.Retries+1, "Failed to update instance: "+err.Error())
+		return
+	}
+	if !IsAvailable(Instance.Status) {
+		glog.Infof("Status did not change at provider for task: %s\n", task.Id)
+		RetryTask(storage, task, task.Retries+1, "No change in status since last check ("+Instance.Status+")")
+		return
+	}
 
-			Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	provider, err := GetProviderByPlan(namePrefix, Instance.Plan)
+	if err != nil {
+		RetryTask(storage, task, task.Retries+1, "Cannot get provider: "+err.Error())
+		return
+	}
 
-			if err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Cannot get Instance: "+err.Error(), "pending")
-				continue
-			}
-			provider, err := GetProviderByPlan(namePrefix, Instance.Plan)
-			if err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Cannot get provider: "+err.Error(), "pending")
-				continue
-			}
-			if err = provider.Deprovision(Instance, true); err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Failed to deprovision: "+err.Error(), "pending")
-				continue
-			}
-			if err = storage.DeleteInstance(Instance); err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Failed to delete: "+err.Error(), "pending")
-				continue
-			}
-			FinishedTask(storage, task.Id, task.Retries, "", "finished")
-		} else if task.Action == ResyncFromProviderTask {
-			glog.Infof("Resyncing from provider for task: %s\n", task.Id)
-			if task.Retries >= 60 {
-				glog.Infof("Retry limit was reached for task: %s %d\n", task.Id, task.Retries)
-				FinishedTask(storage, task.Id, task.Retries, "Unable to resync information from provider for database "+task.ResourceId+" as it failed multiple times ("+task.Result+")", "failed")
-				continue
-			}
-			Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
-			if err != nil {
-				glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Cannot get Instance: "+err.Error(), "pending")
-				continue
-			}
-			Entry, err := storage.GetInstance(task.ResourceId)
-			if err != nil {
-				glog.Infof("Failed to get database instance for task: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Cannot get Entry: "+err.Error(), "pending")
-				continue
-			}
-			if Instance.Status != Entry.Status {
-				if err = storage.UpdateInstance(Instance, Instance.Plan.ID); err != nil {
-					UpdateTaskStatus(storage, task.Id, task.Retries+1, "Failed to update instance: "+err.Error(), "pending")
-					continue
-				}
-			} else {
-				glog.Infof("Status did not change at provider for task: %s\n", task.Id)
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "No change in status since last check", "pending")
-				continue
-			}
+	newInstance, err := provider.PerformPostProvision(Instance)
+	if err != nil {
+		RetryTask(storage, task, task.Retries+1, "Failed to update instance: "+err.Error())
+		return
+	}
 
-			FinishedTask(storage, task.Id, task.Retries, "", "finished")
-		} else if task.Action == ResyncFromProviderUntilAvailableTask {
-			glog.Infof("Resyncing from provider until available for task: %s\n", task.Id)
-			if task.Retries >= 60 {
-				glog.Infof("Retry limit was reached for task: %s %d\n", task.Id, task.Retries)
-				FinishedTask(storage, task.Id, task.Retries, "Unable to resync information from provider for database "+task.ResourceId+" as it failed multiple times ("+task.Result+")", "failed")
-				continue
-			}
-			Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
-			if err != nil {
-				glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Cannot get Instance: "+err.Error(), "pending")
-				continue
-			}
-			if err = storage.UpdateInstance(Instance, Instance.Plan.ID); err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Failed to update instance: "+err.Error(), "pending")
-				continue
-			}
-			if !IsAvailable(Instance.Status) {
-				glog.Infof("Status did not change at provider for task: %s\n", task.Id)
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "No change in status since last check ("+Instance.Status+")", "pending")
-				continue
-			}
-			FinishedTask(storage, task.Id, task.Retries, "", "finished")
-		} else if task.Action == PerformPostProvisionTask {
-			glog.Infof("Resyncing from provider until available (for perform post provision) for task: %s\n", task.Id)
-			if task.Retries >= 60 {
-				glog.Infof("Retry limit was reached for task: %s %d\n", task.Id, task.Retries)
-				FinishedTask(storage, task.Id, task.Retries, "Unable to resync information from provider for database "+task.ResourceId+" as it failed multiple times ("+task.Result+")", "failed")
-				continue
-			}
-			Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
-			if err != nil {
-				glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot get Instance: "+err.Error(), "pending")
-				continue
-			}
-			if err = storage.UpdateInstance(Instance, Instance.Plan.ID); err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Failed to update instance: "+err.Error(), "pending")
-				continue
-			}
-			if !IsAvailable(Instance.Status) {
-				glog.Infof("Status did not change at provider for task: %s\n", task.Id)
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "No change in status since last check ("+Instance.Status+")", "pending")
-				continue
-			}
+	if err = storage.UpdateInstance(newInstance, newInstance.Plan.ID); err != nil {
+		RetryTask(storage, task, task.Retries+1, "Failed to update instance after post provision: "+err.Error())
+		return
+	}
 
-			provider, err := GetProviderByPlan(namePrefix, Instance.Plan)
-			if err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot get provider: "+err.Error(), "pending")
-				continue
-			}
+	FinishedTask(storage, task.Id, task.Retries, "", "finished")
+}
 
-			newInstance, err := provider.PerformPostProvision(Instance)
-			if err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Failed to update instance: "+err.Error(), "pending")
-				continue
-			}
+func runNotifyCreateServiceWebhookTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
 
-			if err = storage.UpdateInstance(newInstance, newInstance.Plan.ID); err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Failed to update instance after post provision: "+err.Error(), "pending")
-				continue
-			}
+	Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		RetryTask(storage, task, task.Retries+1, "Cannot get Instance: "+err.Error())
+		return
+	}
+	if !IsAvailable(Instance.Status) {
+		glog.Infof("Status did not change at provider for task: %s\n", task.Id)
+		RetryTask(storage, task, task.Retries+1, "No change in status since last check")
+		return
+	}
 
-			FinishedTask(storage, task.Id, task.Retries, "", "finished")
-		} else if task.Action == NotifyCreateServiceWebhookTask {
+	byteData, err := json.Marshal(map[string]interface{}{"state": "succeeded", "description": "available"})
+	// seems like this would be more useful, but whatevs: byteData, err := json.Marshal(Instance)
 
-			if task.Retries >= 60 {
-				FinishedTask(storage, task.Id, task.Retries, "Unable to deliver webhook: "+task.Result, "failed")
-				continue
-			}
+	if err != nil {
+		RetryTask(storage, task, task.Retries+1, "Cannot marshal Instance to json: "+err.Error())
+		return
+	}
 
-			Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
-			if err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Cannot get Instance: "+err.Error(), "pending")
-				continue
-			}
-			if !IsAvailable(Instance.Status) {
-				glog.Infof("Status did not change at provider for task: %s\n", task.Id)
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "No change in status since last check", "pending")
-				continue
-			}
+	var taskMetaData WebhookTaskMetadata
+	err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
+	if err != nil {
+		glog.Infof("Cannot unmarshal task metadata to callback on create service: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot unmarshal task metadata to callback on create service: "+err.Error())
+		return
+	}
 
-			byteData, err := json.Marshal(map[string]interface{}{"state": "succeeded", "description": "available"})
-			// seems like this would be more useful, but whatevs: byteData, err := json.Marshal(Instance)
+	resp, err := SendWebhook(taskMetaData.Url, taskMetaData.Secret, byteData)
+	if err != nil {
+		RetryTask(storage, task, task.Retries+1, "Failed to send http post operation: "+err.Error())
+		return
+	}
 
-			if err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot marshal Instance to json: "+err.Error(), "pending")
-				continue
-			}
+	if os.Getenv("RETRY_WEBHOOKS") != "" {
+		if resp.StatusCode < 200 || resp.StatusCode > 399 {
+			RetryTask(storage, task, task.Retries+1, "Got invalid http status code from hook: "+resp.Status)
+			return
+		}
+		FinishedTask(storage, task.Id, task.Retries, resp.Status, "finished")
+	} else {
+		if resp.StatusCode < 200 || resp.StatusCode > 399 {
+			taskFailuresTotal.WithLabelValues(string(task.Action)).Inc()
+			UpdateTaskStatus(storage, task.Id, task.Retries+1, "Got invalid http status code from hook: "+resp.Status, "failed")
+		} else {
+			FinishedTask(storage, task.Id, task.Retries, resp.Status, "finished")
+		}
+	}
+}
 
-			var taskMetaData WebhookTaskMetadata
-			err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
-			if err != nil {
-				glog.Infof("Cannot unmarshal task metadata to callback on create service: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot unmarshal task metadata to callback on create service: "+err.Error(), "pending")
-				continue
-			}
+func runChangePlansTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Changing plans for database: %s\n", task.Id)
+	Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get Instance: "+err.Error())
+		return
+	}
+	var taskMetaData ChangePlansTaskMetadata
+	err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
+	if err != nil {
+		glog.Infof("Cannot unmarshal task metadata to change providers: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot unmarshal task metadata to change providers: "+err.Error())
+		return
+	}
+	output, err := UpgradeWithinProviders(storage, Instance, taskMetaData.Plan, taskMetaData.Parameters, namePrefix)
+	if err != nil {
+		glog.Infof("Cannot change plans for: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot change plans: "+err.Error())
+		return
+	}
 
-			h := hmac.New(sha256.New, []byte(taskMetaData.Secret))
-			h.Write(byteData)
-			sha := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	FinishedTask(storage, task.Id, task.Retries, output, "finished")
+}
 
-			client := &http.Client{}
-			req, err := http.NewRequest("POST", taskMetaData.Url, bytes.NewReader(byteData))
-			if err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Failed to create http post request: "+err.Error(), "pending")
-				continue
-			}
-			req.Header.Add("content-type", "application/json")
-			req.Header.Add("x-osb-signature", sha)
-			resp, err := client.Do(req)
-			if err != nil {
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Failed to send http post operation: "+err.Error(), "pending")
-				continue
-			}
-			resp.Body.Close() // ignore it, we dont want to hear it.
+func runChangeProvidersTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Changing providers for database: %s\n", task.Id)
+	Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get Instance: "+err.Error())
+		return
+	}
+	var taskMetaData ChangeProvidersTaskMetadata
+	err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
+	if err != nil {
+		glog.Infof("Cannot unmarshal task metadata to change providers: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot unmarshal task metadata to change providers: "+err.Error())
+		return
+	}
+	output, err := UpgradeAcrossProviders(storage, Instance, taskMetaData.Plan, namePrefix)
+	if err != nil {
+		glog.Infof("Cannot switch providers: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot switch providers: "+err.Error())
+		return
+	}
 
-			if os.Getenv("RETRY_WEBHOOKS") != "" {
-				if resp.StatusCode < 200 || resp.StatusCode > 399 {
-					UpdateTaskStatus(storage, task.Id, task.Retries+1, "Got invalid http status code from hook: "+resp.Status, "pending")
-					continue
-				}
-				FinishedTask(storage, task.Id, task.Retries, resp.Status, "finished")
-			} else {
-				if resp.StatusCode < 200 || resp.StatusCode > 399 {
-					UpdateTaskStatus(storage, task.Id, task.Retries+1, "Got invalid http status code from hook: "+resp.Status, "failed")
-				} else {
-					FinishedTask(storage, task.Id, task.Retries, resp.Status, "finished")
-				}
-			}
-		} else if task.Action == ChangePlansTask {
-			glog.Infof("Changing plans for database: %s\n", task.Id)
-			if task.Retries >= 60 {
-				glog.Infof("Retry limit was reached for task: %s %d\n", task.Id, task.Retries)
-				FinishedTask(storage, task.Id, task.Retries, "Unable to change plans for database "+task.ResourceId+" as it failed multiple times ("+task.Result+")", "failed")
-				continue
-			}
-			Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
-			if err != nil {
-				glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot get Instance: "+err.Error(), "pending")
-				continue
-			}
-			var taskMetaData ChangePlansTaskMetadata
-			err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
-			if err != nil {
-				glog.Infof("Cannot unmarshal task metadata to change providers: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Cannot unmarshal task metadata to change providers: "+err.Error(), "pending")
-				continue
-			}
-			output, err := UpgradeWithinProviders(storage, Instance, taskMetaData.Plan, namePrefix)
-			if err != nil {
-				glog.Infof("Cannot change plans for: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries+1, "Cannot change plans: "+err.Error(), "pending")
-				continue
-			}
+	FinishedTask(storage, task.Id, task.Retries, output, "finished")
+}
 
-			FinishedTask(storage, task.Id, task.Retries, output, "finished")
-		} else if task.Action == ChangeProvidersTask {
-			glog.Infof("Changing providers for database: %s\n", task.Id)
-			if task.Retries >= 60 {
-				glog.Infof("Retry limit was reached for task: %s %d\n", task.Id, task.Retries)
-				FinishedTask(storage, task.Id, task.Retries, "Unable to resync information from provider for database "+task.ResourceId+" as it failed multiple times ("+task.Result+")", "failed")
-				continue
-			}
-			Instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
-			if err != nil {
-				glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot get Instance: "+err.Error(), "pending")
-				continue
-			}
-			var taskMetaData ChangeProvidersTaskMetadata
-			err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
-			if err != nil {
-				glog.Infof("Cannot unmarshal task metadata to change providers: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot unmarshal task metadata to change providers: "+err.Error(), "pending")
-				continue
-			}
-			output, err := UpgradeAcrossProviders(storage, Instance, taskMetaData.Plan, namePrefix)
-			if err != nil {
-				glog.Infof("Cannot switch providers: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot switch providers: "+err.Error(), "pending")
-				continue
-			}
+func runRestoreTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Restoring database for: %s\n", task.Id)
+	instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get instance: "+err.Error())
+		return
+	}
+	var taskMetaData RestoreTaskMetadata
+	err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
+	if err != nil {
+		glog.Infof("Cannot unmarshal task metadata to restore databases: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot unmarshal task metadata to restore databases: "+err.Error())
+		return
+	}
+	if err = RestoreBackup(storage, instance, namePrefix, taskMetaData.Backup); err != nil {
+		glog.Infof("Cannot restore backups for: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot restore backup: "+err.Error())
+		return
+	}
 
-			FinishedTask(storage, task.Id, task.Retries, output, "finished")
-		} else if task.Action == RestoreTask {
-			glog.Infof("Restoring database for: %s\n", task.Id)
-			if task.Retries >= 60 {
-				glog.Infof("Retry limit was reached for task: %s %d\n", task.Id, task.Retries)
-				FinishedTask(storage, task.Id, task.Retries, "Unable to restore database "+task.ResourceId+" as it failed multiple times ("+task.Result+")", "failed")
-				continue
-			}
-			instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
-			if err != nil {
-				glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot get instance: "+err.Error(), "pending")
-				continue
-			}
-			var taskMetaData RestoreTaskMetadata
-			err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
-			if err != nil {
-				glog.Infof("Cannot unmarshal task metadata to restore databases: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot unmarshal task metadata to restore databases: "+err.Error(), "pending")
-				continue
-			}
-			if err = RestoreBackup(storage, instance, namePrefix, taskMetaData.Backup); err != nil {
-				glog.Infof("Cannot restore backups for: %s, %s\n", task.Id, err.Error())
-				UpdateTaskStatus(storage, task.Id, task.Retries, "Cannot restore backup: "+err.Error(), "pending")
-				continue
-			}
+	FinishedTask(storage, task.Id, task.Retries, "", "finished")
+}
 
-			FinishedTask(storage, task.Id, task.Retries, "", "finished")
+func runRotateCredentialsTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Rotating credentials for database: %s\n", task.Id)
+	instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get instance: "+err.Error())
+		return
+	}
+	var taskMetaData RotateCredentialsTaskMetadata
+	err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
+	if err != nil {
+		glog.Infof("Cannot unmarshal task metadata to rotate credentials: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot unmarshal task metadata to rotate credentials: "+err.Error())
+		return
+	}
+	// The new token is kept with the task so a retry does not rotate to yet another token.
+	if taskMetaData.Token == "" {
+		if taskMetaData.Token, err = RandomSecureString(64); err != nil {
+			RetryTask(storage, task, task.Retries+1, "Cannot generate auth token: "+err.Error())
+			return
 		}
+		byteData, err := json.Marshal(taskMetaData)
+		if err != nil {
+			RetryTask(storage, task, task.Retries+1, "Cannot marshal task metadata: "+err.Error())
+			return
+		}
+		metadata := string(byteData)
+		if err = storage.UpdateTask(task.Id, nil, nil, &metadata, nil, nil, nil); err != nil {
+			RetryTask(storage, task, task.Retries+1, "Cannot save task metadata: "+err.Error())
+			return
+		}
+	}
+	if err = RotateCredentials(storage, instance, namePrefix, &taskMetaData); err != nil {
+		glog.Infof("Cannot rotate credentials for: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot rotate credentials: "+err.Error())
+		return
+	}
 
-		glog.Infof("Finished task: %s\n", task.Id)
+	FinishedTask(storage, task.Id, task.Retries, "", "finished")
+}
+
+// ApplyConfig gives the instance the engine settings saved with it.
+func ApplyConfig(storage Storage, instance *Instance, namePrefix string) error {
+	provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+	if err != nil {
+		glog.Errorf("Unable to apply config, cannot find provider (GetProviderByPlan failed): %s\n", err.Error())
+		return err
+	}
+	if err = provider.UpdateConfig(instance); err != nil {
+		return err
+	}
+	if _, err = storage.AddScheduledTask(instance.Id, ResyncFromProviderTask, "", time.Now().Add(resyncDelay)); err != nil {
+		glog.Errorf("Error: Unable to schedule resync from provider! (%s): %s\n", instance.Name, err.Error())
+	}
+	return nil
+}
+
+func runApplyConfigTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Applying config for database: %s\n", task.Id)
+	instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get instance: "+err.Error())
+		return
+	}
+	// Newly provisioned instances are given their config once they are available.
+	if !IsAvailable(instance.Status) {
+		RetryTask(storage, task, task.Retries+1, "Waiting for the instance to be available, it is "+instance.Status)
+		return
+	}
+	if err = ApplyConfig(storage, instance, namePrefix); err != nil {
+		glog.Infof("Cannot apply config for: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot apply config: "+err.Error())
+		return
+	}
+
+	FinishedTask(storage, task.Id, task.Retries, "", "finished")
+}
+
+// UpgradeEngine moves the instance to a newer engine version and records it so the instance
+// keeps the version when its plan is read or changed.
+func UpgradeEngine(storage Storage, instance *Instance, namePrefix string, version string) error {
+	provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+	if err != nil {
+		glog.Errorf("Unable to upgrade engine, cannot find provider (GetProviderByPlan failed): %s\n", err.Error())
+		return err
+	}
+	if compareEngineVersions(instance.EngineVersion, version) < 0 {
+		if err = provider.UpgradeEngine(instance, version); err != nil {
+			return err
+		}
+	}
+	if err = storage.UpdateInstanceEngineVersion(instance.Id, version); err != nil {
+		return err
+	}
+	if _, err = storage.AddScheduledTask(instance.Id, ResyncFromProviderTask, "", time.Now().Add(resyncDelay)); err != nil {
+		glog.Errorf("Error: Unable to schedule resync from provider! (%s): %s\n", instance.Name, err.Error())
 	}
 	return nil
 }
 
+func runUpgradeEngineTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Upgrading engine for database: %s\n", task.Id)
+	instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get instance: "+err.Error())
+		return
+	}
+	var taskMetaData UpgradeEngineTaskMetadata
+	err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
+	if err != nil {
+		glog.Infof("Cannot unmarshal task metadata to upgrade engine: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot unmarshal task metadata to upgrade engine: "+err.Error())
+		return
+	}
+	if !IsAvailable(instance.Status) {
+		RetryTask(storage, task, task.Retries+1, "Waiting for the instance to be available, it is "+instance.Status)
+		return
+	}
+	if err = UpgradeEngine(storage, instance, namePrefix, taskMetaData.Version); err != nil {
+		glog.Infof("Cannot upgrade engine for: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot upgrade engine: "+err.Error())
+		return
+	}
+
+	FinishedTask(storage, task.Id, task.Retries, "", "finished")
+}
+
+func runCopyBackupTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	glog.Infof("Copying backup for database: %s\n", task.Id)
+	instance, err := GetInstanceById(namePrefix, storage, task.ResourceId)
+	if err != nil {
+		glog.Infof("Failed to get provider instance for task: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot get instance: "+err.Error())
+		return
+	}
+	var taskMetaData CopyBackupTaskMetadata
+	err = json.Unmarshal([]byte(task.Metadata), &taskMetaData)
+	if err != nil {
+		glog.Infof("Cannot unmarshal task metadata to copy backup: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot unmarshal task metadata to copy backup: "+err.Error())
+		return
+	}
+	provider, err := GetProviderByPlan(namePrefix, instance.Plan)
+	if err != nil {
+		glog.Errorf("Unable to copy backup, cannot find provider (GetProviderByPlan failed): %s\n", err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot find provider: "+err.Error())
+		return
+	}
+	if err = provider.CopyBackup(instance, taskMetaData.Backup); err != nil {
+		glog.Infof("Cannot copy backup for: %s, %s\n", task.Id, err.Error())
+		RetryTask(storage, task, task.Retries+1, "Cannot copy backup: "+err.Error())
+		return
+	}
+
+	FinishedTask(storage, task.Id, task.Retries, "", "finished")
+}
+
+// TaskHandler performs a task, it is responsible for marking the task as finished or failed
+// with FinishedTask, or for retrying it later with RetryTask.
+type TaskHandler func(ctx context.Context, storage Storage, namePrefix string, task *Task)
+
+// RetryPolicy controls how often and how quickly a task is retried. The wait doubles with
+// each retry from BaseDelay up to MaxDelay, and is varied by up to Jitter (a fraction of it)
+// so tasks that failed together are not all retried at the same moment.
+type RetryPolicy struct {
+	MaxRetries int64
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	Jitter     float64
+}
+
+var jitterLock sync.Mutex
+var jitterSource = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+func (policy RetryPolicy) Delay(retries int64) time.Duration {
+	delay := policy.BaseDelay
+	for i := int64(1); i < retries && delay < policy.MaxDelay; i++ {
+		delay = delay * 2
+	}
+	if delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	if policy.Jitter > 0 {
+		jitterLock.Lock()
+		variance := (jitterSource.Float64()*2 - 1) * policy.Jitter
+		jitterLock.Unlock()
+		delay = delay + time.Duration(float64(delay)*variance)
+	}
+	return delay
+}
+
+var defaultRetryPolicy = RetryPolicy{MaxRetries: 60, BaseDelay: time.Second * 30, MaxDelay: time.Minute * 10, Jitter: 0.2}
+
+// Status checks are cheap and expected to fail until the provider catches up, so they back off slowly.
+var resyncRetryPolicy = RetryPolicy{MaxRetries: 60, BaseDelay: time.Second * 15, MaxDelay: time.Minute * 2, Jitter: 0.2}
+
+type taskRegistration struct {
+	handler TaskHandler
+	policy  RetryPolicy
+}
+
+var taskHandlers = make(map[TaskAction]taskRegistration)
+
+// RegisterTaskHandler sets the handler workers run for tasks with the action and how it is retried.
+func RegisterTaskHandler(action TaskAction, handler TaskHandler, policy RetryPolicy) {
+	taskHandlers[action] = taskRegistration{handler: handler, policy: policy}
+}
+
+func init() {
+	RegisterTaskHandler(DeleteTask, runDeleteTask, RetryPolicy{MaxRetries: 10, BaseDelay: time.Second * 30, MaxDelay: time.Minute * 10, Jitter: 0.2})
+	RegisterTaskHandler(ResyncFromProviderTask, runResyncFromProviderTask, resyncRetryPolicy)
+	RegisterTaskHandler(ResyncFromProviderUntilAvailableTask, runResyncFromProviderUntilAvailableTask, resyncRetryPolicy)
+	RegisterTaskHandler(PerformPostProvisionTask, runPerformPostProvisionTask, resyncRetryPolicy)
+	RegisterTaskHandler(NotifyCreateServiceWebhookTask, runNotifyCreateServiceWebhookTask, RetryPolicy{MaxRetries: 20, BaseDelay: time.Second * 10, MaxDelay: time.Hour, Jitter: 0.2})
+	RegisterTaskHandler(ChangePlansTask, runChangePlansTask, defaultRetryPolicy)
+	RegisterTaskHandler(ChangeProvidersTask, runChangeProvidersTask, defaultRetryPolicy)
+	RegisterTaskHandler(RestoreTask, runRestoreTask, defaultRetryPolicy)
+	RegisterTaskHandler(RotateCredentialsTask, runRotateCredentialsTask, RetryPolicy{MaxRetries: 10, BaseDelay: time.Second * 30, MaxDelay: time.Minute * 10, Jitter: 0.2})
+	RegisterTaskHandler(ApplyConfigTask, runApplyConfigTask, defaultRetryPolicy)
+	RegisterTaskHandler(UpgradeEngineTask, runUpgradeEngineTask, defaultRetryPolicy)
+	RegisterTaskHandler(CopyBackupTask, runCopyBackupTask, RetryPolicy{MaxRetries: 30, BaseDelay: time.Second * 5, MaxDelay: time.Minute, Jitter: 0.2})
+}
+
+// RetryTask puts the task back to pending, it will not run again until the delay of its
+// action's retry policy has passed.
+func RetryTask(storage Storage, task *Task, retries int64, result string) {
+	policy := defaultRetryPolicy
+	if registration, ok := taskHandlers[task.Action]; ok {
+		policy = registration.policy
+	}
+	taskRetriesTotal.WithLabelValues(string(task.Action)).Inc()
+	notBefore := time.Now().Add(policy.Delay(retries))
+	if err := storage.RescheduleTask(task.Id, retries, result, notBefore); err != nil {
+		glog.Errorf("Unable to reschedule task %s due to: %s (retries: %d, result: [%s])\n", task.Id, err.Error(), retries, result)
+	}
+}
+
+func RunTask(ctx context.Context, storage Storage, namePrefix string, task *Task) {
+	registration, ok := taskHandlers[task.Action]
+	if !ok {
+		glog.Errorf("No handler for task %s with action %s\n", task.Id, task.Action)
+		taskFailuresTotal.WithLabelValues(string(task.Action)).Inc()
+		FinishedTask(storage, task.Id, task.Retries, "Unknown task action "+string(task.Action), "failed")
+		return
+	}
+	if task.Retries >= registration.policy.MaxRetries {
+		glog.Infof("Retry limit was reached for task: %s %d\n", task.Id, task.Retries)
+		taskFailuresTotal.WithLabelValues(string(task.Action)).Inc()
+		FinishedTask(storage, task.Id, task.Retries, "Unable to "+string(task.Action)+" for "+task.ResourceId+" as it failed multiple times ("+task.Result+")", "failed")
+		return
+	}
+	glog.Infof("Started task: %s\n", task.Id)
+	registration.handler(ctx, storage, namePrefix, task)
+	glog.Infof("Finished task: %s\n", task.Id)
+}
+
+// runTaskWorker runs tasks one after another until the context is cancelled, a task that
+// has started is always allowed to finish. When there is nothing to do it waits for the poll interval.
+func runTaskWorker(ctx context.Context, worker *Worker, namePrefix string, storage Storage, poll time.Duration) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		task, err := storage.PopPendingTask(worker.Id, workerLease)
+		if err != nil && err.Error() != "sql: no rows in result set" {
+			glog.Errorf("Getting a pending task failed: %s\n", err.Error())
+			return err
+		} else if err != nil && err.Error() == "sql: no rows in result set" {
+			// Nothing to do...
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(poll):
+			}
+			continue
+		}
+		RunTask(ctx, storage, namePrefix, task)
+	}
+}
+
+// WorkerSettings returns the number of concurrent workers and how long idle workers wait
+// between checks for new tasks from the options or environment.
+func WorkerSettings(o Options) (int, time.Duration) {
+	if o.WorkerConcurrency == 0 && os.Getenv("WORKER_CONCURRENCY") != "" {
+		o.WorkerConcurrency, _ = strconv.Atoi(os.Getenv("WORKER_CONCURRENCY"))
+	}
+	if o.WorkerConcurrency < 1 {
+		o.WorkerConcurrency = 4
+	}
+	if o.WorkerPollInterval == 0 && os.Getenv("WORKER_POLL_INTERVAL") != "" {
+		o.WorkerPollInterval, _ = strconv.Atoi(os.Getenv("WORKER_POLL_INTERVAL"))
+	}
+	if o.WorkerPollInterval < 1 {
+		o.WorkerPollInterval = 10
+	}
+	return o.WorkerConcurrency, time.Second * time.Duration(o.WorkerPollInterval)
+}
+
+// RunWorkerTasks runs a pool of workers processing tasks concurrently, it returns once every
+// worker has finished its current task after the context is cancelled, or if a worker fails.
+func RunWorkerTasks(ctx context.Context, o Options, namePrefix string, storage Storage) error {
+	concurrency, poll := WorkerSettings(o)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	worker := NewWorker(concurrency)
+	if err := storage.RegisterWorker(worker); err != nil {
+		return err
+	}
+	// The heartbeat outlives ctx so the leases of tasks still finishing up do not expire.
+	heartbeatCtx, stopHeartbeat := context.WithCancel(context.Background())
+	go RunWorkerHeartbeat(heartbeatCtx, storage, worker)
+	go RunReconciler(ctx, o, namePrefix, storage, worker)
+
+	glog.Infof("Starting worker %s with %d concurrent tasks\n", worker.Id, concurrency)
+	errs := make(chan error, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go (func() {
+			defer wg.Done()
+			if err := runTaskWorker(ctx, worker, namePrefix, storage, poll); err != nil {
+				errs <- err
+				cancel()
+			}
+		})()
+	}
+	wg.Wait()
+	stopHeartbeat()
+	if err := storage.RemoveWorker(worker.Id); err != nil {
+		glog.Errorf("Unable to remove worker %s: %s\n", worker.Id, err.Error())
+	}
+	glog.Infof("Worker %s stopped\n", worker.Id)
+	close(errs)
+	return <-errs
+}
+
 func RunBackgroundTasks(ctx context.Context, o Options) error {
-	storage, namePrefix, err := InitFromOptions(ctx, o)
+	// The database and providers are closed once the workers have stopped rather than when
+	// ctx is cancelled, so tasks in progress can finish and record how they finished.
+	storageCtx, closeStorage := context.WithCancel(context.Background())
+	defer closeStorage()
+	storage, namePrefix, err := InitFromOptions(storageCtx, o)
 	if err != nil {
 		return err
 	}
+	StartProviders(storageCtx)
 
 	go TickTocPreprovisionTasks(ctx, o, namePrefix, storage)
+	go RunMetricsServer(ctx, o, storage)
 	return RunWorkerTasks(ctx, o, namePrefix, storage)
 }
@@ -0,0 +1,129 @@
+package broker
+
+import (
+	"context"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	Convey("Given a retry policy.", t, func() {
+		policy := RetryPolicy{MaxRetries: 10, BaseDelay: time.Second * 10, MaxDelay: time.Minute}
+
+		Convey("Ensure the delay doubles with each retry up to the maximum", func() {
+			So(policy.Delay(0), ShouldEqual, time.Second*10)
+			So(policy.Delay(1), ShouldEqual, time.Second*10)
+			So(policy.Delay(2), ShouldEqual, time.Second*20)
+			So(policy.Delay(3), ShouldEqual, time.Second*40)
+			So(policy.Delay(4), ShouldEqual, time.Minute)
+			So(policy.Delay(100), ShouldEqual, time.Minute)
+		})
+
+		Convey("Ensure jitter stays within its bounds", func() {
+			policy.Jitter = 0.2
+			for i := 0; i < 100; i++ {
+				delay := policy.Delay(2)
+				So(delay, ShouldBeGreaterThanOrEqualTo, time.Second*16)
+				So(delay, ShouldBeLessThanOrEqualTo, time.Second*24)
+			}
+		})
+
+		Convey("Ensure every task action has a retry policy", func() {
+			for _, action := range []TaskAction{DeleteTask, ResyncFromProviderTask, ResyncFromProviderUntilAvailableTask, NotifyCreateServiceWebhookTask, ChangeProvidersTask, ChangePlansTask, RestoreTask, PerformPostProvisionTask, RotateCredentialsTask, CopyBackupTask} {
+				registration, ok := taskHandlers[action]
+				So(ok, ShouldBeTrue)
+				So(registration.policy.MaxRetries, ShouldBeGreaterThan, 0)
+			}
+		})
+	})
+}
+
+// tokenProvider records the strategies its auth token is updated with.
+type tokenProvider struct {
+	Provider
+	strategies []string
+}
+
+func (p *tokenProvider) UpdateAuthToken(instance *Instance, token string, strategy string) error {
+	p.strategies = append(p.strategies, strategy)
+	return nil
+}
+
+func TestRotateCredentials(t *testing.T) {
+	var testProvider Providers = "test-token-provider"
+	provider := &tokenProvider{}
+	RegisterProvider(testProvider, func(ctx context.Context, namePrefix string) (Provider, error) {
+		return provider, nil
+	})
+
+	Convey("Given an instance whose credentials are being rotated.", t, func() {
+		restartProviders()
+		provider.strategies = nil
+		status := http.StatusOK
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+		defer server.Close()
+		storage := NewMemoryStorage()
+		instance := &Instance{Id: "test1", Name: "test1", Password: "old", Plan: &ProviderPlan{ID: "plan", Provider: testProvider}}
+		metadata := &RotateCredentialsTaskMetadata{Token: "new", Url: server.URL, Secret: "secret"}
+
+		Convey("Ensure the old token is removed once bound apps are notified", func() {
+			So(RotateCredentials(storage, instance, "test", metadata), ShouldBeNil)
+			So(provider.strategies, ShouldResemble, []string{"ROTATE", "SET"})
+			So(instance.Password, ShouldEqual, "new")
+		})
+
+		Convey("Ensure the old token is kept when bound apps cannot be notified", func() {
+			status = http.StatusInternalServerError
+			So(RotateCredentials(storage, instance, "test", metadata), ShouldNotBeNil)
+			So(provider.strategies, ShouldResemble, []string{"ROTATE"})
+
+			metadata.Url = ""
+			So(RotateCredentials(storage, instance, "test", metadata), ShouldNotBeNil)
+			So(provider.strategies, ShouldResemble, []string{"ROTATE"})
+
+			// The retry does not rotate again, it only finishes once apps are notified.
+			status = http.StatusOK
+			metadata.Url = server.URL
+			So(RotateCredentials(storage, instance, "test", metadata), ShouldBeNil)
+			So(provider.strategies, ShouldResemble, []string{"ROTATE", "SET"})
+		})
+	})
+}
+
+func TestFailingTasks(t *testing.T) {
+	Convey("Given a restore of an instance that cannot be found.", t, func() {
+		storage := NewMemoryStorage()
+		So(storage.AddInstance(&Instance{Id: "test1", Name: "test1", Plan: &ProviderPlan{ID: "does-not-exist"}}), ShouldBeNil)
+		taskId, err := storage.AddTask("test1", RestoreTask, `{"backup":"backup1"}`)
+		So(err, ShouldBeNil)
+
+		Convey("Ensure each retry waits longer and the task fails once it runs out of retries", func() {
+			var delays []time.Duration
+			for i := int64(0); i <= defaultRetryPolicy.MaxRetries; i++ {
+				task, err := storage.GetTask(taskId)
+				So(err, ShouldBeNil)
+				So(task.Status, ShouldEqual, "pending")
+				So(task.Retries, ShouldEqual, i)
+				RunTask(context.TODO(), storage, "test", task)
+				task, err = storage.GetTask(taskId)
+				So(err, ShouldBeNil)
+				if task.Status == "pending" {
+					delays = append(delays, time.Until(*task.NotBefore))
+				}
+			}
+			So(len(delays), ShouldEqual, defaultRetryPolicy.MaxRetries)
+			So(delays[1], ShouldBeGreaterThan, delays[0])
+			So(delays[2], ShouldBeGreaterThan, delays[1])
+
+			task, err := storage.GetTask(taskId)
+			So(err, ShouldBeNil)
+			So(task.Status, ShouldEqual, "failed")
+			So(task.Result, ShouldContainSubstring, "failed multiple times")
+		})
+	})
+}
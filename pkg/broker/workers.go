@@ -0,0 +1,63 @@
+package broker
+
+import (
+	"context"
+	"github.com/golang/glog"
+	"os"
+	"strings"
+	"time"
+)
+
+// A worker's tasks are requeued if it has not sent a heartbeat within the lease.
+var workerLease = time.Minute * 2
+var workerHeartbeat = time.Second * 30
+
+type Worker struct {
+	Id          string    `json:"id"`
+	Hostname    string    `json:"hostname"`
+	Concurrency int       `json:"concurrency"`
+	Started     time.Time `json:"started"`
+	Heartbeat   time.Time `json:"heartbeat"`
+	Tasks       []Task    `json:"tasks"`
+}
+
+func NewWorker(concurrency int) *Worker {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return &Worker{
+		Id:          hostname + "-" + strings.ToLower(RandomString(8)),
+		Hostname:    hostname,
+		Concurrency: concurrency,
+		Started:     time.Now(),
+		Heartbeat:   time.Now(),
+	}
+}
+
+// RunWorkerHeartbeat keeps the worker and the leases on its tasks alive until the context is
+// cancelled. Each beat also requeues tasks abandoned by workers that have died, which any
+// worker may do.
+func RunWorkerHeartbeat(ctx context.Context, storage Storage, worker *Worker) {
+	t := time.NewTicker(workerHeartbeat)
+	defer t.Stop()
+	for {
+		if err := storage.WorkerHeartbeat(worker.Id, workerLease); err != nil {
+			glog.Errorf("Unable to send heartbeat for worker %s: %s\n", worker.Id, err.Error())
+		}
+		if requeued, err := storage.RequeueExpiredTasks(); err != nil {
+			glog.Errorf("Unable to requeue expired tasks: %s\n", err.Error())
+		} else if requeued > 0 {
+			glog.Errorf("WARNING: Requeued %d tasks whose worker stopped responding.\n", requeued)
+		}
+		if err := storage.RemoveStaleWorkers(time.Hour * 24); err != nil {
+			glog.Errorf("Unable to remove stale workers: %s\n", err.Error())
+		}
+		storage.WarnOnUnfinishedTasks()
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}